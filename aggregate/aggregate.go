@@ -0,0 +1,252 @@
+// Package aggregate computes grouped aggregations over docs & connections one at a time, so the docs/connections being
+// aggregated never have to be held in memory
+package aggregate
+
+import (
+	"fmt"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"github.com/graphikDB/graphik/sketch"
+	"google.golang.org/protobuf/types/known/structpb"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// GroupAggregator computes grouped aggregations one doc/connection at a time so results never have to be held in memory
+type GroupAggregator struct {
+	filter *apipb.GroupAggFilter
+	groups map[string]*apipb.AggGroup
+	accs   map[string][]*accumulator
+}
+
+// accumulator computes a single aggregate one value at a time
+type accumulator struct {
+	field *apipb.AggField
+	count float64
+	sum   float64
+	prod  float64
+	min   float64
+	max   float64
+	// mean & m2 are used to compute the standard deviation in a single pass(Welford's algorithm)
+	mean     float64
+	m2       float64
+	values   []float64
+	distinct map[string]struct{}
+	hll      *sketch.HyperLogLog
+	digest   *sketch.TDigest
+	buckets  map[int64]uint64
+}
+
+func newAccumulator(field *apipb.AggField) *accumulator {
+	a := &accumulator{field: field}
+	switch field.GetAggregate() {
+	case apipb.Aggregate_COUNT_DISTINCT:
+		if field.GetApproximate() {
+			a.hll = sketch.NewHyperLogLog()
+		} else {
+			a.distinct = map[string]struct{}{}
+		}
+	case apipb.Aggregate_PERCENTILE:
+		if field.GetApproximate() {
+			a.digest = sketch.NewTDigest(sketch.DefaultCompression)
+		}
+	case apipb.Aggregate_HISTOGRAM:
+		a.buckets = map[int64]uint64{}
+	}
+	return a
+}
+
+func (a *accumulator) add(val *structpb.Value) {
+	switch a.field.GetAggregate() {
+	case apipb.Aggregate_COUNT:
+		return
+	case apipb.Aggregate_COUNT_DISTINCT:
+		if val == nil {
+			return
+		}
+		key := fmt.Sprintf("%T:%v", val.AsInterface(), val.AsInterface())
+		if a.hll != nil {
+			a.hll.AddString(key)
+		} else {
+			a.distinct[key] = struct{}{}
+		}
+		return
+	}
+	value, ok := numericValue(val)
+	if !ok {
+		return
+	}
+	if a.count == 0 {
+		a.prod, a.min, a.max = 1, value, value
+	}
+	a.count++
+	a.sum += value
+	a.prod *= value
+	if value < a.min {
+		a.min = value
+	}
+	if value > a.max {
+		a.max = value
+	}
+	delta := value - a.mean
+	a.mean += delta / a.count
+	a.m2 += delta * (value - a.mean)
+	switch {
+	case a.digest != nil:
+		a.digest.Add(value)
+	case a.field.GetAggregate() == apipb.Aggregate_PERCENTILE:
+		a.values = append(a.values, value)
+	case a.buckets != nil && a.field.GetBucketSize() > 0:
+		a.buckets[int64(math.Floor(value/a.field.GetBucketSize()))]++
+	}
+}
+
+func (a *accumulator) value(groupCount uint64) float64 {
+	switch a.field.GetAggregate() {
+	case apipb.Aggregate_COUNT:
+		return float64(groupCount)
+	case apipb.Aggregate_COUNT_DISTINCT:
+		if a.hll != nil {
+			return float64(a.hll.Count())
+		}
+		return float64(len(a.distinct))
+	}
+	if a.count == 0 {
+		return 0
+	}
+	switch a.field.GetAggregate() {
+	case apipb.Aggregate_SUM:
+		return a.sum
+	case apipb.Aggregate_MIN:
+		return a.min
+	case apipb.Aggregate_MAX:
+		return a.max
+	case apipb.Aggregate_PROD:
+		return a.prod
+	case apipb.Aggregate_AVG:
+		return a.sum / a.count
+	case apipb.Aggregate_STDDEV:
+		return math.Sqrt(a.m2 / a.count)
+	case apipb.Aggregate_HISTOGRAM:
+		return a.count
+	case apipb.Aggregate_PERCENTILE:
+		q := a.field.GetPercentile() / 100
+		if a.digest != nil {
+			return a.digest.Quantile(q)
+		}
+		return quantile(a.values, q)
+	}
+	return 0
+}
+
+func (a *accumulator) histogram() []*apipb.HistogramBucket {
+	if a.buckets == nil {
+		return nil
+	}
+	var keys []int64
+	for key := range a.buckets {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i] < keys[j]
+	})
+	var buckets []*apipb.HistogramBucket
+	for _, key := range keys {
+		buckets = append(buckets, &apipb.HistogramBucket{
+			Lower: float64(key) * a.field.GetBucketSize(),
+			Upper: float64(key+1) * a.field.GetBucketSize(),
+			Count: a.buckets[key],
+		})
+	}
+	return buckets
+}
+
+// quantile returns the exact value at quantile q(0-1) using linear interpolation between the closest ranks
+func quantile(values []float64, q float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sort.Float64s(values)
+	pos := q * float64(len(values)-1)
+	lower := int(math.Floor(pos))
+	upper := int(math.Ceil(pos))
+	return values[lower] + (values[upper]-values[lower])*(pos-float64(lower))
+}
+
+// numericValue returns the float value of a number or an RFC3339 timestamp(as unix seconds)
+func numericValue(val *structpb.Value) (float64, bool) {
+	switch kind := val.GetKind().(type) {
+	case *structpb.Value_NumberValue:
+		return kind.NumberValue, true
+	case *structpb.Value_StringValue:
+		if t, err := time.Parse(time.RFC3339Nano, kind.StringValue); err == nil {
+			return float64(t.UnixNano()) / float64(time.Second), true
+		}
+	}
+	return 0, false
+}
+
+// NewGroupAggregator returns a GroupAggregator that groups & aggregates docs/connections as described by the filter
+func NewGroupAggregator(filter *apipb.GroupAggFilter) *GroupAggregator {
+	return &GroupAggregator{
+		filter: filter,
+		groups: map[string]*apipb.AggGroup{},
+		accs:   map[string][]*accumulator{},
+	}
+}
+
+// Add adds a doc/connection to it's group. getPath returns the value at a path of the doc/connection(see DocPath & ConnectionPath)
+func (g *GroupAggregator) Add(getPath func(path string) *structpb.Value) {
+	var (
+		keys   []string
+		fields = map[string]*structpb.Value{}
+	)
+	for _, path := range g.filter.GetGroupBy() {
+		val := getPath(path)
+		if val == nil {
+			val = structpb.NewNullValue()
+		}
+		fields[path] = val
+		keys = append(keys, fmt.Sprintf("%T:%v", val.AsInterface(), val.AsInterface()))
+	}
+	key := strings.Join(keys, "\x00")
+	group, ok := g.groups[key]
+	if !ok {
+		group = &apipb.AggGroup{Key: &structpb.Struct{Fields: fields}}
+		g.groups[key] = group
+		for _, agg := range g.filter.GetAggregates() {
+			g.accs[key] = append(g.accs[key], newAccumulator(agg))
+		}
+	}
+	group.Count++
+	for i, agg := range g.filter.GetAggregates() {
+		if agg.GetAggregate() == apipb.Aggregate_COUNT {
+			continue
+		}
+		g.accs[key][i].add(getPath(agg.GetField()))
+	}
+}
+
+// Groups returns the aggregated groups sorted by key
+func (g *GroupAggregator) Groups() *apipb.AggGroups {
+	var keys []string
+	for key := range g.groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	groups := &apipb.AggGroups{}
+	for _, key := range keys {
+		group := g.groups[key]
+		for i, agg := range g.filter.GetAggregates() {
+			group.Values = append(group.Values, &apipb.AggValue{
+				Aggregate: agg.GetAggregate(),
+				Field:     agg.GetField(),
+				Value:     g.accs[key][i].value(group.Count),
+				Buckets:   g.accs[key][i].histogram(),
+			})
+		}
+		groups.Groups = append(groups.Groups, group)
+	}
+	return groups
+}
@@ -0,0 +1,70 @@
+package aggregate_test
+
+import (
+	"github.com/graphikDB/graphik/aggregate"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"google.golang.org/protobuf/types/known/structpb"
+	"testing"
+)
+
+func TestGroupAggregator(t *testing.T) {
+	aggregator := aggregate.NewGroupAggregator(&apipb.GroupAggFilter{
+		GroupBy: []string{"attributes.status"},
+		Aggregates: []*apipb.AggField{
+			{Aggregate: apipb.Aggregate_COUNT},
+			{Aggregate: apipb.Aggregate_SUM, Field: "attributes.order.total"},
+			{Aggregate: apipb.Aggregate_PERCENTILE, Field: "attributes.order.total", Percentile: 50},
+		},
+	})
+	for i, status := range []string{"open", "closed", "open", "open"} {
+		doc := &apipb.Doc{
+			Ref: &apipb.Ref{Gtype: "order", Gid: string(rune('a' + i))},
+			Attributes: apipb.NewStruct(map[string]interface{}{
+				"status": status,
+				"order":  map[string]interface{}{"total": float64(i + 1)},
+			}),
+		}
+		aggregator.Add(func(path string) *structpb.Value {
+			return aggregate.DocPath(doc, path)
+		})
+	}
+	groups := aggregator.Groups().GetGroups()
+	if len(groups) != 2 {
+		t.Fatalf("expected 2 groups, got %v", groups)
+	}
+	// groups are sorted by key
+	closed, open := groups[0], groups[1]
+	if closed.GetKey().GetFields()["attributes.status"].GetStringValue() != "closed" || closed.GetCount() != 1 {
+		t.Fatalf("unexpected closed group: %v", closed)
+	}
+	if open.GetCount() != 3 {
+		t.Fatalf("expected 3 open orders, got %v", open.GetCount())
+	}
+	for i, expected := range []float64{3, 8, 3} {
+		if got := open.GetValues()[i].GetValue(); got != expected {
+			t.Fatalf("expected %s = %v, got %v", open.GetValues()[i].GetAggregate(), expected, got)
+		}
+	}
+}
+
+func TestConnectionPath(t *testing.T) {
+	connection := &apipb.Connection{
+		Ref:        &apipb.Ref{Gtype: "follows", Gid: "1"},
+		From:       &apipb.Ref{Gtype: "user", Gid: "alice"},
+		To:         &apipb.Ref{Gtype: "user", Gid: "bob"},
+		Directed:   true,
+		Attributes: apipb.NewStruct(map[string]interface{}{"weight": 2.0}),
+	}
+	if got := aggregate.ConnectionPath(connection, "to.gid").GetStringValue(); got != "bob" {
+		t.Fatalf("expected to.gid = bob, got %v", got)
+	}
+	if got := aggregate.ConnectionPath(connection, "directed").GetBoolValue(); !got {
+		t.Fatal("expected directed = true")
+	}
+	if got := aggregate.ConnectionPath(connection, "attributes.weight").GetNumberValue(); got != 2 {
+		t.Fatalf("expected attributes.weight = 2, got %v", got)
+	}
+	if got := aggregate.ConnectionPath(connection, "attributes.missing.key"); got != nil {
+		t.Fatalf("expected a missing path to be nil, got %v", got)
+	}
+}
@@ -0,0 +1,56 @@
+package aggregate
+
+import (
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"google.golang.org/protobuf/types/known/structpb"
+	"strings"
+)
+
+// DocPath returns the value at a dot separated path(ref.gid, ref.gtype, attributes.(.*)) of a doc or nil if it doesn't exist
+func DocPath(n *apipb.Doc, path string) *structpb.Value {
+	switch {
+	case strings.HasPrefix(path, "attributes."):
+		return structPath(n.GetAttributes(), strings.TrimPrefix(path, "attributes."))
+	}
+	return refPath(n.GetRef(), "ref", path)
+}
+
+// ConnectionPath returns the value at a dot separated path(ref.*, from.*, to.*, directed, attributes.(.*)) of a connection or nil if it doesn't exist
+func ConnectionPath(c *apipb.Connection, path string) *structpb.Value {
+	switch {
+	case strings.HasPrefix(path, "attributes."):
+		return structPath(c.GetAttributes(), strings.TrimPrefix(path, "attributes."))
+	case path == "directed":
+		return structpb.NewBoolValue(c.GetDirected())
+	case strings.HasPrefix(path, "from."):
+		return refPath(c.GetFrom(), "from", path)
+	case strings.HasPrefix(path, "to."):
+		return refPath(c.GetTo(), "to", path)
+	}
+	return refPath(c.GetRef(), "ref", path)
+}
+
+func refPath(ref *apipb.Ref, prefix string, path string) *structpb.Value {
+	switch path {
+	case prefix + ".gid":
+		return structpb.NewStringValue(ref.GetGid())
+	case prefix + ".gtype":
+		return structpb.NewStringValue(ref.GetGtype())
+	}
+	return nil
+}
+
+func structPath(strct *structpb.Struct, path string) *structpb.Value {
+	var val *structpb.Value
+	for _, key := range strings.Split(path, ".") {
+		if strct == nil {
+			return nil
+		}
+		val = strct.GetFields()[key]
+		if val == nil {
+			return nil
+		}
+		strct = val.GetStructValue()
+	}
+	return val
+}
@@ -0,0 +1,186 @@
+// Package analytics contains the graph analytics algorithms(PageRank, degree & betweenness centrality, connected components,
+// Louvain communities & topological ordering) run by analytics jobs & queries. It's independent of storage - the subgraph
+// being analyzed is loaded into a Graph first.
+package analytics
+
+import (
+	"context"
+	"math"
+)
+
+// Graph is a directed graph of nodes identified by their index(the order they were added in)
+type Graph struct {
+	nodes []string
+	index map[string]int
+	out   [][]int
+	edges int
+}
+
+// NewGraph returns an empty graph
+func NewGraph() *Graph {
+	return &Graph{index: map[string]int{}}
+}
+
+// AddNode adds a node if it doesn't exist & returns it's index
+func (g *Graph) AddNode(node string) int {
+	if i, ok := g.index[node]; ok {
+		return i
+	}
+	g.index[node] = len(g.nodes)
+	g.nodes = append(g.nodes, node)
+	g.out = append(g.out, nil)
+	return len(g.nodes) - 1
+}
+
+// AddEdge adds a directed edge between two nodes. It returns false if either node doesn't exist.
+func (g *Graph) AddEdge(from, to string) bool {
+	f, ok := g.index[from]
+	if !ok {
+		return false
+	}
+	t, ok := g.index[to]
+	if !ok {
+		return false
+	}
+	g.out[f] = append(g.out[f], t)
+	g.edges++
+	return true
+}
+
+// Nodes returns the nodes of the graph in order - the scores returned by each algorithm are in the same order
+func (g *Graph) Nodes() []string {
+	return g.nodes
+}
+
+// Edges returns the number of edges in the graph
+func (g *Graph) Edges() int {
+	return g.edges
+}
+
+// Progress is called as an algorithm runs with the amount of work done & the total amount of work
+type Progress func(done, total int)
+
+// PageRank returns the PageRank of each node. Rank flows along edges, so a node's rank grows with the rank of the nodes
+// that have edges to it. The rank of nodes without edges(dangling nodes) is spread evenly across the graph. It stops
+// once the total change in rank of an iteration is less than tolerance or after maxIterations. The ranks sum to 1.
+func PageRank(ctx context.Context, g *Graph, damping float64, maxIterations int, tolerance float64, progress Progress) ([]float64, error) {
+	n := len(g.nodes)
+	if n == 0 {
+		return nil, nil
+	}
+	rank := make([]float64, n)
+	for i := range rank {
+		rank[i] = 1 / float64(n)
+	}
+	next := make([]float64, n)
+	for iteration := 1; iteration <= maxIterations; iteration++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		var dangling float64
+		for i, out := range g.out {
+			if len(out) == 0 {
+				dangling += rank[i]
+			}
+		}
+		base := (1-damping)/float64(n) + damping*dangling/float64(n)
+		for i := range next {
+			next[i] = base
+		}
+		for i, out := range g.out {
+			if len(out) == 0 {
+				continue
+			}
+			share := damping * rank[i] / float64(len(out))
+			for _, j := range out {
+				next[j] += share
+			}
+		}
+		var delta float64
+		for i := range rank {
+			delta += math.Abs(next[i] - rank[i])
+		}
+		rank, next = next, rank
+		if progress != nil {
+			progress(iteration, maxIterations)
+		}
+		if delta < tolerance {
+			break
+		}
+	}
+	return rank, nil
+}
+
+// Degree returns the degree centrality of each node - the number of edges leaving it divided by the number of other nodes
+func Degree(g *Graph) []float64 {
+	scores := make([]float64, len(g.nodes))
+	if len(g.nodes) < 2 {
+		return scores
+	}
+	for i, out := range g.out {
+		scores[i] = float64(len(out)) / float64(len(g.nodes)-1)
+	}
+	return scores
+}
+
+// Betweenness returns the betweenness centrality of each node(Brandes' algorithm) - the fraction of shortest paths between
+// every other pair of nodes that pass through it. It's O(nodes * edges), so progress is reported after each node.
+func Betweenness(ctx context.Context, g *Graph, progress Progress) ([]float64, error) {
+	n := len(g.nodes)
+	scores := make([]float64, n)
+	var (
+		stack = make([]int, 0, n)
+		queue = make([]int, 0, n)
+		preds = make([][]int, n)
+		sigma = make([]float64, n)
+		dist  = make([]int, n)
+		delta = make([]float64, n)
+	)
+	for s := 0; s < n; s++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		stack, queue = stack[:0], queue[:0]
+		for i := 0; i < n; i++ {
+			preds[i] = preds[i][:0]
+			sigma[i] = 0
+			dist[i] = -1
+			delta[i] = 0
+		}
+		sigma[s] = 1
+		dist[s] = 0
+		queue = append(queue, s)
+		for head := 0; head < len(queue); head++ {
+			v := queue[head]
+			stack = append(stack, v)
+			for _, w := range g.out[v] {
+				if dist[w] < 0 {
+					dist[w] = dist[v] + 1
+					queue = append(queue, w)
+				}
+				if dist[w] == dist[v]+1 {
+					sigma[w] += sigma[v]
+					preds[w] = append(preds[w], v)
+				}
+			}
+		}
+		for i := len(stack) - 1; i >= 0; i-- {
+			w := stack[i]
+			for _, v := range preds[w] {
+				delta[v] += sigma[v] / sigma[w] * (1 + delta[w])
+			}
+			if w != s {
+				scores[w] += delta[w]
+			}
+		}
+		if progress != nil {
+			progress(s+1, n)
+		}
+	}
+	if n > 2 {
+		for i := range scores {
+			scores[i] /= float64((n - 1) * (n - 2))
+		}
+	}
+	return scores, nil
+}
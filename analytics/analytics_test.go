@@ -0,0 +1,192 @@
+package analytics_test
+
+import (
+	"context"
+	"github.com/graphikDB/graphik/analytics"
+	"math"
+	"testing"
+)
+
+func newGraph(nodes []string, edges [][2]string) *analytics.Graph {
+	g := analytics.NewGraph()
+	for _, n := range nodes {
+		g.AddNode(n)
+	}
+	for _, e := range edges {
+		g.AddEdge(e[0], e[1])
+	}
+	return g
+}
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-6
+}
+
+func TestPageRank(t *testing.T) {
+	cycle := newGraph([]string{"a", "b", "c"}, [][2]string{{"a", "b"}, {"b", "c"}, {"c", "a"}})
+	ranks, err := analytics.PageRank(context.Background(), cycle, 0.85, 100, 1e-9, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, rank := range ranks {
+		if !near(rank, 1.0/3) {
+			t.Fatalf("node %v: expected 1/3, got %v", i, rank)
+		}
+	}
+	// every leaf links to the hub
+	star := newGraph([]string{"hub", "x", "y", "z"}, [][2]string{{"x", "hub"}, {"y", "hub"}, {"z", "hub"}})
+	var iterations int
+	ranks, err = analytics.PageRank(context.Background(), star, 0.85, 100, 1e-9, func(done, total int) {
+		iterations = done
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sum float64
+	for i, rank := range ranks {
+		sum += rank
+		if i > 0 && rank >= ranks[0] {
+			t.Fatalf("expected the hub to have the highest rank: %v", ranks)
+		}
+	}
+	if !near(sum, 1) {
+		t.Fatalf("expected ranks to sum to 1, got %v", sum)
+	}
+	if iterations == 0 || iterations == 100 {
+		t.Fatalf("expected pagerank to converge, got %v iterations", iterations)
+	}
+}
+
+func TestDegree(t *testing.T) {
+	star := newGraph([]string{"hub", "x", "y", "z"}, [][2]string{{"hub", "x"}, {"hub", "y"}, {"hub", "z"}, {"x", "y"}})
+	expected := []float64{1, 1.0 / 3, 0, 0}
+	for i, score := range analytics.Degree(star) {
+		if !near(score, expected[i]) {
+			t.Fatalf("node %v: expected %v, got %v", i, expected[i], score)
+		}
+	}
+}
+
+func TestBetweenness(t *testing.T) {
+	path := newGraph([]string{"a", "b", "c"}, [][2]string{{"a", "b"}, {"b", "c"}})
+	scores, err := analytics.Betweenness(context.Background(), path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !near(scores[0], 0) || !near(scores[1], 0.5) || !near(scores[2], 0) {
+		t.Fatalf("unexpected directed scores: %v", scores)
+	}
+	// edges in both directions - undirected
+	undirected := newGraph([]string{"a", "b", "c"}, [][2]string{{"a", "b"}, {"b", "a"}, {"b", "c"}, {"c", "b"}})
+	scores, err = analytics.Betweenness(context.Background(), undirected, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !near(scores[1], 1) {
+		t.Fatalf("unexpected undirected scores: %v", scores)
+	}
+	// two shortest paths from a to d - each of b & c is on half of them
+	diamond := newGraph([]string{"a", "b", "c", "d"}, [][2]string{{"a", "b"}, {"a", "c"}, {"b", "d"}, {"c", "d"}})
+	scores, err = analytics.Betweenness(context.Background(), diamond, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !near(scores[1], 0.5/6) || !near(scores[2], 0.5/6) {
+		t.Fatalf("unexpected diamond scores: %v", scores)
+	}
+}
+
+func TestCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	g := newGraph([]string{"a", "b"}, [][2]string{{"a", "b"}})
+	if _, err := analytics.PageRank(ctx, g, 0.85, 100, 1e-9, nil); err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if _, err := analytics.Betweenness(ctx, g, nil); err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if _, err := analytics.Louvain(ctx, g, nil); err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestComponents(t *testing.T) {
+	// a -> b -> c -> a is a cycle, d hangs off of it & e is isolated
+	g := newGraph([]string{"a", "b", "c", "d", "e"}, [][2]string{{"a", "b"}, {"b", "c"}, {"c", "a"}, {"c", "d"}})
+	weak, err := analytics.WeakComponents(context.Background(), g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []int{0, 0, 0, 0, 1}; !equal(weak, expected) {
+		t.Fatalf("expected weak components %v, got %v", expected, weak)
+	}
+	strong, err := analytics.StrongComponents(context.Background(), g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []int{0, 0, 0, 1, 2}; !equal(strong, expected) {
+		t.Fatalf("expected strong components %v, got %v", expected, strong)
+	}
+	if sizes := analytics.Sizes(strong); !equal(sizes, []int{3, 1, 1}) {
+		t.Fatalf("unexpected sizes: %v", sizes)
+	}
+}
+
+func TestLouvain(t *testing.T) {
+	// two triangles joined by a single edge
+	g := newGraph([]string{"a", "b", "c", "x", "y", "z"}, [][2]string{
+		{"a", "b"}, {"b", "c"}, {"c", "a"},
+		{"x", "y"}, {"y", "z"}, {"z", "x"},
+		{"c", "x"},
+	})
+	communities, err := analytics.Louvain(context.Background(), g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []int{0, 0, 0, 1, 1, 1}; !equal(communities.Labels, expected) {
+		t.Fatalf("expected communities %v, got %v", expected, communities.Labels)
+	}
+	// 2 * (3/7 - (7/14)^2)
+	if !near(communities.Modularity, 5.0/14) {
+		t.Fatalf("expected modularity 5/14, got %v", communities.Modularity)
+	}
+}
+
+func equal(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestTopologicalSort(t *testing.T) {
+	dag := newGraph([]string{"app", "lib", "util", "log"}, [][2]string{{"app", "lib"}, {"lib", "util"}, {"app", "log"}, {"util", "log"}})
+	order, cycles, err := analytics.TopologicalSort(context.Background(), dag, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []int{0, 1, 2, 3}; !equal(order, expected) || len(cycles) != 0 {
+		t.Fatalf("expected order %v, got %v(cycles: %v)", expected, order, cycles)
+	}
+	// a -> b -> c -> a & d -> d
+	cyclic := newGraph([]string{"a", "b", "c", "d", "e"}, [][2]string{{"a", "b"}, {"b", "c"}, {"c", "a"}, {"d", "d"}, {"e", "a"}})
+	order, cycles, err = analytics.TopologicalSort(context.Background(), cyclic, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order != nil || len(cycles) != 2 {
+		t.Fatalf("expected 2 cycles, got %v(order: %v)", cycles, order)
+	}
+	if !equal(cycles[0], []int{0, 1, 2, 0}) || !equal(cycles[1], []int{3, 3}) {
+		t.Fatalf("unexpected cycles: %v", cycles)
+	}
+	if _, cycles, _ = analytics.TopologicalSort(context.Background(), cyclic, 1); len(cycles) != 1 {
+		t.Fatalf("expected 1 cycle, got %v", cycles)
+	}
+}
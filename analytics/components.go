@@ -0,0 +1,337 @@
+package analytics
+
+import (
+	"context"
+	"sort"
+)
+
+// WeakComponents returns the weakly connected component of each node - the direction of edges is ignored. Components
+// are numbered from 0 in descending order of size.
+func WeakComponents(ctx context.Context, g *Graph) ([]int, error) {
+	parent := make([]int, len(g.nodes))
+	for i := range parent {
+		parent[i] = i
+	}
+	find := func(i int) int {
+		for parent[i] != i {
+			parent[i] = parent[parent[i]]
+			i = parent[i]
+		}
+		return i
+	}
+	for i, out := range g.out {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		for _, j := range out {
+			if a, b := find(i), find(j); a != b {
+				parent[a] = b
+			}
+		}
+	}
+	labels := make([]int, len(g.nodes))
+	for i := range labels {
+		labels[i] = find(i)
+	}
+	return relabel(labels), nil
+}
+
+// StrongComponents returns the strongly connected component of each node(Tarjan's algorithm) - every node in a strongly
+// connected component can reach every other node in it. Components are numbered from 0 in descending order of size.
+func StrongComponents(ctx context.Context, g *Graph) ([]int, error) {
+	n := len(g.nodes)
+	var (
+		index   = make([]int, n)
+		lowlink = make([]int, n)
+		onStack = make([]bool, n)
+		labels  = make([]int, n)
+		stack   []int
+		next    int
+	)
+	for i := range index {
+		index[i] = -1
+	}
+	// frame is a node being visited & the position of the next edge to follow
+	type frame struct {
+		node, edge int
+	}
+	for root := 0; root < n; root++ {
+		if index[root] >= 0 {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		calls := []frame{{node: root}}
+		index[root], lowlink[root] = next, next
+		next++
+		stack = append(stack, root)
+		onStack[root] = true
+		for len(calls) > 0 {
+			top := &calls[len(calls)-1]
+			v := top.node
+			if top.edge < len(g.out[v]) {
+				w := g.out[v][top.edge]
+				top.edge++
+				if index[w] < 0 {
+					index[w], lowlink[w] = next, next
+					next++
+					stack = append(stack, w)
+					onStack[w] = true
+					calls = append(calls, frame{node: w})
+				} else if onStack[w] && index[w] < lowlink[v] {
+					lowlink[v] = index[w]
+				}
+				continue
+			}
+			// every edge of v has been followed
+			if lowlink[v] == index[v] {
+				for {
+					w := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					onStack[w] = false
+					labels[w] = v
+					if w == v {
+						break
+					}
+				}
+			}
+			calls = calls[:len(calls)-1]
+			if len(calls) > 0 {
+				if parent := calls[len(calls)-1].node; lowlink[v] < lowlink[parent] {
+					lowlink[parent] = lowlink[v]
+				}
+			}
+		}
+	}
+	return relabel(labels), nil
+}
+
+// Communities are the communities found by Louvain
+type Communities struct {
+	// Labels are the community of each node - communities are numbered from 0 in descending order of size
+	Labels []int
+	// Modularity is the modularity of the communities(-0.5 - 1) - higher is better
+	Modularity float64
+	// Levels is the number of times the communities were aggregated & optimized
+	Levels int
+}
+
+// weighted is an undirected weighted edge
+type weighted struct {
+	to     int
+	weight float64
+}
+
+// Louvain detects communities by greedily moving nodes to the neighboring community that most increases modularity,
+// then aggregating each community into a single node & repeating until modularity stops increasing. The direction of
+// edges is ignored. Nodes are visited in order, so the result is deterministic.
+func Louvain(ctx context.Context, g *Graph, progress Progress) (*Communities, error) {
+	n := len(g.nodes)
+	// each edge is counted in both directions so the graph is undirected
+	adj := make([][]weighted, n)
+	for i, out := range g.out {
+		for _, j := range out {
+			adj[i] = append(adj[i], weighted{to: j, weight: 1})
+			adj[j] = append(adj[j], weighted{to: i, weight: 1})
+		}
+	}
+	adj = merge(adj)
+	membership := make([]int, n)
+	for i := range membership {
+		membership[i] = i
+	}
+	communities := &Communities{}
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		community, moved, err := optimize(ctx, adj, progress)
+		if err != nil {
+			return nil, err
+		}
+		if !moved {
+			break
+		}
+		communities.Levels++
+		community = relabel(community)
+		for i := range membership {
+			membership[i] = community[membership[i]]
+		}
+		adj = aggregate(adj, community)
+	}
+	communities.Labels = relabel(membership)
+	communities.Modularity = modularity(adj)
+	return communities, nil
+}
+
+// optimize moves each node to the neighboring community that most increases modularity until no node moves. It returns
+// the community of each node & whether any node moved.
+func optimize(ctx context.Context, adj [][]weighted, progress Progress) ([]int, bool, error) {
+	n := len(adj)
+	var (
+		community = make([]int, n)
+		degree    = make([]float64, n)
+		total     = make([]float64, n)
+		links     = make([]float64, n)
+		m2        float64
+		movedAny  bool
+	)
+	for i, edges := range adj {
+		community[i] = i
+		for _, e := range edges {
+			degree[i] += e.weight
+		}
+		total[i] = degree[i]
+		m2 += degree[i]
+	}
+	if m2 == 0 {
+		return community, false, nil
+	}
+	for {
+		var moved bool
+		for i := 0; i < n; i++ {
+			if i%1024 == 0 {
+				if err := ctx.Err(); err != nil {
+					return nil, false, err
+				}
+				if progress != nil {
+					progress(i, n)
+				}
+			}
+			current := community[i]
+			// the weight of the links from i to each neighboring community
+			var neighbors []int
+			for _, e := range adj[i] {
+				if e.to == i {
+					continue
+				}
+				c := community[e.to]
+				if links[c] == 0 {
+					neighbors = append(neighbors, c)
+				}
+				links[c] += e.weight
+			}
+			total[current] -= degree[i]
+			best, bestGain := current, links[current]-total[current]*degree[i]/m2
+			for _, c := range neighbors {
+				if gain := links[c] - total[c]*degree[i]/m2; gain > bestGain {
+					best, bestGain = c, gain
+				}
+			}
+			total[best] += degree[i]
+			for _, c := range neighbors {
+				links[c] = 0
+			}
+			links[current] = 0
+			if best != current {
+				community[i] = best
+				moved, movedAny = true, true
+			}
+		}
+		if !moved {
+			break
+		}
+	}
+	return community, movedAny, nil
+}
+
+// aggregate returns a graph with a node for each community. Links within a community become a self loop.
+func aggregate(adj [][]weighted, community []int) [][]weighted {
+	var size int
+	for _, c := range community {
+		if c+1 > size {
+			size = c + 1
+		}
+	}
+	aggregated := make([][]weighted, size)
+	for i, edges := range adj {
+		for _, e := range edges {
+			aggregated[community[i]] = append(aggregated[community[i]], weighted{to: community[e.to], weight: e.weight})
+		}
+	}
+	return merge(aggregated)
+}
+
+// merge sums the weights of parallel edges & sorts each node's edges
+func merge(adj [][]weighted) [][]weighted {
+	for i, edges := range adj {
+		sort.Slice(edges, func(a, b int) bool {
+			return edges[a].to < edges[b].to
+		})
+		var merged []weighted
+		for _, e := range edges {
+			if len(merged) > 0 && merged[len(merged)-1].to == e.to {
+				merged[len(merged)-1].weight += e.weight
+				continue
+			}
+			merged = append(merged, e)
+		}
+		adj[i] = merged
+	}
+	return adj
+}
+
+// modularity returns the modularity of a graph whose nodes are communities(see aggregate)
+func modularity(adj [][]weighted) float64 {
+	var (
+		m2    float64
+		inner = make([]float64, len(adj))
+		total = make([]float64, len(adj))
+	)
+	for i, edges := range adj {
+		for _, e := range edges {
+			total[i] += e.weight
+			if e.to == i {
+				inner[i] += e.weight
+			}
+		}
+		m2 += total[i]
+	}
+	if m2 == 0 {
+		return 0
+	}
+	var q float64
+	for i := range adj {
+		q += inner[i]/m2 - (total[i]/m2)*(total[i]/m2)
+	}
+	return q
+}
+
+// Sizes returns the number of nodes with each label
+func Sizes(labels []int) []int {
+	var sizes []int
+	for _, label := range labels {
+		for label >= len(sizes) {
+			sizes = append(sizes, 0)
+		}
+		sizes[label]++
+	}
+	return sizes
+}
+
+// relabel numbers labels from 0 in descending order of size(ties are broken by the first node with the label)
+func relabel(labels []int) []int {
+	var (
+		sizes = map[int]int{}
+		order []int
+	)
+	for _, label := range labels {
+		if _, ok := sizes[label]; !ok {
+			order = append(order, label)
+		}
+		sizes[label]++
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return sizes[order[i]] > sizes[order[j]]
+	})
+	ids := make(map[int]int, len(order))
+	for id, label := range order {
+		ids[label] = id
+	}
+	relabeled := make([]int, len(labels))
+	for i, label := range labels {
+		relabeled[i] = ids[label]
+	}
+	return relabeled
+}
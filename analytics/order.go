@@ -0,0 +1,88 @@
+package analytics
+
+import "context"
+
+// TopologicalSort orders the nodes so that every node comes before the nodes it has edges to(Kahn's algorithm). If the
+// graph isn't acyclic, the order is nil & up to maxCycles cycles are returned instead - one from each strongly connected
+// component that contains a cycle. Each cycle is a list of nodes that ends with the node it starts with.
+func TopologicalSort(ctx context.Context, g *Graph, maxCycles int) ([]int, [][]int, error) {
+	n := len(g.nodes)
+	in := make([]int, n)
+	for _, out := range g.out {
+		for _, j := range out {
+			in[j]++
+		}
+	}
+	order := make([]int, 0, n)
+	for i := range in {
+		if in[i] == 0 {
+			order = append(order, i)
+		}
+	}
+	for head := 0; head < len(order); head++ {
+		if head%1024 == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, nil, err
+			}
+		}
+		for _, j := range g.out[order[head]] {
+			in[j]--
+			if in[j] == 0 {
+				order = append(order, j)
+			}
+		}
+	}
+	if len(order) == n {
+		return order, nil, nil
+	}
+	labels, err := StrongComponents(ctx, g)
+	if err != nil {
+		return nil, nil, err
+	}
+	var (
+		cycles [][]int
+		seen   = map[int]struct{}{}
+	)
+	for start := 0; start < n && len(cycles) < maxCycles; start++ {
+		if _, ok := seen[labels[start]]; ok {
+			continue
+		}
+		if cycle := findCycle(g, labels, start); cycle != nil {
+			seen[labels[start]] = struct{}{}
+			cycles = append(cycles, cycle)
+		}
+	}
+	return nil, cycles, nil
+}
+
+// findCycle returns the shortest cycle from start back to itself within it's strongly connected component or nil if
+// there isn't one(the component is a single node without a self loop)
+func findCycle(g *Graph, labels []int, start int) []int {
+	parents := map[int]int{}
+	queue := []int{start}
+	for head := 0; head < len(queue); head++ {
+		v := queue[head]
+		for _, w := range g.out[v] {
+			if labels[w] != labels[start] {
+				continue
+			}
+			if w == start {
+				cycle := []int{start}
+				for ; v != start; v = parents[v] {
+					cycle = append(cycle, v)
+				}
+				cycle = append(cycle, start)
+				// the path was built backwards
+				for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+					cycle[i], cycle[j] = cycle[j], cycle[i]
+				}
+				return cycle
+			}
+			if _, ok := parents[w]; !ok {
+				parents[w] = v
+				queue = append(queue, w)
+			}
+		}
+	}
+	return nil
+}
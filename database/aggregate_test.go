@@ -0,0 +1,54 @@
+package database
+
+import (
+	"fmt"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"testing"
+)
+
+func TestAggregateDocGroupsIgnoresLimit(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	for i := 0; i < 10; i++ {
+		createTestDoc(t, g, ctx, "order", fmt.Sprint(i), map[string]interface{}{"total": float64(i)})
+	}
+	ctx = withMethod(g, ctx, "AggregateDocGroups")
+	groups, err := g.AggregateDocGroups(ctx, &apipb.GroupAggFilter{
+		Filter: &apipb.Filter{Gtype: "order", Limit: 3},
+		Aggregates: []*apipb.AggField{
+			{Aggregate: apipb.Aggregate_COUNT},
+			{Aggregate: apipb.Aggregate_SUM, Field: "attributes.total"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups.GetGroups()) != 1 || groups.GetGroups()[0].GetCount() != 10 {
+		t.Fatalf("expected a single group of 10 orders, got %v", groups)
+	}
+	if sum := groups.GetGroups()[0].GetValues()[1].GetValue(); sum != 45 {
+		t.Fatalf("expected a sum of 45, got %v", sum)
+	}
+}
+
+func TestAggregateDocsHonorsLimit(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	for i := 0; i < 10; i++ {
+		createTestDoc(t, g, ctx, "order", fmt.Sprint(i), map[string]interface{}{"total": float64(i)})
+	}
+	for aggregate, expected := range map[apipb.Aggregate]float64{
+		apipb.Aggregate_COUNT: 3,
+		apipb.Aggregate_SUM:   3,
+	} {
+		number, err := g.AggregateDocs(withMethod(g, ctx, "AggregateDocs"), &apipb.AggFilter{
+			Filter:    &apipb.Filter{Gtype: "order", Limit: 3},
+			Aggregate: aggregate,
+			Field:     "attributes.total",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if number.GetValue() != expected {
+			t.Fatalf("%s: expected %v, got %v", aggregate, expected, number.GetValue())
+		}
+	}
+}
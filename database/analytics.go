@@ -0,0 +1,452 @@
+package database
+
+import (
+	"context"
+	"github.com/autom8ter/machine"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/google/cel-go/cel"
+	"github.com/graphikDB/graphik/analytics"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"github.com/graphikDB/graphik/logger"
+	"github.com/pkg/errors"
+	"github.com/segmentio/ksuid"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// analyticsBatchSize is the max number of docs analytics results are written to per transaction
+	analyticsBatchSize = 1000
+	// analyticsRetention is how long finished analytics jobs(& their results) are kept in memory
+	analyticsRetention = 24 * time.Hour
+	// analyticsMaxComponents is the max number of components/communities reported in the status of an analytics job
+	analyticsMaxComponents = 1000
+)
+
+// analyticsJob is a running or finished analytics job. Jobs are kept in memory - they're lost when the server restarts.
+type analyticsJob struct {
+	mu        sync.RWMutex
+	namespace string
+	status    *apipb.AnalyticsStatus
+	results   []*apipb.AnalyticsResult
+	// cancel halts the job once it's started
+	cancel func()
+}
+
+func (j *analyticsJob) getStatus() *apipb.AnalyticsStatus {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	return proto.Clone(j.status).(*apipb.AnalyticsStatus)
+}
+
+func (j *analyticsJob) update(fn func(status *apipb.AnalyticsStatus)) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	fn(j.status)
+}
+
+// start records the job's cancel func - a job that was cancelled before it started is halted immediately
+func (j *analyticsJob) start(cancel func()) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.cancel = cancel
+	if j.status.GetState() == apipb.JobState_CANCELLED {
+		cancel()
+	}
+}
+
+// finish records the outcome of the job unless it was cancelled
+func (j *analyticsJob) finish(results []*apipb.AnalyticsResult, err error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.status.GetState() == apipb.JobState_CANCELLED {
+		return
+	}
+	j.status.CompletedAt = timestamppb.Now()
+	if err != nil {
+		j.status.State = apipb.JobState_FAILED
+		j.status.Error = err.Error()
+		return
+	}
+	j.status.State = apipb.JobState_COMPLETE
+	j.status.Phase = "done"
+	j.status.Progress = 1
+	j.results = results
+}
+
+// RunAnalytics validates an analytics job & runs it in the background. The job's status(including it's progress) may be
+// polled with GetAnalytics & it's results streamed with StreamAnalyticsResults once it's complete.
+func (g *Graph) RunAnalytics(ctx context.Context, job *apipb.AnalyticsJob) (*apipb.AnalyticsStatus, error) {
+	user := g.getIdentity(ctx)
+	if user == nil {
+		return nil, status.Error(codes.Unauthenticated, "failed to get user")
+	}
+	if !g.isGraphikAdmin(user) {
+		return nil, status.Error(codes.PermissionDenied, "analytics jobs are restricted to root users")
+	}
+	job = proto.Clone(job).(*apipb.AnalyticsJob)
+	if job.GetDamping() == 0 {
+		job.Damping = 0.85
+	}
+	if job.GetMaxIterations() == 0 {
+		job.MaxIterations = 100
+	}
+	if job.GetTolerance() == 0 {
+		job.Tolerance = 0.000001
+	}
+	subgraph, err := g.newSubgraph(job.GetSubgraph())
+	if err != nil {
+		return nil, err
+	}
+	j := &analyticsJob{
+		namespace: g.getNamespace(ctx),
+		status: &apipb.AnalyticsStatus{
+			Id:        ksuid.New().String(),
+			Job:       job,
+			State:     apipb.JobState_RUNNING,
+			Phase:     "loading",
+			User:      user.GetRef(),
+			StartedAt: timestamppb.Now(),
+		},
+	}
+	key := g.namespacedKey(ctx, j.status.GetId())
+	g.analytics.Set(key, j, 0)
+	// the job outlives the request so it needs its own context scoped to the request's namespace
+	namespace := j.namespace
+	g.machine.Go(func(routine machine.Routine) {
+		ctx, cancel := context.WithCancel(g.namespaceToContext(routine.Context(), namespace))
+		defer cancel()
+		j.start(cancel)
+		logger.Info("starting analytics job", zap.String("id", j.status.GetId()), zap.String("algorithm", job.GetAlgorithm().String()))
+		results, err := g.runAnalytics(ctx, j, subgraph)
+		if err != nil && routine.Context().Err() != nil {
+			err = errors.New("interrupted")
+		}
+		j.finish(results, err)
+		final := j.getStatus()
+		if final.GetState() == apipb.JobState_FAILED {
+			logger.Error("analytics job failure", zap.String("id", final.GetId()), zap.String("error", final.GetError()))
+		} else {
+			logger.Info("analytics job finished", zap.String("id", final.GetId()), zap.String("state", final.GetState().String()))
+		}
+		g.analytics.Set(key, j, analyticsRetention)
+	})
+	return j.getStatus(), nil
+}
+
+// GetAnalytics returns the status of an analytics job
+func (g *Graph) GetAnalytics(ctx context.Context, ref *apipb.AnalyticsRef) (*apipb.AnalyticsStatus, error) {
+	j, err := g.getAnalyticsJob(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	return j.getStatus(), nil
+}
+
+// ListAnalytics returns the status of every analytics job within the namespace ordered by start time
+func (g *Graph) ListAnalytics(ctx context.Context, _ *empty.Empty) (*apipb.AnalyticsStatuses, error) {
+	statuses := &apipb.AnalyticsStatuses{}
+	namespace := g.getNamespace(ctx)
+	g.analytics.Range(func(key, value interface{}) bool {
+		if j := value.(*analyticsJob); j.namespace == namespace {
+			statuses.Jobs = append(statuses.Jobs, j.getStatus())
+		}
+		return true
+	})
+	sort.Slice(statuses.Jobs, func(i, j int) bool {
+		return statuses.Jobs[i].GetId() < statuses.Jobs[j].GetId()
+	})
+	return statuses, nil
+}
+
+// CancelAnalytics cancels a running analytics job. Results that were already written to docs aren't rolled back.
+func (g *Graph) CancelAnalytics(ctx context.Context, ref *apipb.AnalyticsRef) (*apipb.AnalyticsStatus, error) {
+	user := g.getIdentity(ctx)
+	if user == nil {
+		return nil, status.Error(codes.Unauthenticated, "failed to get user")
+	}
+	if !g.isGraphikAdmin(user) {
+		return nil, status.Error(codes.PermissionDenied, "analytics jobs are restricted to root users")
+	}
+	j, err := g.getAnalyticsJob(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.status.GetState() != apipb.JobState_RUNNING {
+		return nil, status.Errorf(codes.FailedPrecondition, "analytics job %s is %s", ref.GetId(), strings.ToLower(j.status.GetState().String()))
+	}
+	j.status.State = apipb.JobState_CANCELLED
+	j.status.CompletedAt = timestamppb.Now()
+	if j.cancel != nil {
+		j.cancel()
+	}
+	return proto.Clone(j.status).(*apipb.AnalyticsStatus), nil
+}
+
+// StreamAnalyticsResults streams the results of a completed analytics job in descending order of score(or ascending order
+// of component/community id)
+func (g *Graph) StreamAnalyticsResults(ref *apipb.AnalyticsRef, server apipb.DatabaseService_StreamAnalyticsResultsServer) error {
+	j, err := g.getAnalyticsJob(server.Context(), ref)
+	if err != nil {
+		return err
+	}
+	j.mu.RLock()
+	state, results := j.status.GetState(), j.results
+	j.mu.RUnlock()
+	if state != apipb.JobState_COMPLETE {
+		return status.Errorf(codes.FailedPrecondition, "analytics job %s is %s", ref.GetId(), strings.ToLower(state.String()))
+	}
+	for _, result := range results {
+		if err := server.Send(result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *Graph) getAnalyticsJob(ctx context.Context, ref *apipb.AnalyticsRef) (*analyticsJob, error) {
+	value, ok := g.analytics.Get(g.namespacedKey(ctx, ref.GetId()))
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "analytics job %s not found", ref.GetId())
+	}
+	return value.(*analyticsJob), nil
+}
+
+func (g *Graph) runAnalytics(ctx context.Context, j *analyticsJob, subgraph *subgraph) ([]*apipb.AnalyticsResult, error) {
+	job := j.status.GetJob()
+	graph := analytics.NewGraph()
+	connections, err := subgraph.load(ctx, func(doc *apipb.Doc) {
+		graph.AddNode(refString(doc.GetRef()))
+	}, func(connection *apipb.Connection) bool {
+		from, to := refString(connection.GetFrom()), refString(connection.GetTo())
+		// undirected connections are followed in both directions regardless of the job's direction
+		if !connection.GetDirected() {
+			return graph.AddEdge(from, to) && graph.AddEdge(to, from)
+		}
+		switch job.GetDirection() {
+		case apipb.Direction_IN:
+			return graph.AddEdge(to, from)
+		case apipb.Direction_BOTH:
+			return graph.AddEdge(from, to) && graph.AddEdge(to, from)
+		}
+		return graph.AddEdge(from, to)
+	})
+	if err != nil {
+		return nil, err
+	}
+	j.update(func(status *apipb.AnalyticsStatus) {
+		status.Docs = uint64(len(graph.Nodes()))
+		status.Connections = uint64(connections)
+		status.Phase = "running"
+	})
+	progress := func(done, total int) {
+		j.update(func(status *apipb.AnalyticsStatus) {
+			status.Progress = float64(done) / float64(total)
+			if job.GetAlgorithm() == apipb.AnalyticsAlgorithm_PAGERANK {
+				status.Iterations = uint64(done)
+			}
+		})
+	}
+	var (
+		scores []float64
+		labels []int
+	)
+	switch job.GetAlgorithm() {
+	case apipb.AnalyticsAlgorithm_PAGERANK:
+		scores, err = analytics.PageRank(ctx, graph, job.GetDamping(), int(job.GetMaxIterations()), job.GetTolerance(), progress)
+	case apipb.AnalyticsAlgorithm_DEGREE:
+		scores = analytics.Degree(graph)
+	case apipb.AnalyticsAlgorithm_BETWEENNESS:
+		scores, err = analytics.Betweenness(ctx, graph, progress)
+	case apipb.AnalyticsAlgorithm_WEAK_COMPONENTS:
+		labels, err = analytics.WeakComponents(ctx, graph)
+	case apipb.AnalyticsAlgorithm_STRONG_COMPONENTS:
+		labels, err = analytics.StrongComponents(ctx, graph)
+	case apipb.AnalyticsAlgorithm_LOUVAIN:
+		var communities *analytics.Communities
+		if communities, err = analytics.Louvain(ctx, graph, progress); err == nil {
+			labels = communities.Labels
+			j.update(func(status *apipb.AnalyticsStatus) {
+				status.Iterations = uint64(communities.Levels)
+				status.Modularity = communities.Modularity
+			})
+		}
+	default:
+		err = errors.Errorf("unsupported analytics algorithm: %s", job.GetAlgorithm())
+	}
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*apipb.AnalyticsResult, len(graph.Nodes()))
+	if labels != nil {
+		for i, node := range graph.Nodes() {
+			results[i] = &apipb.AnalyticsResult{Ref: fromRefString(node), Value: float64(labels[i])}
+		}
+		// the largest component first
+		sort.SliceStable(results, func(i, j int) bool {
+			return results[i].GetValue() < results[j].GetValue()
+		})
+		sizes := analytics.Sizes(labels)
+		j.update(func(status *apipb.AnalyticsStatus) {
+			status.ComponentCount = uint64(len(sizes))
+			for id, size := range sizes {
+				if id == analyticsMaxComponents {
+					break
+				}
+				status.Components = append(status.Components, &apipb.Component{Id: uint64(id), Size: uint64(size)})
+			}
+		})
+	} else {
+		for i, node := range graph.Nodes() {
+			results[i] = &apipb.AnalyticsResult{Ref: fromRefString(node), Value: scores[i]}
+		}
+		sort.SliceStable(results, func(i, j int) bool {
+			return results[i].GetValue() > results[j].GetValue()
+		})
+	}
+	if job.GetAttribute() != "" {
+		j.update(func(status *apipb.AnalyticsStatus) {
+			status.Phase = "writing"
+			status.Progress = 0
+		})
+		if err := g.writeResults(ctx, j, job.GetAttribute(), results); err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// writeResults sets the attribute of each doc to it's result in batches so a single transaction never holds every doc.
+// Docs that were deleted while the job was running are skipped.
+func (g *Graph) writeResults(ctx context.Context, j *analyticsJob, attribute string, results []*apipb.AnalyticsResult) error {
+	for start := 0; start < len(results); start += analyticsBatchSize {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		end := start + analyticsBatchSize
+		if end > len(results) {
+			end = len(results)
+		}
+		var written uint64
+		if err := g.db.Update(func(tx *bbolt.Tx) error {
+			for _, result := range results[start:end] {
+				doc, err := g.getDoc(ctx, tx, result.GetRef())
+				if err != nil {
+					if err == ErrNotFound {
+						continue
+					}
+					return err
+				}
+				if doc.GetAttributes() == nil {
+					doc.Attributes = apipb.NewStruct(map[string]interface{}{})
+				}
+				doc.GetAttributes().GetFields()[attribute] = structpb.NewNumberValue(result.GetValue())
+				if _, err := g.setDoc(ctx, tx, doc); err != nil {
+					return errors.Wrapf(err, "failed to write result to %s", result.GetRef().String())
+				}
+				written++
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		j.update(func(status *apipb.AnalyticsStatus) {
+			status.Written += written
+			status.Progress = float64(end) / float64(len(results))
+		})
+	}
+	return nil
+}
+
+// subgraph is the compiled selection of docs & connections a job operates on
+type subgraph struct {
+	g                 *Graph
+	selection         *apipb.Subgraph
+	docProgram        cel.Program
+	connectionProgram cel.Program
+}
+
+func (g *Graph) newSubgraph(selection *apipb.Subgraph) (*subgraph, error) {
+	s := &subgraph{g: g, selection: selection}
+	var err error
+	if selection.GetDocExpression() != "" {
+		s.docProgram, err = g.vm.Doc().Program(selection.GetDocExpression())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if selection.GetConnectionExpression() != "" {
+		s.connectionProgram, err = g.vm.Connection().Program(selection.GetConnectionExpression())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	return s, nil
+}
+
+// load executes onDoc against each doc in the subgraph, then onConnection against each connection of the selected types
+// from a doc in the subgraph that passes the connection expression. Connections are found via the in-memory adjacency
+// of each doc rather than a scan of every connection of the selected types. onConnection returns false if either of the
+// connection's docs isn't in the subgraph - load returns the number of connections it accepted. Undirected connections
+// are only passed to onConnection once, so onConnection must add both of their edges.
+func (s *subgraph) load(ctx context.Context, onDoc func(doc *apipb.Doc), onConnection func(connection *apipb.Connection) bool) (int, error) {
+	var refs []*apipb.Ref
+	for _, gtype := range s.selection.GetDocGtypes() {
+		if _, err := s.g.rangeSeekDocs(ctx, gtype, s.selection.GetDocExpression(), "", "", false, func(doc *apipb.Doc) bool {
+			if s.docProgram != nil {
+				if pass, err := s.g.vm.Doc().Eval(doc, s.docProgram); err != nil || !pass {
+					return true
+				}
+			}
+			refs = append(refs, doc.GetRef())
+			onDoc(doc)
+			return true
+		}); err != nil && err != ErrNotFound {
+			return 0, err
+		}
+	}
+	gtypes := map[string]struct{}{}
+	for _, gtype := range s.selection.GetConnectionGtypes() {
+		gtypes[gtype] = struct{}{}
+	}
+	var (
+		count int
+		// undirected connections are in the adjacency of both of their docs
+		seen = map[string]struct{}{}
+	)
+	err := s.g.db.View(func(tx *bbolt.Tx) error {
+		for _, ref := range refs {
+			if err := s.g.rangeTypes(ctx, tx, ref, apipb.Direction_OUT, gtypes, func(connection *apipb.Connection) bool {
+				key := refString(connection.GetRef())
+				if _, ok := seen[key]; ok {
+					return true
+				}
+				seen[key] = struct{}{}
+				if s.connectionProgram != nil {
+					if pass, err := s.g.vm.Connection().Eval(connection, s.connectionProgram); err != nil || !pass {
+						return true
+					}
+				}
+				if onConnection(connection) {
+					count++
+				}
+				return true
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return count, err
+}
@@ -0,0 +1,81 @@
+package database
+
+import (
+	"context"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"testing"
+	"time"
+)
+
+// runTestAnalytics runs an analytics job to completion & returns it's results by doc gid
+func runTestAnalytics(t *testing.T, g *Graph, ctx context.Context, job *apipb.AnalyticsJob) map[string]float64 {
+	t.Helper()
+	started, err := g.RunAnalytics(withMethod(g, ctx, "RunAnalytics"), job)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ref := &apipb.AnalyticsRef{Id: started.GetId()}
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		status, err := g.GetAnalytics(withMethod(g, ctx, "GetAnalytics"), ref)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if status.GetState() == apipb.JobState_COMPLETE {
+			break
+		}
+		if status.GetState() == apipb.JobState_FAILED || time.Now().After(deadline) {
+			t.Fatalf("analytics job didn't complete: %v", status)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	j, err := g.getAnalyticsJob(ctx, ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results := map[string]float64{}
+	j.mu.RLock()
+	for _, result := range j.results {
+		results[result.GetRef().GetGid()] = result.GetValue()
+	}
+	j.mu.RUnlock()
+	return results
+}
+
+func TestAnalyticsUndirectedDegree(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	docs := map[string]*apipb.Doc{}
+	for _, gid := range []string{"a", "b", "c"} {
+		docs[gid] = createTestDoc(t, g, ctx, "person", gid, map[string]interface{}{})
+	}
+	// b is a friend of both a & c, though neither connection was created from b
+	createTestConnection(t, g, ctx, "friend", docs["a"].GetRef(), docs["b"].GetRef(), false)
+	createTestConnection(t, g, ctx, "friend", docs["c"].GetRef(), docs["b"].GetRef(), false)
+	results := runTestAnalytics(t, g, ctx, &apipb.AnalyticsJob{
+		Algorithm: apipb.AnalyticsAlgorithm_DEGREE,
+		Subgraph:  &apipb.Subgraph{DocGtypes: []string{"person"}, ConnectionGtypes: []string{"friend"}},
+	})
+	for gid, expected := range map[string]float64{"a": 0.5, "b": 1, "c": 0.5} {
+		if results[gid] != expected {
+			t.Fatalf("expected %s to have a degree centrality of %v, got %v", gid, expected, results)
+		}
+	}
+}
+
+func TestAnalyticsUndirectedComponents(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	docs := map[string]*apipb.Doc{}
+	for _, gid := range []string{"a", "b", "c"} {
+		docs[gid] = createTestDoc(t, g, ctx, "person", gid, map[string]interface{}{})
+	}
+	// a & b follow each other, but c doesn't follow b back
+	createTestConnection(t, g, ctx, "follows", docs["a"].GetRef(), docs["b"].GetRef(), false)
+	createTestConnection(t, g, ctx, "follows", docs["b"].GetRef(), docs["c"].GetRef(), true)
+	labels := runTestAnalytics(t, g, ctx, &apipb.AnalyticsJob{
+		Algorithm: apipb.AnalyticsAlgorithm_STRONG_COMPONENTS,
+		Subgraph:  &apipb.Subgraph{DocGtypes: []string{"person"}, ConnectionGtypes: []string{"follows"}},
+	})
+	if labels["a"] != labels["b"] || labels["b"] == labels["c"] {
+		t.Fatalf("expected a & b to be strongly connected without c, got %v", labels)
+	}
+}
@@ -0,0 +1,142 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"github.com/graphikDB/graphik/helpers"
+	"github.com/graphikDB/graphik/logger"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"strconv"
+	"time"
+)
+
+const (
+	// defaultSlowQueryThreshold is the slow query log threshold if the slow_query_threshold flag is empty
+	defaultSlowQueryThreshold = 1 * time.Second
+)
+
+// budgetError halts a query that exceeded one of it's limits
+type budgetError struct {
+	limit string
+	value interface{}
+}
+
+func (b *budgetError) Error() string {
+	return fmt.Sprintf("query budget exceeded: %s(%v)", b.limit, b.value)
+}
+
+// limits are the server-wide query limits set by flags
+type limits struct {
+	maxScan            uint64
+	maxEvaluations     uint64
+	queryTimeout       time.Duration
+	slowQueryThreshold time.Duration
+}
+
+func newLimits(flgs *apipb.Flags) (limits, error) {
+	l := limits{
+		maxScan:            flgs.GetMaxScan(),
+		maxEvaluations:     flgs.GetMaxEvaluations(),
+		slowQueryThreshold: defaultSlowQueryThreshold,
+	}
+	var err error
+	if flgs.GetQueryTimeout() != "" {
+		l.queryTimeout, err = time.ParseDuration(flgs.GetQueryTimeout())
+		if err != nil {
+			return l, errors.Wrap(err, "failed to parse query timeout")
+		}
+	}
+	if flgs.GetSlowQueryThreshold() != "" {
+		l.slowQueryThreshold, err = time.ParseDuration(flgs.GetSlowQueryThreshold())
+		if err != nil {
+			return l, errors.Wrap(err, "failed to parse slow query threshold")
+		}
+	}
+	return l, nil
+}
+
+// capLimit returns the smallest non-zero limit(0 is unlimited)
+func capLimit(requested, server uint64) uint64 {
+	if requested == 0 || (server != 0 && requested > server) {
+		return server
+	}
+	return requested
+}
+
+// startQuery applies the budget(capped by the server's limits) to a search, aggregation or traversal. The returned
+// func must be called with the outcome of the query - it writes slow queries to the slow query log & converts budget
+// errors to RESOURCE_EXHAUSTED errors with partial result metadata(x-graphik-partial-results, x-graphik-seek-next).
+// Streams aren't bound by the server's query timeout since they're halted by the client.
+func (g *Graph) startQuery(ctx context.Context, filter proto.Message, budget *apipb.Budget, stream bool) (context.Context, func(results int, seek string, err error) error) {
+	stats := getQueryStats(ctx)
+	if stats == nil {
+		ctx, stats = withQueryStats(ctx)
+	}
+	stats.maxScan = capLimit(budget.GetMaxScan(), g.limits.maxScan)
+	stats.maxEvaluations = capLimit(budget.GetMaxEvaluations(), g.limits.maxEvaluations)
+	var timeout time.Duration
+	if !stream {
+		timeout = g.limits.queryTimeout
+	}
+	if requested := budget.GetTimeout().AsDuration(); requested > 0 && (timeout == 0 || requested < timeout) {
+		timeout = requested
+	}
+	parent := ctx
+	cancel := func() {}
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	}
+	start := time.Now()
+	return ctx, func(results int, seek string, err error) error {
+		// only the budget's timeout is a budget error - not the client's deadline
+		timedOut := ctx.Err() == context.DeadlineExceeded && parent.Err() == nil
+		cancel()
+		if elapsed := time.Since(start); g.limits.slowQueryThreshold > 0 && elapsed >= g.limits.slowQueryThreshold {
+			bits, _ := helpers.MarshalJSON(filter)
+			logger.Warn("slow query",
+				zap.String("user", g.getIdentity(ctx).GetRef().GetGid()),
+				zap.String("method", g.getMethod(ctx)),
+				zap.String("filter", string(bits)),
+				zap.Duration("duration", elapsed),
+				zap.Uint64("keys_scanned", stats.keysScanned),
+				zap.Uint64("evaluations", stats.evaluations),
+				zap.Int("results", results),
+			)
+		}
+		if err == nil {
+			return nil
+		}
+		if _, ok := err.(*budgetError); !ok {
+			if !timedOut {
+				return err
+			}
+			err = &budgetError{limit: "timeout", value: timeout}
+		}
+		grpc.SetTrailer(ctx, metadata.Pairs(
+			"x-graphik-partial-results", strconv.Itoa(results),
+			"x-graphik-seek-next", seek,
+		))
+		return status.Errorf(codes.ResourceExhausted, "%s - %v partial results(seek_next: %s)", err.Error(), results, seek)
+	}
+}
+
+func (s *queryStats) exceed(limit string, value interface{}) {
+	if s.exceeded == nil {
+		s.exceeded = &budgetError{limit: limit, value: value}
+	}
+}
+
+// err returns the budget error of a query that exceeded it's budget
+func (s *queryStats) err() error {
+	if s == nil || s.exceeded == nil {
+		return nil
+	}
+	return s.exceeded
+}
@@ -0,0 +1,122 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"go.etcd.io/bbolt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"testing"
+	"time"
+)
+
+// trailerStream records the trailers set by a unary rpc
+type trailerStream struct {
+	trailer metadata.MD
+}
+
+func (s *trailerStream) Method() string { return "" }
+
+func (s *trailerStream) SetHeader(md metadata.MD) error { return nil }
+
+func (s *trailerStream) SendHeader(md metadata.MD) error { return nil }
+
+func (s *trailerStream) SetTrailer(md metadata.MD) error {
+	s.trailer = metadata.Join(s.trailer, md)
+	return nil
+}
+
+func TestBudgetMaxScan(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	for i := 0; i < 20; i++ {
+		createTestDoc(t, g, ctx, "event", fmt.Sprintf("%02d", i), map[string]interface{}{"n": float64(i)})
+	}
+	stream := &trailerStream{}
+	ctx = grpc.NewContextWithServerTransportStream(withMethod(g, ctx, "SearchDocs"), stream)
+	_, err := g.SearchDocs(ctx, &apipb.Filter{
+		Gtype:      "event",
+		Expression: "this.attributes.n >= 0.0",
+		Limit:      100,
+		Budget:     &apipb.Budget{MaxScan: 5},
+	})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted, got %v", err)
+	}
+	if got := stream.trailer.Get("x-graphik-partial-results"); len(got) != 1 || got[0] != "5" {
+		t.Fatalf("expected 5 partial results, got %v", got)
+	}
+	if got := stream.trailer.Get("x-graphik-seek-next"); len(got) != 1 || got[0] == "" {
+		t.Fatalf("expected a seek_next trailer, got %v", got)
+	}
+}
+
+func TestBudgetTraverseTimeout(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	var prev *apipb.Doc
+	for i := 0; i < 10; i++ {
+		doc := createTestDoc(t, g, ctx, "task", fmt.Sprint(i), map[string]interface{}{})
+		if prev != nil {
+			createTestConnection(t, g, ctx, "next", prev.GetRef(), doc.GetRef(), true)
+		}
+		prev = doc
+	}
+	for _, algorithm := range []apipb.Algorithm{apipb.Algorithm_DFS, apipb.Algorithm_BFS} {
+		stream := &trailerStream{}
+		ctx := grpc.NewContextWithServerTransportStream(withMethod(g, ctx, "Traverse"), stream)
+		filter := &apipb.TraverseFilter{
+			Root:      &apipb.Ref{Gtype: "task", Gid: "0"},
+			Limit:     100,
+			Algorithm: algorithm,
+			MaxDepth:  100,
+			MaxHops:   100,
+			Budget:    &apipb.Budget{Timeout: durationpb.New(10 * time.Millisecond)},
+		}
+		var count int
+		// the budget's timeout expires while the root is being emitted - between reads of the db
+		walker, err := g.newTraversal(filter, func(t *apipb.Traversal) error {
+			if count == 0 {
+				time.Sleep(50 * time.Millisecond)
+			}
+			count++
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		walkCtx, finish := g.startQuery(ctx, filter, filter.GetBudget(), false)
+		err = g.db.View(func(tx *bbolt.Tx) error {
+			return walker.Walk(walkCtx, tx)
+		})
+		if err := finish(count, "", err); status.Code(err) != codes.ResourceExhausted {
+			t.Fatalf("%s: expected a timed out traversal to fail with ResourceExhausted, got %v", algorithm, err)
+		}
+		if got := stream.trailer.Get("x-graphik-partial-results"); len(got) != 1 || got[0] != "1" {
+			t.Fatalf("%s: expected 1 partial result, got %v", algorithm, got)
+		}
+	}
+}
+
+func TestBudgetDefaultsToUnlimited(t *testing.T) {
+	l, err := newLimits(&apipb.Flags{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.maxScan != 0 || l.maxEvaluations != 0 || l.queryTimeout != 0 {
+		t.Fatalf("expected unlimited queries by default, got %+v", l)
+	}
+}
+
+func TestTraverseCancelled(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	doc := createTestDoc(t, g, ctx, "task", "1", map[string]interface{}{})
+	cancelled, cancel := context.WithCancel(withMethod(g, ctx, "Traverse"))
+	cancel()
+	_, err := g.Traverse(cancelled, &apipb.TraverseFilter{Root: doc.GetRef(), Limit: 10, MaxDepth: 10, MaxHops: 10})
+	if status.Code(err) != codes.Canceled {
+		t.Fatalf("expected Canceled, got %v", err)
+	}
+}
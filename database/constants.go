@@ -13,6 +13,12 @@ const (
 	userType             ctxKey = "user"
 	methodCtxKey         ctxKey = "x-graphik-full-method"
 	importOverrideCtxKey ctxKey = "x-graphik-import-override"
+	namespaceCtxKey      ctxKey = "x-graphik-namespace"
+	explainCtxKey        ctxKey = "x-graphik-explain"
+	// namespaceHeader is the request metadata key root users may use to select a namespace
+	namespaceHeader = "x-graphik-namespace"
+	// idempotencyKeyHeader is the request metadata key used to set an idempotency key on create requests
+	idempotencyKeyHeader = "x-graphik-idempotency-key"
 )
 
 var (
@@ -25,6 +31,15 @@ var (
 	dbTypeValidators   = []byte("typeValidators")
 	dbIndexDocs        = []byte("indexedDocs")
 	dbIndexConnections = []byte("indexedConnections")
+	dbIdempotency      = []byte("idempotencyKeys")
+	dbNamespaces       = []byte("namespaces")
+	dbMigrations       = []byte("migrations")
+	dbTextIndexes      = []byte("textIndexes")
+	dbGeoIndexes       = []byte("geoIndexes")
+	dbVectorIndexes    = []byte("vectorIndexes")
+	dbValueIndexes     = []byte("valueIndexes")
+	// dbBackfilledIndexes records the expression each EXPRESSION index was last backfilled with
+	dbBackfilledIndexes = []byte("backfilledIndexes")
 	// An error indicating a given key does not exist
 	ErrNotFound             = errors.New("not found")
 	ErrAlreadyExists        = errors.New("already exists")
@@ -1 +1,65 @@
 package database
+
+import (
+	"context"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"testing"
+)
+
+const testRootUser = "root@graphik.io"
+
+// openTestGraph opens a graph(in a temporary directory unless the flags set a storage path) & returns it along with a
+// context authenticated as a root user
+func openTestGraph(t *testing.T, flgs *apipb.Flags) (*Graph, context.Context) {
+	t.Helper()
+	if flgs.GetStoragePath() == "" {
+		flgs.StoragePath = t.TempDir()
+	}
+	flgs.RootUsers = []string{testRootUser}
+	ctx := context.Background()
+	g, err := NewGraph(ctx, flgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(g.Close)
+	user, err := g.createIdentity(ctx, &apipb.DocConstructor{
+		Ref:        &apipb.RefConstructor{Gtype: string(userType), Gid: testRootUser},
+		Attributes: apipb.NewStruct(map[string]interface{}{"email": testRootUser}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g, context.WithValue(ctx, authCtxKey, user)
+}
+
+// withMethod sets the rpc method of the request(used by authorizers, idempotency keys & budgets)
+func withMethod(g *Graph, ctx context.Context, method string) context.Context {
+	return g.methodToContext(ctx, "/api.DatabaseService/"+method)
+}
+
+func createTestDoc(t *testing.T, g *Graph, ctx context.Context, gtype, gid string, attributes map[string]interface{}) *apipb.Doc {
+	t.Helper()
+	doc, err := g.CreateDoc(withMethod(g, ctx, "CreateDoc"), &apipb.DocConstructor{
+		Ref:        &apipb.RefConstructor{Gtype: gtype, Gid: gid},
+		Attributes: apipb.NewStruct(attributes),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+func createTestConnection(t *testing.T, g *Graph, ctx context.Context, gtype string, from, to *apipb.Ref, directed bool) *apipb.Connection {
+	t.Helper()
+	connection, err := g.CreateConnection(withMethod(g, ctx, "CreateConnection"), &apipb.ConnectionConstructor{
+		Ref:        &apipb.RefConstructor{Gtype: gtype},
+		Attributes: apipb.NewStruct(map[string]interface{}{}),
+		Directed:   directed,
+		From:       from,
+		To:         to,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return connection
+}
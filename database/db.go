@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"github.com/google/cel-go/cel"
 	"github.com/graphikDB/graphik/gen/grpc/go"
+	"github.com/graphikDB/graphik/helpers"
 	"github.com/graphikDB/graphik/logger"
+	"github.com/graphikDB/graphik/vm"
 	"github.com/pkg/errors"
+	"github.com/segmentio/ksuid"
 	"go.etcd.io/bbolt"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -18,92 +21,140 @@ import (
 )
 
 type index struct {
-	index   *apipb.Index
-	program cel.Program
+	namespace string
+	index     *apipb.Index
+	program   cel.Program
+	// clauses are the normalized conjuncts of the index's expression(used by the query planner)
+	clauses []string
 }
 
 type authorizer struct {
+	namespace  string
 	authorizer *apipb.Authorizer
 	program    cel.Program
 }
 
 type typeValidator struct {
+	namespace string
 	validator *apipb.TypeValidator
 	program   cel.Program
 }
 
-func (g *Graph) rangeIndexes(fn func(index *index) bool) {
+func (g *Graph) rangeIndexes(ctx context.Context, fn func(index *index) bool) {
+	namespace := g.getNamespace(ctx)
 	g.indexes.Range(func(key, value interface{}) bool {
 		if value == nil {
 			return true
 		}
 		index := value.(*index)
+		if index.namespace != namespace {
+			return true
+		}
 		return fn(index)
 	})
 }
 
-func (g *Graph) cacheConnectionRefs() error {
-	return g.rangeConnections(context.Background(), apipb.Any, func(e *apipb.Connection) bool {
-		g.mu.Lock()
-		defer g.mu.Unlock()
-		if g.connectionsFrom[e.From.String()] == nil {
-			g.connectionsFrom[e.From.String()] = map[string]struct{}{}
+// getIndex returns the cached index with the given name or nil if it doesn't exist
+func (g *Graph) getIndex(ctx context.Context, name string) *index {
+	var i *index
+	g.rangeIndexes(ctx, func(idx *index) bool {
+		if idx.index.GetName() == name {
+			i = idx
+			return false
 		}
-		if g.connectionsTo[e.To.String()] == nil {
-			g.connectionsTo[e.To.String()] = map[string]struct{}{}
+		return true
+	})
+	return i
+}
+
+// compileIndex compiles the index's expression(if any)
+func (g *Graph) compileIndex(ctx context.Context, i *apipb.Index) (*index, error) {
+	ind := &index{
+		namespace: g.getNamespace(ctx),
+		index:     i,
+	}
+	if i.GetExpression() == "" {
+		return ind, nil
+	}
+	var (
+		err     error
+		clauses []vm.Conjunct
+	)
+	if i.GetConnections() {
+		ind.program, err = g.vm.Connection().Program(i.GetExpression())
+		if err == nil {
+			clauses, err = g.vm.Connection().Conjuncts(i.GetExpression())
+		}
+	} else {
+		ind.program, err = g.vm.Doc().Program(i.GetExpression())
+		if err == nil {
+			clauses, err = g.vm.Doc().Conjuncts(i.GetExpression())
 		}
-		refstr := refString(e.GetRef())
-		g.connectionsFrom[e.From.String()][refstr] = struct{}{}
-		g.connectionsTo[e.To.String()][refstr] = struct{}{}
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, clause := range clauses {
+		ind.clauses = append(ind.clauses, clause.Expression)
+	}
+	return ind, nil
+}
+
+func (g *Graph) cacheConnectionRefs(ctx context.Context) error {
+	return g.rangeConnections(ctx, apipb.Any, func(e *apipb.Connection) bool {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		g.cacheConnection(ctx, e)
 		return true
 	})
 }
 
-func (g *Graph) cacheIndexes() error {
+func (g *Graph) cacheIndexes(ctx context.Context) error {
 	return g.db.View(func(tx *bbolt.Tx) error {
-		return tx.Bucket(dbIndexes).ForEach(func(k, v []byte) error {
+		return g.bucket(ctx, tx, dbIndexes).ForEach(func(k, v []byte) error {
 			var i apipb.Index
-			var program cel.Program
-			var err error
 			if err := proto.Unmarshal(v, &i); err != nil {
 				return err
 			}
-			if i.Connections {
-				program, err = g.vm.Connection().Program(i.Expression)
-				if err != nil {
-					return err
-				}
-			} else if i.Docs {
-				program, err = g.vm.Doc().Program(i.Expression)
-				if err != nil {
-					return err
-				}
+			// an index set before encryption was enabled would keep storing values in plaintext
+			if err := g.checkIndexEncryption(&i); err != nil {
+				return err
 			}
-			ind := &index{
-				index:   &i,
-				program: program,
+			ind, err := g.compileIndex(ctx, &i)
+			if err != nil {
+				return err
 			}
-			g.indexes.Set(i.GetName(), ind, 0)
+			g.indexes.Set(g.namespacedKey(ctx, i.GetName()), ind, 0)
 			return nil
 		})
 	})
 }
 
-func (g *Graph) rangeAuthorizers(fn func(a *authorizer) bool) {
+func (g *Graph) rangeAuthorizers(ctx context.Context, fn func(a *authorizer) bool) {
+	namespace := g.getNamespace(ctx)
 	g.authorizers.Range(func(key, value interface{}) bool {
-		return fn(value.(*authorizer))
+		a := value.(*authorizer)
+		if a.namespace != namespace {
+			return true
+		}
+		return fn(a)
 	})
 }
 
-func (g *Graph) rangeTypeValidators(fn func(a *typeValidator) bool) {
+func (g *Graph) rangeTypeValidators(ctx context.Context, fn func(a *typeValidator) bool) {
+	namespace := g.getNamespace(ctx)
 	g.typeValidators.Range(func(key, value interface{}) bool {
-		return fn(value.(*typeValidator))
+		v := value.(*typeValidator)
+		if v.namespace != namespace {
+			return true
+		}
+		return fn(v)
 	})
 }
 
-func (g *Graph) cacheAuthorizers() error {
+func (g *Graph) cacheAuthorizers(ctx context.Context) error {
 	return g.db.View(func(tx *bbolt.Tx) error {
-		return tx.Bucket(dbAuthorizers).ForEach(func(k, v []byte) error {
+		return g.bucket(ctx, tx, dbAuthorizers).ForEach(func(k, v []byte) error {
 			if v == nil {
 				return nil
 			}
@@ -116,7 +167,8 @@ func (g *Graph) cacheAuthorizers() error {
 			if err != nil {
 				return err
 			}
-			g.authorizers.Set(i.GetName(), &authorizer{
+			g.authorizers.Set(g.namespacedKey(ctx, i.GetName()), &authorizer{
+				namespace:  g.getNamespace(ctx),
 				authorizer: &i,
 				program:    program,
 			}, 0)
@@ -125,44 +177,57 @@ func (g *Graph) cacheAuthorizers() error {
 	})
 }
 
-func (g *Graph) cacheTypeValidators() error {
+func (g *Graph) cacheTypeValidators(ctx context.Context) error {
 	return g.db.View(func(tx *bbolt.Tx) error {
-		return tx.Bucket(dbTypeValidators).ForEach(func(k, v []byte) error {
+		return g.bucket(ctx, tx, dbTypeValidators).ForEach(func(k, v []byte) error {
 			if v == nil {
 				return nil
 			}
 			var i apipb.TypeValidator
-			var err error
 			if err := proto.Unmarshal(v, &i); err != nil {
 				return err
 			}
-			var program cel.Program
-			if i.GetConnections() {
-				program, err = g.vm.Connection().Program(i.Expression)
-				if err != nil {
-					return err
-				}
-			}
-			if i.GetDocs() {
-				program, err = g.vm.Doc().Program(i.Expression)
-				if err != nil {
-					return err
-				}
+			validator, err := g.compileTypeValidator(ctx, &i)
+			if err != nil {
+				return err
 			}
-			g.typeValidators.Set(i.GetName(), &typeValidator{
-				validator: &i,
-				program:   program,
-			}, 0)
+			g.typeValidators.Set(g.namespacedKey(ctx, i.GetName()), validator, 0)
 			return nil
 		})
 	})
 }
 
+// compileTypeValidator compiles the validator's expression(if any)
+func (g *Graph) compileTypeValidator(ctx context.Context, v *apipb.TypeValidator) (*typeValidator, error) {
+	var (
+		program cel.Program
+		err     error
+	)
+	// acyclic validators don't require an expression
+	if v.GetConnections() && v.GetExpression() != "" {
+		program, err = g.vm.Connection().Program(v.GetExpression())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if v.GetDocs() && v.GetExpression() != "" {
+		program, err = g.vm.Doc().Program(v.GetExpression())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &typeValidator{
+		namespace: g.getNamespace(ctx),
+		validator: v,
+		program:   program,
+	}, nil
+}
+
 func (g *Graph) setIndex(ctx context.Context, tx *bbolt.Tx, i *apipb.Index) (*apipb.Index, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
-	indexBucket := tx.Bucket(dbIndexes)
+	indexBucket := g.bucket(ctx, tx, dbIndexes)
 	if indexBucket == nil {
 		return nil, errors.New("empty index bucket")
 	}
@@ -177,6 +242,10 @@ func (g *Graph) setIndex(ctx context.Context, tx *bbolt.Tx, i *apipb.Index) (*ap
 		current.Docs = i.Docs
 		current.Gtype = i.Gtype
 		current.Expression = i.Expression
+		current.Kind = i.Kind
+		current.Fields = i.Fields
+		current.Metric = i.Metric
+		current.Dimensions = i.Dimensions
 		bits, err := proto.Marshal(current)
 		if err != nil {
 			return nil, err
@@ -195,19 +264,115 @@ func (g *Graph) setIndex(ctx context.Context, tx *bbolt.Tx, i *apipb.Index) (*ap
 		return nil, err
 	}
 	if i.Connections {
-		tx.Bucket(dbIndexConnections).CreateBucketIfNotExists([]byte(i.GetName()))
+		g.bucket(ctx, tx, dbIndexConnections).CreateBucketIfNotExists([]byte(i.GetName()))
 	}
-	if i.Docs {
-		tx.Bucket(dbIndexDocs).CreateBucketIfNotExists([]byte(i.GetName()))
+	if i.Docs && i.GetKind() == apipb.IndexKind_EXPRESSION {
+		g.bucket(ctx, tx, dbIndexDocs).CreateBucketIfNotExists([]byte(i.GetName()))
 	}
 	return i, nil
 }
 
+// backfillIndexes backfills the EXPRESSION indexes that haven't been backfilled with their current expression - ex: indexes
+// set by an older version, which only indexed docs/connections written after the index was set. The planner assumes an
+// EXPRESSION index contains every doc/connection that passes it's expression.
+func (g *Graph) backfillIndexes(ctx context.Context) error {
+	return g.db.Update(func(tx *bbolt.Tx) error {
+		backfilled := g.bucket(ctx, tx, dbBackfilledIndexes)
+		var indexes []*apipb.Index
+		if err := g.bucket(ctx, tx, dbIndexes).ForEach(func(k, v []byte) error {
+			var i apipb.Index
+			if err := proto.Unmarshal(v, &i); err != nil {
+				return err
+			}
+			if i.GetKind() == apipb.IndexKind_EXPRESSION && string(backfilled.Get([]byte(i.GetName()))) != i.GetExpression() {
+				indexes = append(indexes, &i)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		for _, i := range indexes {
+			logger.Info("backfilling index", zap.String("index", i.GetName()))
+			if err := g.reindexExpression(ctx, tx, i); err != nil {
+				return errors.Wrapf(err, "failed to backfill index %s", i.GetName())
+			}
+		}
+		return nil
+	})
+}
+
+// reindex rebuilds an index from the docs/connections that already exist
+func (g *Graph) reindex(ctx context.Context, tx *bbolt.Tx, i *apipb.Index) error {
+	switch i.GetKind() {
+	case apipb.IndexKind_FULL_TEXT:
+		return g.reindexText(ctx, tx, i)
+	case apipb.IndexKind_GEO:
+		return g.reindexGeo(ctx, tx, i)
+	case apipb.IndexKind_VECTOR:
+		return g.reindexVector(ctx, tx, i)
+	case apipb.IndexKind_VALUE:
+		return g.reindexValue(ctx, tx, i)
+	default:
+		return g.reindexExpression(ctx, tx, i)
+	}
+}
+
+// reindexExpression rebuilds an EXPRESSION index from every doc/connection of the index's type
+func (g *Graph) reindexExpression(ctx context.Context, tx *bbolt.Tx, i *apipb.Index) error {
+	ind, err := g.compileIndex(ctx, i)
+	if err != nil {
+		return err
+	}
+	parent, source := g.bucket(ctx, tx, dbIndexDocs), g.bucket(ctx, tx, dbDocs)
+	if i.GetConnections() {
+		parent, source = g.bucket(ctx, tx, dbIndexConnections), g.bucket(ctx, tx, dbConnections)
+	}
+	if parent.Bucket([]byte(i.GetName())) != nil {
+		if err := parent.DeleteBucket([]byte(i.GetName())); err != nil {
+			return err
+		}
+	}
+	bucket, err := parent.CreateBucket([]byte(i.GetName()))
+	if err != nil {
+		return err
+	}
+	if err := g.bucket(ctx, tx, dbBackfilledIndexes).Put([]byte(i.GetName()), []byte(i.GetExpression())); err != nil {
+		return err
+	}
+	items := source.Bucket([]byte(i.GetGtype()))
+	if items == nil {
+		return nil
+	}
+	return items.ForEach(func(k, v []byte) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var pass bool
+		if i.GetConnections() {
+			var connection apipb.Connection
+			if err := g.unmarshal(v, &connection); err != nil {
+				return err
+			}
+			pass, _ = g.vm.Connection().Eval(&connection, ind.program)
+		} else {
+			var doc apipb.Doc
+			if err := g.unmarshal(v, &doc); err != nil {
+				return err
+			}
+			pass, _ = g.vm.Doc().Eval(&doc, ind.program)
+		}
+		if !pass {
+			return nil
+		}
+		return bucket.Put(k, v)
+	})
+}
+
 func (g *Graph) setAuthorizer(ctx context.Context, tx *bbolt.Tx, i *apipb.Authorizer) (*apipb.Authorizer, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
-	authBucket := tx.Bucket(dbAuthorizers)
+	authBucket := g.bucket(ctx, tx, dbAuthorizers)
 	val := authBucket.Get([]byte(i.GetName()))
 	if val != nil && len(val) > 0 {
 		var current = &apipb.Authorizer{}
@@ -240,7 +405,7 @@ func (g *Graph) setTypedValidator(ctx context.Context, tx *bbolt.Tx, i *apipb.Ty
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
-	validatorBucket := tx.Bucket(dbTypeValidators)
+	validatorBucket := g.bucket(ctx, tx, dbTypeValidators)
 	val := validatorBucket.Get([]byte(i.GetName()))
 	if val != nil && len(val) > 0 {
 		var current = &apipb.TypeValidator{}
@@ -252,6 +417,7 @@ func (g *Graph) setTypedValidator(ctx context.Context, tx *bbolt.Tx, i *apipb.Ty
 		current.Docs = i.Docs
 		current.Connections = i.Connections
 		current.Gtype = i.Gtype
+		current.Acyclic = i.Acyclic
 		bits, err := proto.Marshal(current)
 		if err != nil {
 			return nil, err
@@ -276,7 +442,7 @@ func (g *Graph) setIndexedDoc(ctx context.Context, tx *bbolt.Tx, index string, g
 	if err := ctx.Err(); err != nil {
 		return err
 	}
-	bucket := tx.Bucket(dbIndexDocs).Bucket([]byte(index))
+	bucket := g.bucket(ctx, tx, dbIndexDocs).Bucket([]byte(index))
 	if bucket == nil {
 		return ErrNotFound
 	}
@@ -290,7 +456,7 @@ func (g *Graph) setIndexedConnection(ctx context.Context, tx *bbolt.Tx, index, g
 	if err := ctx.Err(); err != nil {
 		return err
 	}
-	bucket := tx.Bucket(dbIndexConnections).Bucket([]byte(index))
+	bucket := g.bucket(ctx, tx, dbIndexConnections).Bucket([]byte(index))
 	if bucket == nil {
 		return ErrNotFound
 	}
@@ -304,7 +470,7 @@ func (g *Graph) delIndexedDoc(ctx context.Context, tx *bbolt.Tx, index, gid []by
 	if err := ctx.Err(); err != nil {
 		return err
 	}
-	bucket := tx.Bucket(dbIndexDocs).Bucket(index)
+	bucket := g.bucket(ctx, tx, dbIndexDocs).Bucket(index)
 	if bucket == nil {
 		return ErrNotFound
 	}
@@ -318,7 +484,7 @@ func (g *Graph) delIndexedConnection(ctx context.Context, tx *bbolt.Tx, index, g
 	if err := ctx.Err(); err != nil {
 		return err
 	}
-	bucket := tx.Bucket(dbIndexConnections).Bucket(index)
+	bucket := g.bucket(ctx, tx, dbIndexConnections).Bucket(index)
 	if bucket == nil {
 		return ErrNotFound
 	}
@@ -333,7 +499,7 @@ func (g *Graph) setDoc(ctx context.Context, tx *bbolt.Tx, doc *apipb.Doc) (*apip
 		return nil, err
 	}
 	var validationErr error
-	g.rangeTypeValidators(func(v *typeValidator) bool {
+	g.rangeTypeValidators(ctx, func(v *typeValidator) bool {
 		if v.validator.GetDocs() && v.validator.GetGtype() == doc.GetRef().GetGtype() {
 			res, err := g.vm.Doc().Eval(doc, v.program)
 			if err != nil {
@@ -350,11 +516,11 @@ func (g *Graph) setDoc(ctx context.Context, tx *bbolt.Tx, doc *apipb.Doc) (*apip
 	if validationErr != nil {
 		return nil, status.Error(codes.InvalidArgument, validationErr.Error())
 	}
-	bits, err := proto.Marshal(doc)
+	bits, err := g.marshal(doc)
 	if err != nil {
 		return nil, err
 	}
-	docBucket := tx.Bucket(dbDocs)
+	docBucket := g.bucket(ctx, tx, dbDocs)
 	bucket := docBucket.Bucket([]byte(doc.GetRef().GetGtype()))
 	if bucket == nil {
 		bucket, err = docBucket.CreateBucketIfNotExists([]byte(doc.GetRef().GetGtype()))
@@ -365,8 +531,31 @@ func (g *Graph) setDoc(ctx context.Context, tx *bbolt.Tx, doc *apipb.Doc) (*apip
 	if err := bucket.Put([]byte(doc.GetRef().GetGid()), bits); err != nil {
 		return nil, err
 	}
-	g.rangeIndexes(func(i *index) bool {
-		if i.index.Docs {
+	var indexErr error
+	g.rangeIndexes(ctx, func(i *index) bool {
+		switch i.index.GetKind() {
+		case apipb.IndexKind_FULL_TEXT:
+			if i.index.Docs && i.index.GetGtype() == doc.GetRef().GetGtype() {
+				indexErr = g.setTextIndexedDoc(ctx, tx, i, doc)
+			}
+			return indexErr == nil
+		case apipb.IndexKind_GEO:
+			if i.index.Docs && i.index.GetGtype() == doc.GetRef().GetGtype() {
+				indexErr = g.setGeoIndexedDoc(ctx, tx, i, doc)
+			}
+			return indexErr == nil
+		case apipb.IndexKind_VECTOR:
+			if i.index.Docs && i.index.GetGtype() == doc.GetRef().GetGtype() {
+				indexErr = g.setVectorIndexedDoc(ctx, tx, i, doc)
+			}
+			return indexErr == nil
+		case apipb.IndexKind_VALUE:
+			if i.index.Docs && i.index.GetGtype() == doc.GetRef().GetGtype() {
+				indexErr = g.setValueIndexedDoc(ctx, tx, i, doc)
+			}
+			return indexErr == nil
+		}
+		if i.index.Docs && i.index.GetGtype() == doc.GetRef().GetGtype() {
 			result, err := g.vm.Doc().Eval(doc, i.program)
 			if err != nil {
 				if !strings.Contains(err.Error(), "no such key") {
@@ -379,11 +568,17 @@ func (g *Graph) setDoc(ctx context.Context, tx *bbolt.Tx, doc *apipb.Doc) (*apip
 					logger.Error("failed to save index", zap.Error(err))
 					return true
 				}
+			} else {
+				// the doc may have passed the expression before it was edited
+				g.delIndexedDoc(ctx, tx, []byte(i.index.Name), []byte(doc.GetRef().GetGid()))
 			}
 		}
 		return true
 	})
-	if err := g.machine.PubSub().Publish(changeChannel, &apipb.Message{
+	if indexErr != nil {
+		return nil, errors.Wrap(indexErr, "failed to save index")
+	}
+	if err := g.machine.PubSub().Publish(g.namespacedKey(ctx, changeChannel), &apipb.Message{
 		Channel:   changeChannel,
 		Data:      apipb.NewStruct(doc.AsMap()),
 		User:      g.getIdentity(ctx).GetRef(),
@@ -395,6 +590,70 @@ func (g *Graph) setDoc(ctx context.Context, tx *bbolt.Tx, doc *apipb.Doc) (*apip
 	return doc, nil
 }
 
+// setCreatedConnections connects the origin user to a newly created doc(identity graph)
+func (g *Graph) setCreatedConnections(ctx context.Context, tx *bbolt.Tx, user *apipb.Doc, doc *apipb.Doc, method string) error {
+	if doc.GetRef().GetGid() == user.GetRef().GetGid() || doc.GetRef().GetGtype() == user.GetRef().GetGtype() {
+		return nil
+	}
+	_, err := g.setConnection(ctx, tx, &apipb.Connection{
+		Ref: &apipb.Ref{Gtype: "created", Gid: ksuid.New().String()},
+		Attributes: apipb.NewStruct(map[string]interface{}{
+			"method": method,
+		}),
+		Directed: true,
+		From:     user.GetRef(),
+		To:       doc.GetRef(),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = g.setConnection(ctx, tx, &apipb.Connection{
+		Ref: &apipb.Ref{Gtype: "created_by", Gid: ksuid.New().String()},
+		Attributes: apipb.NewStruct(map[string]interface{}{
+			"method": method,
+		}),
+		Directed: true,
+		To:       user.GetRef(),
+		From:     doc.GetRef(),
+	})
+	return err
+}
+
+// setEditedConnections connects the origin user to an edited doc(identity graph) if they aren't already connected
+func (g *Graph) setEditedConnections(ctx context.Context, tx *bbolt.Tx, user *apipb.Doc, doc *apipb.Doc) error {
+	if doc.GetRef().GetGid() == user.GetRef().GetGid() || doc.GetRef().GetGtype() == user.GetRef().GetGtype() {
+		return nil
+	}
+	id := helpers.Hash([]byte(fmt.Sprintf("%s-%s", user.GetRef().String(), doc.GetRef().String())))
+	editedRef := &apipb.Ref{Gid: id, Gtype: "edited"}
+	if !g.hasConnectionFrom(ctx, tx, user.GetRef(), editedRef) {
+		_, err := g.setConnection(ctx, tx, &apipb.Connection{
+			Ref:        editedRef,
+			Attributes: apipb.NewStruct(map[string]interface{}{}),
+			Directed:   true,
+			From:       user.GetRef(),
+			To:         doc.GetRef(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	editedByRef := &apipb.Ref{Gtype: "edited_by", Gid: id}
+	if !g.hasConnectionFrom(ctx, tx, doc.GetRef(), editedByRef) {
+		_, err := g.setConnection(ctx, tx, &apipb.Connection{
+			Ref:        editedByRef,
+			Attributes: apipb.NewStruct(map[string]interface{}{}),
+			Directed:   true,
+			To:         user.GetRef(),
+			From:       doc.GetRef(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (g *Graph) setDocs(ctx context.Context, docs ...*apipb.Doc) (*apipb.Docs, error) {
 	var nds = &apipb.Docs{}
 	if err := g.db.Batch(func(tx *bbolt.Tx) error {
@@ -416,7 +675,7 @@ func (g *Graph) setConnection(ctx context.Context, tx *bbolt.Tx, connection *api
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
-	docBucket := tx.Bucket(dbDocs)
+	docBucket := g.bucket(ctx, tx, dbDocs)
 	{
 		fromBucket := docBucket.Bucket([]byte(connection.GetFrom().GetGtype()))
 		if fromBucket == nil {
@@ -439,16 +698,29 @@ func (g *Graph) setConnection(ctx context.Context, tx *bbolt.Tx, connection *api
 	}
 
 	var validationErr error
-	g.rangeTypeValidators(func(v *typeValidator) bool {
+	g.rangeTypeValidators(ctx, func(v *typeValidator) bool {
 		if v.validator.GetConnections() && v.validator.GetGtype() == connection.GetRef().GetGtype() {
-			res, err := g.vm.Connection().Eval(connection, v.program)
-			if err != nil {
-				validationErr = err
-				return false
+			if v.program != nil {
+				res, err := g.vm.Connection().Eval(connection, v.program)
+				if err != nil {
+					validationErr = err
+					return false
+				}
+				if !res {
+					validationErr = errors.New(fmt.Sprintf("%s.%s connection validation error! validator expression: %s", v.validator.GetGtype(), v.validator.GetName(), v.validator.GetExpression()))
+					return false
+				}
 			}
-			if !res {
-				validationErr = errors.New(fmt.Sprintf("%s.%s connection validation error! validator expression: %s", v.validator.GetGtype(), v.validator.GetName(), v.validator.GetExpression()))
-				return false
+			if v.validator.GetAcyclic() && connection.GetDirected() {
+				cycle, err := g.findCycle(ctx, tx, connection)
+				if err != nil {
+					validationErr = err
+					return false
+				}
+				if cycle != nil {
+					validationErr = errors.Errorf("%s.%s connection validation error! connection would create a cycle: %s", v.validator.GetGtype(), v.validator.GetName(), cycle)
+					return false
+				}
 			}
 		}
 		return true
@@ -456,11 +728,11 @@ func (g *Graph) setConnection(ctx context.Context, tx *bbolt.Tx, connection *api
 	if validationErr != nil {
 		return nil, status.Error(codes.InvalidArgument, validationErr.Error())
 	}
-	bits, err := proto.Marshal(connection)
+	bits, err := g.marshal(connection)
 	if err != nil {
 		return nil, err
 	}
-	bucket := tx.Bucket(dbConnections)
+	bucket := g.bucket(ctx, tx, dbConnections)
 	connectionBucket := bucket.Bucket([]byte(connection.GetRef().GetGtype()))
 	if connectionBucket == nil {
 		connectionBucket, err = bucket.CreateBucketIfNotExists([]byte(connection.GetRef().GetGtype()))
@@ -471,23 +743,11 @@ func (g *Graph) setConnection(ctx context.Context, tx *bbolt.Tx, connection *api
 	if err := connectionBucket.Put([]byte(connection.GetRef().GetGid()), bits); err != nil {
 		return nil, err
 	}
-	refstr := refString(connection.GetRef())
+	g.stageConnection(ctx, tx, connection, true)
 	g.mu.Lock()
 	defer g.mu.Unlock()
-	if g.connectionsFrom[connection.GetFrom().String()] == nil {
-		g.connectionsFrom[connection.GetFrom().String()] = map[string]struct{}{}
-	}
-	if g.connectionsTo[connection.GetTo().String()] == nil {
-		g.connectionsTo[connection.GetTo().String()] = map[string]struct{}{}
-	}
-	g.connectionsFrom[connection.GetFrom().String()][refstr] = struct{}{}
-	g.connectionsTo[connection.GetTo().String()][refstr] = struct{}{}
-	if !connection.Directed {
-		g.connectionsTo[connection.GetFrom().String()][refstr] = struct{}{}
-		g.connectionsFrom[connection.GetTo().String()][refstr] = struct{}{}
-	}
-	g.rangeIndexes(func(i *index) bool {
-		if i.index.Connections {
+	g.rangeIndexes(ctx, func(i *index) bool {
+		if i.index.Connections && i.index.GetGtype() == connection.GetRef().GetGtype() {
 			result, _ := g.vm.Connection().Eval(connection, i.program)
 			if result {
 				err = g.setIndexedConnection(ctx, tx, []byte(i.index.Name), []byte(connection.GetRef().GetGid()), bits)
@@ -495,11 +755,14 @@ func (g *Graph) setConnection(ctx context.Context, tx *bbolt.Tx, connection *api
 					logger.Error("failed to save index", zap.Error(err))
 					return true
 				}
+			} else {
+				// the connection may have passed the expression before it was edited
+				g.delIndexedConnection(ctx, tx, []byte(i.index.Name), []byte(connection.GetRef().GetGid()))
 			}
 		}
 		return true
 	})
-	if err := g.machine.PubSub().Publish(changeChannel, &apipb.Message{
+	if err := g.machine.PubSub().Publish(g.namespacedKey(ctx, changeChannel), &apipb.Message{
 		Channel:   changeChannel,
 		Data:      apipb.NewStruct(connection.AsMap()),
 		User:      g.getIdentity(ctx).GetRef(),
@@ -534,7 +797,7 @@ func (g *Graph) getDoc(ctx context.Context, tx *bbolt.Tx, path *apipb.Ref) (*api
 		return nil, ErrNotFound
 	}
 	var doc apipb.Doc
-	docsBucket := tx.Bucket(dbDocs)
+	docsBucket := g.bucket(ctx, tx, dbDocs)
 	bucket := docsBucket.Bucket([]byte(path.GetGtype()))
 	if bucket == nil {
 		return nil, ErrNotFound
@@ -543,7 +806,7 @@ func (g *Graph) getDoc(ctx context.Context, tx *bbolt.Tx, path *apipb.Ref) (*api
 	if len(bits) == 0 {
 		return nil, ErrNotFound
 	}
-	if err := proto.Unmarshal(bits, &doc); err != nil {
+	if err := g.unmarshal(bits, &doc); err != nil {
 		return nil, err
 	}
 	return &doc, nil
@@ -558,7 +821,7 @@ func (g *Graph) getConnection(ctx context.Context, tx *bbolt.Tx, path *apipb.Ref
 	}
 
 	var connection apipb.Connection
-	bucket := tx.Bucket(dbConnections).Bucket([]byte(path.Gtype))
+	bucket := g.bucket(ctx, tx, dbConnections).Bucket([]byte(path.Gtype))
 	if bucket == nil {
 		return nil, ErrNotFound
 	}
@@ -566,7 +829,7 @@ func (g *Graph) getConnection(ctx context.Context, tx *bbolt.Tx, path *apipb.Ref
 	if len(bits) == 0 {
 		return nil, ErrNotFound
 	}
-	if err := proto.Unmarshal(bits, &connection); err != nil {
+	if err := g.unmarshal(bits, &connection); err != nil {
 		return nil, err
 	}
 	return &connection, nil
@@ -589,7 +852,7 @@ func (g *Graph) rangeConnections(ctx context.Context, gType string, fn func(n *a
 			}
 			return nil
 		}
-		bucket := tx.Bucket(dbConnections).Bucket([]byte(gType))
+		bucket := g.bucket(ctx, tx, dbConnections).Bucket([]byte(gType))
 		if bucket == nil {
 			return ErrNotFound
 		}
@@ -599,7 +862,7 @@ func (g *Graph) rangeConnections(ctx context.Context, gType string, fn func(n *a
 				return ctx.Err()
 			}
 			var connection apipb.Connection
-			if err := proto.Unmarshal(v, &connection); err != nil {
+			if err := g.unmarshal(v, &connection); err != nil {
 				return err
 			}
 			if !fn(&connection) {
@@ -631,7 +894,7 @@ func (g *Graph) rangeDocs(ctx context.Context, gType string, fn func(n *apipb.Do
 			}
 			return nil
 		}
-		bucket := tx.Bucket(dbDocs).Bucket([]byte(gType))
+		bucket := g.bucket(ctx, tx, dbDocs).Bucket([]byte(gType))
 		if bucket == nil {
 			return ErrNotFound
 		}
@@ -641,7 +904,7 @@ func (g *Graph) rangeDocs(ctx context.Context, gType string, fn func(n *apipb.Do
 				return ctx.Err()
 			}
 			var doc apipb.Doc
-			if err := proto.Unmarshal(v, &doc); err != nil {
+			if err := g.unmarshal(v, &doc); err != nil {
 				return err
 			}
 			if !fn(&doc) {
@@ -663,7 +926,7 @@ func (g *Graph) createIdentity(ctx context.Context, constructor *apipb.DocConstr
 	)
 
 	if err := g.db.Update(func(tx *bbolt.Tx) error {
-		docBucket := tx.Bucket(dbDocs)
+		docBucket := g.bucket(ctx, tx, dbDocs)
 		bucket := docBucket.Bucket([]byte(constructor.GetRef().GetGtype()))
 		if bucket == nil {
 			bucket, err = docBucket.CreateBucket([]byte(constructor.GetRef().GetGtype()))
@@ -692,7 +955,7 @@ func (g *Graph) delDoc(ctx context.Context, tx *bbolt.Tx, path *apipb.Ref) error
 	if err != nil {
 		return err
 	}
-	bucket := tx.Bucket(dbDocs).Bucket([]byte(doc.GetRef().GetGtype()))
+	bucket := g.bucket(ctx, tx, dbDocs).Bucket([]byte(doc.GetRef().GetGtype()))
 	g.rangeFrom(ctx, tx, path, func(e *apipb.Connection) bool {
 		g.delConnection(ctx, tx, e.GetRef())
 		return true
@@ -701,13 +964,24 @@ func (g *Graph) delDoc(ctx context.Context, tx *bbolt.Tx, path *apipb.Ref) error
 		g.delConnection(ctx, tx, e.GetRef())
 		return true
 	})
-	g.rangeIndexes(func(index *index) bool {
+	g.rangeIndexes(ctx, func(index *index) bool {
 		if index.index.Docs && index.index.GetGtype() == path.GetGtype() {
-			g.delIndexedDoc(ctx, tx, []byte(index.index.Name), []byte(path.GetGid()))
+			switch index.index.GetKind() {
+			case apipb.IndexKind_FULL_TEXT:
+				g.delTextIndexedDoc(ctx, tx, index.index.Name, path.GetGid())
+			case apipb.IndexKind_GEO:
+				g.delGeoIndexedDoc(ctx, tx, index.index.Name, path.GetGid())
+			case apipb.IndexKind_VECTOR:
+				g.delVectorIndexedDoc(ctx, tx, index.index.Name, path.GetGid())
+			case apipb.IndexKind_VALUE:
+				g.delValueIndexedDoc(ctx, tx, index.index.Name, path.GetGid())
+			default:
+				g.delIndexedDoc(ctx, tx, []byte(index.index.Name), []byte(path.GetGid()))
+			}
 		}
 		return true
 	})
-	if err := g.machine.PubSub().Publish(changeChannel, &apipb.Message{
+	if err := g.machine.PubSub().Publish(g.namespacedKey(ctx, changeChannel), &apipb.Message{
 		Channel:   changeChannel,
 		Data:      apipb.NewStruct(path.AsMap()),
 		User:      g.getIdentity(ctx).GetRef(),
@@ -722,29 +996,38 @@ func (g *Graph) delDoc(ctx context.Context, tx *bbolt.Tx, path *apipb.Ref) error
 	return nil
 }
 
+// connectionRefs returns the refs of every connection from or to the doc(the connections delDoc removes along with the doc)
+func (g *Graph) connectionRefs(ctx context.Context, tx *bbolt.Tx, path *apipb.Ref) []*apipb.Ref {
+	var refs []*apipb.Ref
+	seen := map[string]struct{}{}
+	fn := func(e *apipb.Connection) bool {
+		if _, ok := seen[refString(e.GetRef())]; !ok {
+			seen[refString(e.GetRef())] = struct{}{}
+			refs = append(refs, e.GetRef())
+		}
+		return true
+	}
+	g.rangeFrom(ctx, tx, path, fn)
+	g.rangeTo(ctx, tx, path, fn)
+	return refs
+}
+
 func (g *Graph) delConnection(ctx context.Context, tx *bbolt.Tx, path *apipb.Ref) error {
 	connection, err := g.getConnection(ctx, tx, path)
 	if err != nil {
 		return err
 	}
-	g.mu.Lock()
-	if g.connectionsFrom != nil {
-		delete(g.connectionsFrom[connection.GetFrom().String()], refString(path))
-	}
-	if g.connectionsTo != nil {
-		delete(g.connectionsTo[connection.GetTo().String()], refString(path))
-	}
-	g.mu.Unlock()
-	g.rangeIndexes(func(index *index) bool {
+	g.stageConnection(ctx, tx, connection, false)
+	g.rangeIndexes(ctx, func(index *index) bool {
 		if index.index.Connections && index.index.GetGtype() == path.GetGtype() {
 			g.delIndexedConnection(ctx, tx, []byte(index.index.Name), []byte(path.GetGid()))
 		}
 		return true
 	})
-	if err := tx.Bucket(dbConnections).Bucket([]byte(connection.GetRef().GetGtype())).Delete([]byte(connection.GetRef().GetGid())); err != nil {
+	if err := g.bucket(ctx, tx, dbConnections).Bucket([]byte(connection.GetRef().GetGtype())).Delete([]byte(connection.GetRef().GetGid())); err != nil {
 		return err
 	}
-	if err := g.machine.PubSub().Publish(changeChannel, &apipb.Message{
+	if err := g.machine.PubSub().Publish(g.namespacedKey(ctx, changeChannel), &apipb.Message{
 		Channel:   changeChannel,
 		Data:      apipb.NewStruct(path.AsMap()),
 		User:      g.getIdentity(ctx).GetRef(),
@@ -774,26 +1057,24 @@ func (n *Graph) filterDoc(ctx context.Context, docType string, filter func(doc *
 
 func (g *Graph) rangeTo(ctx context.Context, tx *bbolt.Tx, docRef *apipb.Ref, fn func(e *apipb.Connection) bool) error {
 	g.mu.RLock()
-	pathMap := g.connectionsTo[docRef.String()]
+	paths := g.adjacent(tx, g.namespacedKey(ctx, docRef.String()), false)
 	g.mu.RUnlock()
-	var paths []string
-	for path, _ := range pathMap {
-		paths = append(paths, path)
-	}
 	sort.Strings(paths)
 	for _, path := range paths {
 		if err := ctx.Err(); err != nil {
 			return err
 		}
-		var ref apipb.Ref
-		proto.Unmarshal([]byte(path), &ref)
-		bucket := tx.Bucket(dbConnections).Bucket([]byte(ref.GetGtype()))
+		ref := fromRefString(path)
+		bucket := g.bucket(ctx, tx, dbConnections).Bucket([]byte(ref.GetGtype()))
 		if bucket == nil {
 			return ErrNotFound
 		}
-		var connection apipb.Connection
 		bits := bucket.Get([]byte(ref.GetGid()))
-		if err := proto.Unmarshal(bits, &connection); err != nil {
+		if bits == nil {
+			continue
+		}
+		var connection apipb.Connection
+		if err := g.unmarshal(bits, &connection); err != nil {
 			return err
 		}
 		if !fn(&connection) {
@@ -805,25 +1086,24 @@ func (g *Graph) rangeTo(ctx context.Context, tx *bbolt.Tx, docRef *apipb.Ref, fn
 
 func (g *Graph) rangeFrom(ctx context.Context, tx *bbolt.Tx, docRef *apipb.Ref, fn func(e *apipb.Connection) bool) error {
 	g.mu.RLock()
-	pathMap := g.connectionsFrom[docRef.String()]
+	paths := g.adjacent(tx, g.namespacedKey(ctx, docRef.String()), true)
 	g.mu.RUnlock()
-	var paths []string
-	for path, _ := range pathMap {
-		paths = append(paths, path)
-	}
 	sort.Strings(paths)
 	for _, val := range paths {
 		if err := ctx.Err(); err != nil {
 			return err
 		}
 		path := fromRefString(val)
-		bucket := tx.Bucket(dbConnections).Bucket([]byte(path.Gtype))
+		bucket := g.bucket(ctx, tx, dbConnections).Bucket([]byte(path.Gtype))
 		if bucket == nil {
 			return ErrNotFound
 		}
-		var connection apipb.Connection
 		bits := bucket.Get([]byte(path.GetGid()))
-		if err := proto.Unmarshal(bits, &connection); err != nil {
+		if bits == nil {
+			continue
+		}
+		var connection apipb.Connection
+		if err := g.unmarshal(bits, &connection); err != nil {
 			return err
 		}
 		if !fn(&connection) {
@@ -833,134 +1113,250 @@ func (g *Graph) rangeFrom(ctx context.Context, tx *bbolt.Tx, docRef *apipb.Ref,
 	return nil
 }
 
-func (g *Graph) rangeSeekConnections(ctx context.Context, gType string, seek string, index string, reverse bool, fn func(e *apipb.Connection) bool) (string, error) {
+// rangeTypes executes fn against the connections of the given types in the given direction from a doc until fn returns
+// false. Connections of other types are skipped without being read & connections that are both to & from the doc are only
+// passed to fn once when the direction is BOTH.
+func (g *Graph) rangeTypes(ctx context.Context, tx *bbolt.Tx, docRef *apipb.Ref, direction apipb.Direction, gtypes map[string]struct{}, fn func(e *apipb.Connection) bool) error {
+	var (
+		key   = g.namespacedKey(ctx, docRef.String())
+		paths []string
+		seen  = map[string]struct{}{}
+	)
+	g.mu.RLock()
+	if direction != apipb.Direction_IN {
+		for _, path := range g.adjacent(tx, key, true) {
+			seen[path] = struct{}{}
+			paths = append(paths, path)
+		}
+	}
+	if direction != apipb.Direction_OUT {
+		for _, path := range g.adjacent(tx, key, false) {
+			if _, ok := seen[path]; !ok {
+				paths = append(paths, path)
+			}
+		}
+	}
+	g.mu.RUnlock()
+	sort.Strings(paths)
+	for _, val := range paths {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		path := fromRefString(val)
+		if _, ok := gtypes[path.GetGtype()]; !ok {
+			continue
+		}
+		connection, err := g.getConnection(ctx, tx, path)
+		if err != nil {
+			if err == ErrNotFound {
+				continue
+			}
+			return err
+		}
+		if !fn(connection) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// rangeDirection executes fn against the connections of a doc in the given direction until fn returns false. Connections
+// that are both to & from the doc(self-connections) are only passed to fn once when the direction is BOTH.
+func (g *Graph) rangeDirection(ctx context.Context, tx *bbolt.Tx, docRef *apipb.Ref, direction apipb.Direction, fn func(e *apipb.Connection) bool) error {
+	switch direction {
+	case apipb.Direction_OUT:
+		return g.rangeFrom(ctx, tx, docRef, fn)
+	case apipb.Direction_IN:
+		return g.rangeTo(ctx, tx, docRef, fn)
+	}
+	var (
+		seen = map[string]struct{}{}
+		halt bool
+	)
+	if err := g.rangeFrom(ctx, tx, docRef, func(e *apipb.Connection) bool {
+		seen[refString(e.GetRef())] = struct{}{}
+		halt = !fn(e)
+		return !halt
+	}); err != nil || halt {
+		return err
+	}
+	return g.rangeTo(ctx, tx, docRef, func(e *apipb.Connection) bool {
+		if _, ok := seen[refString(e.GetRef())]; ok {
+			return true
+		}
+		return fn(e)
+	})
+}
+
+// rangeSeekConnections executes fn against each connection of the given type that may pass the expression until fn
+// returns false. The query planner picks the access path unless an index is given.
+func (g *Graph) rangeSeekConnections(ctx context.Context, gType, expression, seek, index string, reverse bool, fn func(e *apipb.Connection) bool) (string, error) {
 	if ctx.Err() != nil {
 		return seek, ctx.Err()
 	}
-	var lastKey []byte
+	var (
+		lastKey []byte
+		stats   = getQueryStats(ctx)
+	)
 	if err := g.db.View(func(tx *bbolt.Tx) error {
-		var c *bbolt.Cursor
-		if index != "" {
-			bucket := tx.Bucket(dbIndexConnections).Bucket([]byte(index))
-			if bucket == nil {
-				return ErrNotFound
-			}
-			c = bucket.Cursor()
+		stopPlan := stats.phase("plan")
+		plan := g.planConnections(ctx, tx, gType, expression, index)
+		stopPlan()
+		var bucket *bbolt.Bucket
+		if plan.index != "" {
+			bucket = g.bucket(ctx, tx, dbIndexConnections).Bucket([]byte(plan.index))
 		} else {
-			bucket := tx.Bucket(dbConnections).Bucket([]byte(gType))
-			if bucket == nil {
-				return ErrNotFound
-			}
-			c = bucket.Cursor()
+			bucket = g.bucket(ctx, tx, dbConnections).Bucket([]byte(gType))
 		}
-		var iter = c.Next
-		if reverse {
-			iter = c.Prev
+		if bucket == nil {
+			return ErrNotFound
 		}
-		if seek != "" {
-			for k, v := c.Seek([]byte(seek)); k != nil; k, v = iter() {
-				if ctx.Err() != nil {
-					return ctx.Err()
-				}
-				var connection apipb.Connection
-				if err := proto.Unmarshal(v, &connection); err != nil {
-					return err
-				}
-				lastKey = k
-				if !fn(&connection) {
-					return DONE
-				}
+		defer stats.phase("scan")()
+		return rangeSeek(ctx, bucket.Cursor(), seek, reverse, func(k, v []byte) error {
+			if err := stats.scanned(); err != nil {
+				return err
 			}
-		} else {
-			for k, v := c.First(); k != nil; k, v = iter() {
-				if ctx.Err() != nil {
-					return ctx.Err()
-				}
-				var connection apipb.Connection
-				if err := proto.Unmarshal(v, &connection); err != nil {
-					return err
-				}
-				lastKey = k
-				if !fn(&connection) {
-					return DONE
-				}
+			var connection apipb.Connection
+			if err := g.unmarshal(v, &connection); err != nil {
+				return err
 			}
-		}
-		return nil
+			stats.decodedConnection()
+			lastKey = k
+			// expression indexes may contain connections of other types
+			if gType != apipb.Any && connection.GetRef().GetGtype() != gType {
+				return nil
+			}
+			if !fn(&connection) {
+				return DONE
+			}
+			return nil
+		})
 	}); err != nil && err != DONE {
 		return string(lastKey), err
 	}
 	return string(lastKey), nil
 }
 
-func (g *Graph) rangeSeekDocs(ctx context.Context, gType string, seek string, index string, reverse bool, fn func(e *apipb.Doc) bool) (string, error) {
+// rangeSeekDocs executes fn against each doc of the given type that may pass the expression until fn returns false.
+// The query planner picks the access path unless an index is given.
+func (g *Graph) rangeSeekDocs(ctx context.Context, gType, expression, seek, index string, reverse bool, fn func(e *apipb.Doc) bool) (string, error) {
 	if ctx.Err() != nil {
 		return seek, ctx.Err()
 	}
-	var lastKey []byte
+	var (
+		lastKey []byte
+		stats   = getQueryStats(ctx)
+	)
 	if err := g.db.View(func(tx *bbolt.Tx) error {
-		var c *bbolt.Cursor
-		if index != "" {
-			bucket := tx.Bucket(dbIndexDocs).Bucket([]byte(index))
-			if bucket == nil {
-				return ErrNotFound
-			}
-			c = bucket.Cursor()
+		stopPlan := stats.phase("plan")
+		plan := g.planDocs(ctx, tx, gType, expression, index)
+		stopPlan()
+		var bucket *bbolt.Bucket
+		if plan.index != "" {
+			bucket = g.bucket(ctx, tx, dbIndexDocs).Bucket([]byte(plan.index))
 		} else {
-			bucket := tx.Bucket(dbDocs).Bucket([]byte(gType))
-			if bucket == nil {
-				return ErrNotFound
-			}
-			c = bucket.Cursor()
+			bucket = g.bucket(ctx, tx, dbDocs).Bucket([]byte(gType))
 		}
-		var iter = c.Next
-		if reverse {
-			iter = c.Prev
+		if bucket == nil {
+			return ErrNotFound
 		}
-		if seek != "" {
-			for k, v := c.Seek([]byte(seek)); k != nil; k, v = iter() {
-				if ctx.Err() != nil {
-					return ctx.Err()
-				}
-				var doc apipb.Doc
-				if err := proto.Unmarshal(v, &doc); err != nil {
-					return err
-				}
-				lastKey = k
-				if !fn(&doc) {
-					return DONE
-				}
+		defer stats.phase("scan")()
+		rangeFn := func(k, v []byte) error {
+			if err := stats.scanned(); err != nil {
+				return err
 			}
-		} else {
-			for k, v := c.First(); k != nil; k, v = iter() {
-				if ctx.Err() != nil {
-					return ctx.Err()
-				}
-				var doc apipb.Doc
-				if err := proto.Unmarshal(v, &doc); err != nil {
-					return err
-				}
-				lastKey = k
-				if !fn(&doc) {
-					return DONE
-				}
+			var doc apipb.Doc
+			if err := g.unmarshal(v, &doc); err != nil {
+				return err
+			}
+			stats.decodedDoc()
+			lastKey = k
+			// expression indexes may contain docs of other types
+			if gType != apipb.Any && doc.GetRef().GetGtype() != gType {
+				return nil
 			}
+			if !fn(&doc) {
+				return DONE
+			}
+			return nil
 		}
-		return nil
+		if plan.values != nil {
+			values := g.valueIndexBucket(ctx, tx, plan.values.index)
+			if values == nil {
+				return ErrNotFound
+			}
+			gids := plan.values.gids(values)
+			// the value index keys that were scanned to find the gids
+			if err := stats.scannedKeys(uint64(len(gids))); err != nil {
+				return err
+			}
+			return rangeSeekGids(ctx, bucket, gids, seek, reverse, rangeFn)
+		}
+		return rangeSeek(ctx, bucket.Cursor(), seek, reverse, rangeFn)
 	}); err != nil && err != DONE {
 		return string(lastKey), err
 	}
 	return string(lastKey), nil
 }
 
-func (g *Graph) hasConnectionFrom(doc, connection *apipb.Ref) bool {
+// rangeSeek executes fn against each key/value pair starting from the seek key(or the first/last key) until fn returns an error
+func rangeSeek(ctx context.Context, c *bbolt.Cursor, seek string, reverse bool, fn func(k, v []byte) error) error {
+	var (
+		k, v []byte
+		iter = c.Next
+	)
+	switch {
+	case seek != "":
+		k, v = c.Seek([]byte(seek))
+	case reverse:
+		k, v = c.Last()
+	default:
+		k, v = c.First()
+	}
+	if reverse {
+		iter = c.Prev
+	}
+	for ; k != nil; k, v = iter() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rangeSeekGids executes fn against the value of each gid(sorted) in the bucket starting from the seek gid(or the
+// first/last gid) until fn returns an error
+func rangeSeekGids(ctx context.Context, bucket *bbolt.Bucket, gids []string, seek string, reverse bool, fn func(k, v []byte) error) error {
+	start, step := 0, 1
+	if reverse {
+		start, step = len(gids)-1, -1
+	}
+	if seek != "" {
+		// like a cursor seek - the first gid >= seek
+		start = sort.SearchStrings(gids, seek)
+	}
+	for x := start; x >= 0 && x < len(gids); x += step {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		v := bucket.Get([]byte(gids[x]))
+		if v == nil {
+			continue
+		}
+		if err := fn([]byte(gids[x]), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *Graph) hasConnectionFrom(ctx context.Context, tx *bbolt.Tx, doc, connection *apipb.Ref) bool {
 	g.mu.RLock()
 	defer g.mu.RUnlock()
-	values := g.connectionsFrom[doc.String()]
-	if values == nil {
-		return false
-	}
-	for path, _ := range values {
+	for _, path := range g.adjacent(tx, g.namespacedKey(ctx, doc.String()), true) {
 		val := fromRefString(path)
 		if val.Gid == connection.Gid && val.GetGtype() == connection.GetGtype() {
 			return true
@@ -969,14 +1365,10 @@ func (g *Graph) hasConnectionFrom(doc, connection *apipb.Ref) bool {
 	return false
 }
 
-func (g *Graph) hasConnectionTo(doc, connection *apipb.Ref) bool {
+func (g *Graph) hasConnectionTo(ctx context.Context, tx *bbolt.Tx, doc, connection *apipb.Ref) bool {
 	g.mu.RLock()
 	defer g.mu.RUnlock()
-	values := g.connectionsTo[doc.String()]
-	if values == nil {
-		return false
-	}
-	for path, _ := range values {
+	for _, path := range g.adjacent(tx, g.namespacedKey(ctx, doc.String()), false) {
 		val := fromRefString(path)
 		if val.Gid == connection.Gid && val.GetGtype() == connection.GetGtype() {
 			return true
@@ -0,0 +1,323 @@
+package database
+
+import (
+	"container/heap"
+	"context"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"math"
+	"sort"
+	"strings"
+)
+
+// degree is the number of connections of a single type to & from a doc
+type degree struct {
+	in  uint64
+	out uint64
+}
+
+// adjacencyChanges are the connections written by an open write transaction. They're only applied to the adjacency lists
+// & degrees once the transaction commits, so a rolled back transaction never leaves phantom connections behind.
+type adjacencyChanges struct {
+	tx      *bbolt.Tx
+	changes []func()
+	// from & to are the changes to each doc's adjacency lists made by the transaction - true if the connection was
+	// added & false if it was removed
+	from map[string]map[string]bool
+	to   map[string]map[string]bool
+}
+
+func (a *adjacencyChanges) set(adjacency map[string]map[string]bool, doc, refstr string, added bool) {
+	if adjacency[doc] == nil {
+		adjacency[doc] = map[string]bool{}
+	}
+	adjacency[doc][refstr] = added
+}
+
+// stageConnection records that the connection was written(or deleted if cached is false) by tx. The adjacency lists &
+// degrees are updated once tx commits - until then the change is only visible to tx itself.
+func (g *Graph) stageConnection(ctx context.Context, tx *bbolt.Tx, connection *apipb.Connection, cached bool) {
+	connection = proto.Clone(connection).(*apipb.Connection)
+	var (
+		refstr = refString(connection.GetRef())
+		from   = g.namespacedKey(ctx, connection.GetFrom().String())
+		to     = g.namespacedKey(ctx, connection.GetTo().String())
+	)
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	// only one write transaction is open at a time, so changes staged by any other transaction were either applied
+	// when it committed or discarded when it rolled back
+	staged := g.staged
+	if staged == nil || staged.tx != tx {
+		staged = &adjacencyChanges{
+			tx:   tx,
+			from: map[string]map[string]bool{},
+			to:   map[string]map[string]bool{},
+		}
+		g.staged = staged
+		tx.OnCommit(func() {
+			g.mu.Lock()
+			defer g.mu.Unlock()
+			for _, change := range staged.changes {
+				change()
+			}
+			if g.staged == staged {
+				g.staged = nil
+			}
+		})
+	}
+	if cached {
+		staged.changes = append(staged.changes, func() { g.cacheConnection(ctx, connection) })
+	} else {
+		staged.changes = append(staged.changes, func() { g.uncacheConnection(ctx, connection) })
+	}
+	staged.set(staged.from, from, refstr, cached)
+	staged.set(staged.to, to, refstr, cached)
+	if !connection.GetDirected() {
+		staged.set(staged.to, from, refstr, cached)
+		staged.set(staged.from, to, refstr, cached)
+	}
+}
+
+// adjacent returns the refs of the connections from(or to if out is false) a doc including the changes staged by tx. The
+// caller must hold g.mu.
+func (g *Graph) adjacent(tx *bbolt.Tx, doc string, out bool) []string {
+	adjacency := g.connectionsTo
+	if out {
+		adjacency = g.connectionsFrom
+	}
+	var staged map[string]bool
+	if g.staged != nil && g.staged.tx == tx {
+		if out {
+			staged = g.staged.from[doc]
+		} else {
+			staged = g.staged.to[doc]
+		}
+	}
+	var refs []string
+	for refstr := range adjacency[doc] {
+		if added, ok := staged[refstr]; ok && !added {
+			continue
+		}
+		refs = append(refs, refstr)
+	}
+	for refstr, added := range staged {
+		if _, ok := adjacency[doc][refstr]; added && !ok {
+			refs = append(refs, refstr)
+		}
+	}
+	return refs
+}
+
+// cacheConnection adds the connection to the adjacency lists of it's docs & counts it towards their degrees. Undirected
+// connections are added to both adjacency lists of both docs. The caller must hold g.mu.
+func (g *Graph) cacheConnection(ctx context.Context, connection *apipb.Connection) {
+	var (
+		refstr = refString(connection.GetRef())
+		gtype  = connection.GetRef().GetGtype()
+		from   = g.namespacedKey(ctx, connection.GetFrom().String())
+		to     = g.namespacedKey(ctx, connection.GetTo().String())
+	)
+	g.addAdjacent(g.connectionsFrom, from, refstr, gtype, true)
+	g.addAdjacent(g.connectionsTo, to, refstr, gtype, false)
+	if !connection.GetDirected() {
+		g.addAdjacent(g.connectionsTo, from, refstr, gtype, false)
+		g.addAdjacent(g.connectionsFrom, to, refstr, gtype, true)
+	}
+}
+
+// uncacheConnection removes the connection from the adjacency lists of it's docs & their degrees. The caller must hold g.mu.
+func (g *Graph) uncacheConnection(ctx context.Context, connection *apipb.Connection) {
+	var (
+		refstr = refString(connection.GetRef())
+		gtype  = connection.GetRef().GetGtype()
+		from   = g.namespacedKey(ctx, connection.GetFrom().String())
+		to     = g.namespacedKey(ctx, connection.GetTo().String())
+	)
+	g.delAdjacent(g.connectionsFrom, from, refstr, gtype, true)
+	g.delAdjacent(g.connectionsTo, to, refstr, gtype, false)
+	if !connection.GetDirected() {
+		g.delAdjacent(g.connectionsTo, from, refstr, gtype, false)
+		g.delAdjacent(g.connectionsFrom, to, refstr, gtype, true)
+	}
+}
+
+// addAdjacent adds the connection to the doc's adjacency list. The doc's degree is only incremented if the connection
+// wasn't already in the list(ex: an edited connection), so degrees always match the size of the lists.
+func (g *Graph) addAdjacent(adjacency map[string]map[string]struct{}, doc, refstr, gtype string, out bool) {
+	if adjacency[doc] == nil {
+		adjacency[doc] = map[string]struct{}{}
+	}
+	if _, ok := adjacency[doc][refstr]; ok {
+		return
+	}
+	adjacency[doc][refstr] = struct{}{}
+	if g.degrees[doc] == nil {
+		g.degrees[doc] = map[string]*degree{}
+	}
+	if g.degrees[doc][gtype] == nil {
+		g.degrees[doc][gtype] = &degree{}
+	}
+	if out {
+		g.degrees[doc][gtype].out++
+	} else {
+		g.degrees[doc][gtype].in++
+	}
+}
+
+// delAdjacent removes the connection from the doc's adjacency list & decrements the doc's degree if it was in the list
+func (g *Graph) delAdjacent(adjacency map[string]map[string]struct{}, doc, refstr, gtype string, out bool) {
+	if _, ok := adjacency[doc][refstr]; !ok {
+		return
+	}
+	delete(adjacency[doc], refstr)
+	d := g.degrees[doc][gtype]
+	if d == nil {
+		return
+	}
+	if out && d.out > 0 {
+		d.out--
+	} else if !out && d.in > 0 {
+		d.in--
+	}
+	if d.in == 0 && d.out == 0 {
+		delete(g.degrees[doc], gtype)
+	}
+	if len(g.degrees[doc]) == 0 {
+		delete(g.degrees, doc)
+	}
+}
+
+// degreeOf returns the number of connections of the given type(or every type if it's empty) in the given direction of a doc
+func (g *Graph) degreeOf(ctx context.Context, ref *apipb.Ref, direction apipb.Direction, gtype string) uint64 {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	var count uint64
+	for connectionType, d := range g.degrees[g.namespacedKey(ctx, ref.String())] {
+		if gtype != "" && connectionType != gtype {
+			continue
+		}
+		if direction != apipb.Direction_OUT {
+			count += d.in
+		}
+		if direction != apipb.Direction_IN {
+			count += d.out
+		}
+	}
+	return count
+}
+
+// GetDegree returns the number of connections to & from a doc - in total & per connection type. Degrees are maintained
+// as connections are written, so they're never counted from the connections themselves.
+func (g *Graph) GetDegree(ctx context.Context, filter *apipb.DegreeFilter) (*apipb.Degree, error) {
+	if err := g.db.View(func(tx *bbolt.Tx) error {
+		_, err := g.getDoc(ctx, tx, filter.GetDoc())
+		return err
+	}); err != nil {
+		if err == ErrNotFound {
+			return nil, status.Errorf(codes.NotFound, "doc %s/%s not found", filter.GetDoc().GetGtype(), filter.GetDoc().GetGid())
+		}
+		return nil, err
+	}
+	result := &apipb.Degree{Doc: filter.GetDoc()}
+	g.mu.RLock()
+	for gtype, d := range g.degrees[g.namespacedKey(ctx, filter.GetDoc().String())] {
+		if filter.GetGtype() != "" && gtype != filter.GetGtype() {
+			continue
+		}
+		result.InDegree += d.in
+		result.OutDegree += d.out
+		result.Gtypes = append(result.Gtypes, &apipb.GtypeDegree{
+			Gtype:     gtype,
+			InDegree:  d.in,
+			OutDegree: d.out,
+		})
+	}
+	g.mu.RUnlock()
+	sort.Slice(result.Gtypes, func(i, j int) bool {
+		return result.Gtypes[i].GetGtype() < result.Gtypes[j].GetGtype()
+	})
+	return result, nil
+}
+
+// degreeSort parses a degree sort ex: degree.in.follows(followers), degree.out(connections from the doc of any type)
+func degreeSort(field string) (apipb.Direction, string, bool) {
+	split := strings.SplitN(field, ".", 3)
+	if len(split) < 2 || split[0] != "degree" {
+		return 0, "", false
+	}
+	var direction apipb.Direction
+	switch split[1] {
+	case "in":
+		direction = apipb.Direction_IN
+	case "out":
+		direction = apipb.Direction_OUT
+	default:
+		return 0, "", false
+	}
+	if len(split) == 3 {
+		return direction, split[2], true
+	}
+	return direction, "", true
+}
+
+// searchDocsByDegree returns the most connected docs that pass the filter(up to the filter's limit). Unlike other sorts,
+// which order a single page of results, every doc that passes the filter is considered - so no seek is returned.
+func (g *Graph) searchDocsByDegree(ctx context.Context, filter *apipb.Filter, direction apipb.Direction, gtype string) (*apipb.Docs, error) {
+	unlimited := &apipb.Filter{
+		Gtype:      filter.GetGtype(),
+		Expression: filter.GetExpression(),
+		Limit:      math.MaxInt32,
+		Reverse:    filter.GetReverse(),
+		Index:      filter.GetIndex(),
+		Budget:     filter.GetBudget(),
+	}
+	ctx, finish := g.startQuery(ctx, filter, filter.GetBudget(), false)
+	top := &degreeHeap{}
+	_, err := g.searchDocs(ctx, unlimited, func(doc *apipb.Doc) error {
+		heap.Push(top, degreeDoc{doc: doc, degree: g.degreeOf(ctx, doc.GetRef(), direction, gtype)})
+		if top.Len() > int(filter.GetLimit()) {
+			heap.Pop(top)
+		}
+		return nil
+	})
+	if err := finish(top.Len(), "", err); err != nil {
+		return nil, err
+	}
+	docs := make([]*apipb.Doc, top.Len())
+	for i := len(docs) - 1; i >= 0; i-- {
+		docs[i] = heap.Pop(top).(degreeDoc).doc
+	}
+	return &apipb.Docs{Docs: docs}, nil
+}
+
+type degreeDoc struct {
+	doc    *apipb.Doc
+	degree uint64
+}
+
+// degreeHeap is a min heap of docs by degree - ties are broken by ref so results are deterministic
+type degreeHeap []degreeDoc
+
+func (h degreeHeap) Len() int { return len(h) }
+
+func (h degreeHeap) Less(i, j int) bool {
+	if h[i].degree != h[j].degree {
+		return h[i].degree < h[j].degree
+	}
+	return refString(h[i].doc.GetRef()) > refString(h[j].doc.GetRef())
+}
+
+func (h degreeHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *degreeHeap) Push(x interface{}) { *h = append(*h, x.(degreeDoc)) }
+
+func (h *degreeHeap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
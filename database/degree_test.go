@@ -0,0 +1,97 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"go.etcd.io/bbolt"
+	"testing"
+)
+
+func assertDegree(t *testing.T, g *Graph, ctx context.Context, doc *apipb.Ref, in, out uint64) {
+	t.Helper()
+	degree, err := g.GetDegree(withMethod(g, ctx, "GetDegree"), &apipb.DegreeFilter{Doc: doc, Gtype: "follows"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if degree.GetInDegree() != in || degree.GetOutDegree() != out {
+		t.Fatalf("expected %s/%s to have an in degree of %v & an out degree of %v, got %v", doc.GetGtype(), doc.GetGid(), in, out, degree)
+	}
+}
+
+func countFollows(t *testing.T, g *Graph, ctx context.Context, tx *bbolt.Tx, doc *apipb.Ref) int {
+	t.Helper()
+	var count int
+	if err := g.rangeFrom(ctx, tx, doc, func(e *apipb.Connection) bool {
+		if e.GetRef().GetGtype() == "follows" {
+			count++
+		}
+		return true
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return count
+}
+
+func TestDegrees(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	a := createTestDoc(t, g, ctx, "person", "a", map[string]interface{}{})
+	b := createTestDoc(t, g, ctx, "person", "b", map[string]interface{}{})
+	follows := createTestConnection(t, g, ctx, "follows", a.GetRef(), b.GetRef(), true)
+	assertDegree(t, g, ctx, a.GetRef(), 0, 1)
+	assertDegree(t, g, ctx, b.GetRef(), 1, 0)
+
+	// the second connection reuses the first's gid, so the whole batch is rolled back
+	_, err := g.CreateConnections(withMethod(g, ctx, "CreateConnections"), &apipb.ConnectionConstructors{
+		Connections: []*apipb.ConnectionConstructor{
+			{Ref: &apipb.RefConstructor{Gtype: "follows", Gid: "c"}, Attributes: apipb.NewStruct(map[string]interface{}{}), Directed: true, From: b.GetRef(), To: a.GetRef()},
+			{Ref: &apipb.RefConstructor{Gtype: "follows", Gid: "c"}, Attributes: apipb.NewStruct(map[string]interface{}{}), Directed: true, From: b.GetRef(), To: a.GetRef()},
+		},
+	})
+	if err == nil {
+		t.Fatal("expected the batch to fail")
+	}
+	assertDegree(t, g, ctx, a.GetRef(), 0, 1)
+	assertDegree(t, g, ctx, b.GetRef(), 1, 0)
+
+	// connections written by a transaction are visible to it before it commits, but not after it's rolled back
+	rollback := errors.New("rollback")
+	if err := g.db.Update(func(tx *bbolt.Tx) error {
+		if err := g.delConnection(ctx, tx, follows.GetRef()); err != nil {
+			return err
+		}
+		if count := countFollows(t, g, ctx, tx, a.GetRef()); count != 0 {
+			t.Fatalf("expected the deleted connection to be hidden from the transaction, got %v connections", count)
+		}
+		if _, err := g.setConnection(ctx, tx, &apipb.Connection{
+			Ref:        &apipb.Ref{Gtype: "follows", Gid: "d"},
+			Attributes: apipb.NewStruct(map[string]interface{}{}),
+			From:       b.GetRef(),
+			To:         a.GetRef(),
+		}); err != nil {
+			return err
+		}
+		if count := countFollows(t, g, ctx, tx, a.GetRef()); count != 1 {
+			t.Fatalf("expected the undirected connection to be visible to the transaction, got %v connections", count)
+		}
+		return rollback
+	}); err != rollback {
+		t.Fatalf("expected the transaction to be rolled back, got %v", err)
+	}
+	assertDegree(t, g, ctx, a.GetRef(), 0, 1)
+	assertDegree(t, g, ctx, b.GetRef(), 1, 0)
+	if err := g.db.View(func(tx *bbolt.Tx) error {
+		if count := countFollows(t, g, ctx, tx, b.GetRef()); count != 0 {
+			t.Fatalf("expected no connections from b after the rollback, got %v", count)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := g.DelConnection(withMethod(g, ctx, "DelConnection"), follows.GetRef()); err != nil {
+		t.Fatal(err)
+	}
+	assertDegree(t, g, ctx, a.GetRef(), 0, 0)
+	assertDegree(t, g, ctx, b.GetRef(), 0, 0)
+}
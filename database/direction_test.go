@@ -0,0 +1,150 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"sort"
+	"testing"
+)
+
+// createDirectionTestGraph creates a -follows-> b, c -follows-> a, a -follows-> a & an undirected a -friend- d
+func createDirectionTestGraph(t *testing.T, g *Graph, ctx context.Context) map[string]*apipb.Doc {
+	docs := map[string]*apipb.Doc{}
+	for _, gid := range []string{"a", "b", "c", "d"} {
+		docs[gid] = createTestDoc(t, g, ctx, "person", gid, map[string]interface{}{})
+	}
+	createTestConnection(t, g, ctx, "follows", docs["a"].GetRef(), docs["b"].GetRef(), true)
+	createTestConnection(t, g, ctx, "follows", docs["c"].GetRef(), docs["a"].GetRef(), true)
+	createTestConnection(t, g, ctx, "follows", docs["a"].GetRef(), docs["a"].GetRef(), true)
+	createTestConnection(t, g, ctx, "friend", docs["a"].GetRef(), docs["d"].GetRef(), false)
+	return docs
+}
+
+// connectionEnds returns the sorted from->to gids of the connections
+func connectionEnds(connections *apipb.Connections) string {
+	var ends []string
+	for _, connection := range connections.GetConnections() {
+		ends = append(ends, fmt.Sprintf("%s->%s", connection.GetFrom().GetGid(), connection.GetTo().GetGid()))
+	}
+	sort.Strings(ends)
+	return fmt.Sprint(ends)
+}
+
+// traversalGids returns the sorted gids of the traversed docs
+func traversalGids(traversals []*apipb.Traversal) string {
+	var gids []string
+	for _, traversal := range traversals {
+		gids = append(gids, traversal.GetDoc().GetRef().GetGid())
+	}
+	sort.Strings(gids)
+	return fmt.Sprint(gids)
+}
+
+func TestConnectFilterDirection(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	docs := createDirectionTestGraph(t, g, ctx)
+	for _, test := range []struct {
+		name     string
+		from     bool
+		filter   *apipb.ConnectFilter
+		expected string
+	}{
+		{"from", true, &apipb.ConnectFilter{DocRef: docs["a"].GetRef(), Gtype: "follows", Limit: 10}, "[a->a a->b]"},
+		{"to", false, &apipb.ConnectFilter{DocRef: docs["a"].GetRef(), Gtype: "follows", Limit: 10}, "[a->a c->a]"},
+		// the self-connection is in both of a's adjacency lists but is only returned once
+		{"from both", true, &apipb.ConnectFilter{DocRef: docs["a"].GetRef(), Gtype: "follows", Limit: 10, Direction: apipb.Direction_BOTH}, "[a->a a->b c->a]"},
+		{"to both", false, &apipb.ConnectFilter{DocRef: docs["a"].GetRef(), Gtype: "follows", Limit: 10, Direction: apipb.Direction_BOTH}, "[a->a a->b c->a]"},
+		// undirected connections are in both adjacency lists of both docs
+		{"undirected from", true, &apipb.ConnectFilter{DocRef: docs["d"].GetRef(), Gtype: "friend", Limit: 10}, "[a->d]"},
+		{"undirected both", true, &apipb.ConnectFilter{DocRef: docs["d"].GetRef(), Gtype: "friend", Limit: 10, Direction: apipb.Direction_BOTH}, "[a->d]"},
+	} {
+		var (
+			connections *apipb.Connections
+			err         error
+		)
+		if test.from {
+			connections, err = g.ConnectionsFrom(ctx, test.filter)
+		} else {
+			connections, err = g.ConnectionsTo(ctx, test.filter)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := connectionEnds(connections); got != test.expected {
+			t.Fatalf("%s: expected %s, got %s", test.name, test.expected, got)
+		}
+	}
+}
+
+func TestTraverseDirection(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	docs := createDirectionTestGraph(t, g, ctx)
+	for _, test := range []struct {
+		name      string
+		direction apipb.Direction
+		reverse   bool
+		expected  string
+	}{
+		{"out", apipb.Direction_OUT, false, "[a b]"},
+		{"in", apipb.Direction_IN, false, "[a c]"},
+		// reverse is a legacy alias of IN
+		{"reverse", apipb.Direction_OUT, true, "[a c]"},
+		{"both", apipb.Direction_BOTH, false, "[a b c]"},
+	} {
+		filter := &apipb.TraverseFilter{
+			Root:                 docs["a"].GetRef(),
+			ConnectionExpression: "this.ref.gtype == 'follows'",
+			Direction:            test.direction,
+			Reverse:              test.reverse,
+			Limit:                10,
+			MaxDepth:             10,
+			MaxHops:              10,
+		}
+		traversals, err := g.Traverse(withMethod(g, ctx, "Traverse"), filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := traversalGids(traversals.GetTraversals()); got != test.expected {
+			t.Fatalf("%s: expected %s, got %s", test.name, test.expected, got)
+		}
+		filter.Algorithm = apipb.Algorithm_BFS
+		stream, sent := newTestStream(t, g, ctx, "StreamTraverse", 0)
+		if err := g.StreamTraverse(filter, traversalStream{stream}); err != nil {
+			t.Fatal(err)
+		}
+		var streamed []*apipb.Traversal
+		for _, msg := range *sent {
+			streamed = append(streamed, msg.(*apipb.Traversal))
+		}
+		if got := traversalGids(streamed); got != test.expected {
+			t.Fatalf("%s(stream): expected %s, got %s", test.name, test.expected, got)
+		}
+	}
+}
+
+func TestTraverseMeDirection(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	createDirectionTestGraph(t, g, ctx)
+	ctx = withMethod(g, ctx, "TraverseMe")
+	// every doc the user created has a created_by connection to the user
+	for direction, expected := range map[apipb.Direction]string{
+		apipb.Direction_OUT:  "[" + testRootUser + "]",
+		apipb.Direction_IN:   "[a b c d " + testRootUser + "]",
+		apipb.Direction_BOTH: "[a b c d " + testRootUser + "]",
+	} {
+		traversals, err := g.TraverseMe(ctx, &apipb.TraverseMeFilter{
+			ConnectionExpression: "this.ref.gtype == 'created_by'",
+			Direction:            direction,
+			Limit:                10,
+			MaxDepth:             10,
+			MaxHops:              10,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := traversalGids(traversals.GetTraversals()); got != expected {
+			t.Fatalf("%s: expected %s, got %s", direction, expected, got)
+		}
+	}
+}
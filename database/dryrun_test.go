@@ -0,0 +1,52 @@
+package database
+
+import (
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestEditDocsDryRun(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	for _, gid := range []string{"1", "2"} {
+		createTestDoc(t, g, ctx, "task", gid, map[string]interface{}{"status": "open"})
+	}
+	ctx = withMethod(g, ctx, "EditDocs")
+	edit := func(dryRun bool) *apipb.MutationResult {
+		result, err := g.EditDocs(ctx, &apipb.EditFilter{
+			Filter:     &apipb.Filter{Gtype: "task", Limit: 10, DryRun: dryRun},
+			Attributes: apipb.NewStruct(map[string]interface{}{"status": "closed"}),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.GetDryRun() != dryRun || result.GetAffected() != 2 || len(result.GetRefs()) != 2 || len(result.GetDocs().GetDocs()) != 2 {
+			t.Fatalf("unexpected edit result: %v", result)
+		}
+		for _, doc := range result.GetDocs().GetDocs() {
+			if doc.GetAttributes().GetFields()["status"].GetStringValue() != "closed" {
+				t.Fatalf("expected an edited doc, got %v", doc)
+			}
+		}
+		return result
+	}
+	search := func() *apipb.Docs {
+		docs, err := g.SearchDocs(withMethod(g, ctx, "SearchDocs"), &apipb.Filter{Gtype: "task", Expression: "this.attributes.status == 'closed'", Limit: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return docs
+	}
+	edit(true)
+	if docs := search(); len(docs.GetDocs()) != 0 {
+		t.Fatalf("expected a dry run to leave docs as is, got %v", docs)
+	}
+	edit(false)
+	if docs := search(); len(docs.GetDocs()) != 2 {
+		t.Fatalf("expected 2 edited docs, got %v", docs)
+	}
+	if _, err := g.SearchDocs(withMethod(g, ctx, "SearchDocs"), &apipb.Filter{Gtype: "task", Limit: 10, DryRun: true}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a search with dry_run to fail with InvalidArgument, got %v", err)
+	}
+}
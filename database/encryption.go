@@ -0,0 +1,320 @@
+package database
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"github.com/autom8ter/machine"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"github.com/graphikDB/graphik/logger"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+const (
+	// sealedMarker prefixes every encrypted value. A protobuf message can never begin with a zero byte(field number 0 is invalid),
+	// so the marker can't be confused with a plaintext value written before encryption was enabled.
+	sealedMarker = byte(0)
+	keyIDLen     = 4
+	// reEncryptBatchSize is the max number of values rewritten per transaction during a re-encrypt
+	reEncryptBatchSize = 1000
+)
+
+// plaintextIndexKinds are the index kinds that store attribute values outside of the sealed doc values. Their contents can't
+// be encrypted without breaking lookups, so they're refused while encryption is enabled.
+var plaintextIndexKinds = []apipb.IndexKind{
+	apipb.IndexKind_FULL_TEXT,
+	apipb.IndexKind_GEO,
+	apipb.IndexKind_VECTOR,
+	apipb.IndexKind_VALUE,
+}
+
+// crypter seals & opens the marshalled values stored in the doc, connection & index buckets using AES-GCM.
+// Keys & bucket names are never encrypted so seeks & cursors keep working.
+type crypter struct {
+	keyID []byte
+	aead  cipher.AEAD
+	// keys are all known keys(current & previous) by key id
+	keys map[string]cipher.AEAD
+}
+
+func newCrypter(flgs *apipb.Flags) (*crypter, error) {
+	current := flgs.GetEncryptionKey()
+	if flgs.GetEncryptionKeyFile() != "" {
+		bits, err := ioutil.ReadFile(flgs.GetEncryptionKeyFile())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read encryption key file")
+		}
+		current = string(bits)
+	}
+	if current == "" {
+		if len(flgs.GetDecryptionKeys()) > 0 {
+			return nil, errors.New("decryption keys require an encryption key")
+		}
+		return nil, nil
+	}
+	c := &crypter{
+		keys: map[string]cipher.AEAD{},
+	}
+	keyID, aead, err := parseEncryptionKey(current)
+	if err != nil {
+		return nil, err
+	}
+	c.keyID = keyID
+	c.aead = aead
+	c.keys[string(keyID)] = aead
+	for _, key := range flgs.GetDecryptionKeys() {
+		keyID, aead, err := parseEncryptionKey(key)
+		if err != nil {
+			return nil, err
+		}
+		c.keys[string(keyID)] = aead
+	}
+	return c, nil
+}
+
+// parseEncryptionKey decodes a base64 encoded 16, 24 or 32 byte AES key
+func parseEncryptionKey(key string) ([]byte, cipher.AEAD, error) {
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(key))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "encryption keys must be base64 encoded")
+	}
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, nil, err
+	}
+	sum := sha256.Sum256(raw)
+	return sum[:keyIDLen], aead, nil
+}
+
+func (c *crypter) seal(bits []byte) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	sealed := make([]byte, 0, 1+keyIDLen+len(nonce)+len(bits)+c.aead.Overhead())
+	sealed = append(sealed, sealedMarker)
+	sealed = append(sealed, c.keyID...)
+	sealed = append(sealed, nonce...)
+	return c.aead.Seal(sealed, nonce, bits, nil), nil
+}
+
+func (c *crypter) open(bits []byte) ([]byte, error) {
+	if len(bits) < 1+keyIDLen {
+		return nil, errors.New("malformed encrypted value")
+	}
+	aead, ok := c.keys[string(bits[1:1+keyIDLen])]
+	if !ok {
+		return nil, errors.New("value was encrypted with an unknown key")
+	}
+	bits = bits[1+keyIDLen:]
+	if len(bits) < aead.NonceSize() {
+		return nil, errors.New("malformed encrypted value")
+	}
+	return aead.Open(nil, bits[:aead.NonceSize()], bits[aead.NonceSize():], nil)
+}
+
+// current returns true if the value is sealed with the current key
+func (c *crypter) current(bits []byte) bool {
+	return isSealed(bits) && len(bits) >= 1+keyIDLen && string(bits[1:1+keyIDLen]) == string(c.keyID)
+}
+
+func isSealed(bits []byte) bool {
+	return len(bits) > 0 && bits[0] == sealedMarker
+}
+
+// checkIndexEncryption returns an error if the index would store attribute values in plaintext while encryption is enabled
+func (g *Graph) checkIndexEncryption(i *apipb.Index) error {
+	if g.crypter == nil {
+		return nil
+	}
+	for _, kind := range plaintextIndexKinds {
+		if i.GetKind() == kind {
+			return status.Errorf(codes.FailedPrecondition, "index %s: %s indexes store attribute values in plaintext & aren't supported while encryption is enabled", i.GetName(), kind.String())
+		}
+	}
+	return nil
+}
+
+// seal encrypts a marshalled doc/connection before it's written to the db. It's a no-op if encryption is disabled.
+func (g *Graph) seal(bits []byte) ([]byte, error) {
+	if g.crypter == nil {
+		return bits, nil
+	}
+	return g.crypter.seal(bits)
+}
+
+// open decrypts a value read from the db. Plaintext values(written before encryption was enabled) are returned as is.
+func (g *Graph) open(bits []byte) ([]byte, error) {
+	if !isSealed(bits) {
+		return bits, nil
+	}
+	if g.crypter == nil {
+		return nil, errors.New("encrypted value found but no encryption key is configured")
+	}
+	return g.crypter.open(bits)
+}
+
+// ReEncrypt starts a background job that rewrites every doc, connection, indexed value & idempotent response with the current encryption key.
+// Run it after rotating keys(moving the old key to --decryption-keys) or after enabling encryption on an existing database.
+func (g *Graph) ReEncrypt(ctx context.Context, _ *empty.Empty) (*empty.Empty, error) {
+	user := g.getIdentity(ctx)
+	if user == nil {
+		return nil, status.Error(codes.Unauthenticated, "failed to get user")
+	}
+	if !g.isGraphikAdmin(user) {
+		return nil, status.Error(codes.PermissionDenied, "re-encryption is restricted to root users")
+	}
+	if g.crypter == nil {
+		return nil, status.Error(codes.FailedPrecondition, "encryption is disabled - set --encryption-key or --encryption-key-file")
+	}
+	g.machine.Go(func(routine machine.Routine) {
+		logger.Info("starting re-encryption")
+		count, err := g.reEncrypt(routine.Context())
+		if err != nil {
+			logger.Error("re-encryption failure", zap.Error(err), zap.Int("rewritten", count))
+			return
+		}
+		logger.Info("re-encryption complete", zap.Int("rewritten", count))
+	})
+	return &empty.Empty{}, nil
+}
+
+func (g *Graph) reEncrypt(ctx context.Context) (int, error) {
+	var total int
+	err := g.rangeNamespaces(ctx, func(ctx context.Context) error {
+		count, err := g.reEncryptNamespace(ctx)
+		total += count
+		return err
+	})
+	return total, err
+}
+
+func (g *Graph) reEncryptNamespace(ctx context.Context) (int, error) {
+	var total int
+	for _, parent := range [][]byte{dbDocs, dbConnections, dbIndexDocs, dbIndexConnections} {
+		var children [][]byte
+		if err := g.db.View(func(tx *bbolt.Tx) error {
+			return g.bucket(ctx, tx, parent).ForEach(func(name []byte, v []byte) error {
+				if v == nil {
+					children = append(children, append([]byte{}, name...))
+				}
+				return nil
+			})
+		}); err != nil {
+			return total, err
+		}
+		for _, child := range children {
+			count, err := g.reEncryptBucket(ctx, parent, child, 0)
+			total += count
+			if err != nil {
+				return total, errors.Wrapf(err, "failed to re-encrypt %s/%s", parent, child)
+			}
+		}
+	}
+	// idempotent responses are prefixed with their expiration
+	count, err := g.reEncryptBucket(ctx, dbIdempotency, nil, 8)
+	total += count
+	if err != nil {
+		return total, errors.Wrapf(err, "failed to re-encrypt %s", dbIdempotency)
+	}
+	return total, nil
+}
+
+// reEncryptBucket rewrites a bucket(or the child bucket nested within it) in batches so a single transaction never holds
+// the entire bucket. The first offset bytes of each value are left as is.
+func (g *Graph) reEncryptBucket(ctx context.Context, parent, child []byte, offset int) (int, error) {
+	var (
+		total int
+		seek  []byte
+	)
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+		var done bool
+		if err := g.db.Update(func(tx *bbolt.Tx) error {
+			bucket := g.bucket(ctx, tx, parent)
+			if bucket != nil && child != nil {
+				bucket = bucket.Bucket(child)
+			}
+			if bucket == nil {
+				done = true
+				return nil
+			}
+			type kv struct {
+				k, v []byte
+			}
+			var batch []kv
+			c := bucket.Cursor()
+			k, v := c.First()
+			if seek != nil {
+				k, v = c.Seek(seek)
+			}
+			for ; k != nil && len(batch) < reEncryptBatchSize; k, v = c.Next() {
+				if v == nil || len(v) < offset || g.crypter.current(v[offset:]) {
+					continue
+				}
+				bits, err := g.open(v[offset:])
+				if err != nil {
+					return err
+				}
+				sealed, err := g.crypter.seal(bits)
+				if err != nil {
+					return err
+				}
+				batch = append(batch, kv{k: append([]byte{}, k...), v: append(append([]byte{}, v[:offset]...), sealed...)})
+			}
+			if k == nil {
+				done = true
+			} else {
+				seek = append([]byte{}, k...)
+			}
+			for _, pair := range batch {
+				if err := bucket.Put(pair.k, pair.v); err != nil {
+					return err
+				}
+			}
+			total += len(batch)
+			return nil
+		}); err != nil {
+			return total, err
+		}
+		if done {
+			return total, nil
+		}
+	}
+}
+
+// marshal marshals & seals a doc/connection for storage in the doc, connection or index buckets
+func (g *Graph) marshal(msg proto.Message) ([]byte, error) {
+	bits, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return g.seal(bits)
+}
+
+// unmarshal opens & unmarshals a doc/connection read from the doc, connection or index buckets
+func (g *Graph) unmarshal(bits []byte, msg proto.Message) error {
+	bits, err := g.open(bits)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(bits, msg)
+}
@@ -0,0 +1,120 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func newTestKey(t *testing.T) string {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatal(err)
+	}
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+func TestEncryptionRoundTrip(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{EncryptionKey: newTestKey(t)})
+	doc := createTestDoc(t, g, ctx, "note", "1", map[string]interface{}{"secret": "plaintext-secret"})
+	if err := g.db.View(func(tx *bbolt.Tx) error {
+		bits := tx.Bucket(dbDocs).Bucket([]byte("note")).Get([]byte("1"))
+		if !isSealed(bits) {
+			t.Fatal("expected doc to be sealed")
+		}
+		if bytes.Contains(bits, []byte("plaintext-secret")) {
+			t.Fatal("expected doc attributes to be encrypted")
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := g.GetDoc(ctx, doc.GetRef())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetAttributes().GetFields()["secret"].GetStringValue() != "plaintext-secret" {
+		t.Fatalf("expected decrypted attributes, got %v", got.GetAttributes())
+	}
+}
+
+func TestEncryptionKeyRotation(t *testing.T) {
+	var (
+		dir    = t.TempDir()
+		oldKey = newTestKey(t)
+		newKey = newTestKey(t)
+	)
+	g, ctx := openTestGraph(t, &apipb.Flags{StoragePath: dir, EncryptionKey: oldKey})
+	createTestDoc(t, g, ctx, "note", "1", map[string]interface{}{"title": "hello"})
+	created, err := g.CreateDoc(withMethod(g, ctx, "CreateDoc"), &apipb.DocConstructor{
+		Ref:            &apipb.RefConstructor{Gtype: "note", Gid: "2"},
+		Attributes:     apipb.NewStruct(map[string]interface{}{"title": "world"}),
+		IdempotencyKey: "create-note-2",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.Close()
+
+	g, ctx = openTestGraph(t, &apipb.Flags{StoragePath: dir, EncryptionKey: newKey, DecryptionKeys: []string{oldKey}})
+	if _, err := g.reEncrypt(ctx); err != nil {
+		t.Fatal(err)
+	}
+	g.Close()
+
+	// the old key is no longer needed once every value has been re-encrypted
+	g, ctx = openTestGraph(t, &apipb.Flags{StoragePath: dir, EncryptionKey: newKey})
+	doc, err := g.GetDoc(ctx, &apipb.Ref{Gtype: "note", Gid: "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc.GetAttributes().GetFields()["title"].GetStringValue() != "hello" {
+		t.Fatalf("unexpected attributes after rotation: %v", doc.GetAttributes())
+	}
+	replayed, err := g.CreateDoc(withMethod(g, ctx, "CreateDoc"), &apipb.DocConstructor{
+		Ref:            &apipb.RefConstructor{Gtype: "note", Gid: "2"},
+		Attributes:     apipb.NewStruct(map[string]interface{}{"title": "world"}),
+		IdempotencyKey: "create-note-2",
+	})
+	if err != nil {
+		t.Fatalf("expected idempotent replay to decrypt with the new key: %v", err)
+	}
+	if replayed.GetRef().String() != created.GetRef().String() {
+		t.Fatalf("expected replay to return %v, got %v", created.GetRef(), replayed.GetRef())
+	}
+}
+
+func TestEncryptionRefusesPlaintextIndexes(t *testing.T) {
+	indexes := []*apipb.Index{
+		{Name: "ages", Gtype: "user", Docs: true, Kind: apipb.IndexKind_VALUE, Fields: []string{"attributes.age"}},
+		{Name: "embeddings", Gtype: "item", Docs: true, Kind: apipb.IndexKind_VECTOR, Fields: []string{"attributes.embedding"}, Dimensions: 3},
+		{Name: "places", Gtype: "place", Docs: true, Kind: apipb.IndexKind_GEO, Fields: []string{"attributes.lat", "attributes.lng"}},
+		{Name: "titles", Gtype: "note", Docs: true, Kind: apipb.IndexKind_FULL_TEXT, Fields: []string{"attributes.title"}},
+	}
+	for _, index := range indexes {
+		dir := t.TempDir()
+		g, ctx := openTestGraph(t, &apipb.Flags{StoragePath: dir, EncryptionKey: newTestKey(t)})
+		if _, err := g.SetIndexes(ctx, &apipb.Indexes{Indexes: []*apipb.Index{index}}); status.Code(err) != codes.FailedPrecondition {
+			t.Fatalf("expected a %s index to be refused with FailedPrecondition, got %v", index.GetKind(), err)
+		}
+		g.Close()
+
+		// an index set before encryption was enabled can't be loaded with an encryption key
+		g, ctx = openTestGraph(t, &apipb.Flags{StoragePath: dir})
+		if _, err := g.SetIndexes(ctx, &apipb.Indexes{Indexes: []*apipb.Index{index}}); err != nil {
+			t.Fatal(err)
+		}
+		g.Close()
+		g, err := NewGraph(context.Background(), &apipb.Flags{StoragePath: dir, EncryptionKey: newTestKey(t)})
+		if err == nil {
+			g.Close()
+			t.Fatalf("expected a %s index to prevent the graph from opening with an encryption key", index.GetKind())
+		}
+	}
+}
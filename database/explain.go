@@ -0,0 +1,214 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/cel-go/cel"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxExplainErrors caps the number of distinct swallowed errors recorded by an explanation
+const maxExplainErrors = 10
+
+// queryStats records the work done by a search or traversal that's being explained or has a budget. A nil *queryStats
+// records nothing.
+type queryStats struct {
+	plan               *queryPlan
+	candidates         []*queryPlan
+	keysScanned        uint64
+	docsDecoded        uint64
+	connectionsDecoded uint64
+	evaluations        uint64
+	errors             map[string]uint64
+	phases             []*apipb.ExplainPhase
+	// maxScan & maxEvaluations are the query's budget(0 is unlimited)
+	maxScan        uint64
+	maxEvaluations uint64
+	// exceeded is set once the query exceeds it's budget
+	exceeded *budgetError
+}
+
+func withQueryStats(ctx context.Context) (context.Context, *queryStats) {
+	stats := &queryStats{errors: map[string]uint64{}}
+	return context.WithValue(ctx, explainCtxKey, stats), stats
+}
+
+// getQueryStats returns the stats of the query being explained or nil if the query isn't being explained
+func getQueryStats(ctx context.Context) *queryStats {
+	stats, _ := ctx.Value(explainCtxKey).(*queryStats)
+	return stats
+}
+
+func (s *queryStats) planned(plan *queryPlan, candidates []*queryPlan) {
+	if s != nil {
+		s.plan, s.candidates = plan, candidates
+	}
+}
+
+// scanned records a key read from the db - it returns an error if the query has exceeded it's budget
+func (s *queryStats) scanned() error {
+	return s.scannedKeys(1)
+}
+
+// scannedKeys records n keys read from the db - it returns an error if the query has exceeded it's budget
+func (s *queryStats) scannedKeys(n uint64) error {
+	if s == nil {
+		return nil
+	}
+	s.keysScanned += n
+	if s.maxScan > 0 && s.keysScanned > s.maxScan {
+		s.exceed("max_scan", s.maxScan)
+	}
+	return s.err()
+}
+
+// evaluated records a CEL evaluation - it returns an error if the query has exceeded it's budget
+func (s *queryStats) evaluated() error {
+	if s == nil {
+		return nil
+	}
+	s.evaluations++
+	if s.maxEvaluations > 0 && s.evaluations > s.maxEvaluations {
+		s.exceed("max_evaluations", s.maxEvaluations)
+	}
+	return s.err()
+}
+
+func (s *queryStats) decodedDoc() {
+	if s != nil {
+		s.docsDecoded++
+	}
+}
+
+func (s *queryStats) decodedConnection() {
+	if s != nil {
+		s.connectionsDecoded++
+	}
+}
+
+// swallowed records an error that didn't halt the query
+func (s *queryStats) swallowed(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	if _, ok := s.errors[err.Error()]; ok || len(s.errors) < maxExplainErrors {
+		s.errors[err.Error()]++
+	}
+}
+
+// phase starts timing a phase of execution - the returned func stops it
+func (s *queryStats) phase(name string) func() {
+	if s == nil {
+		return func() {}
+	}
+	start := time.Now()
+	return func() {
+		s.phases = append(s.phases, &apipb.ExplainPhase{
+			Name:     name,
+			Duration: durationpb.New(time.Since(start)),
+		})
+	}
+}
+
+// evalDoc evaluates a program against a doc like vm.Eval - recording the evaluation & any error(including the missing
+// attribute errors that vm.Eval swallows) if the query is being explained
+func (g *Graph) evalDoc(stats *queryStats, doc *apipb.Doc, program cel.Program) (bool, error) {
+	if stats == nil {
+		return g.vm.Doc().Eval(doc, program)
+	}
+	if err := stats.evaluated(); err != nil {
+		// the query halts once it reads it's next key
+		return false, nil
+	}
+	pass, err := g.vm.Doc().Trace(doc, program)
+	if err != nil && strings.Contains(err.Error(), "no such key") {
+		stats.swallowed(err)
+		return false, nil
+	}
+	return pass, err
+}
+
+// evalConnection evaluates a program against a connection like vm.Eval - recording the evaluation & any error(including
+// the missing attribute errors that vm.Eval swallows) if the query is being explained
+func (g *Graph) evalConnection(stats *queryStats, connection *apipb.Connection, program cel.Program) (bool, error) {
+	if stats == nil {
+		return g.vm.Connection().Eval(connection, program)
+	}
+	if err := stats.evaluated(); err != nil {
+		// the query halts once it reads it's next key
+		return false, nil
+	}
+	pass, err := g.vm.Connection().Trace(connection, program)
+	if err != nil && strings.Contains(err.Error(), "no such key") {
+		stats.swallowed(err)
+		return false, nil
+	}
+	return pass, err
+}
+
+// Explain executes a search or traversal & returns how it was executed instead of it's results(like SQL EXPLAIN ANALYZE)
+func (g *Graph) Explain(ctx context.Context, filter *apipb.ExplainFilter) (*apipb.Explanation, error) {
+	ctx, stats := withQueryStats(ctx)
+	var (
+		results    uint64
+		accessPath string
+		err        error
+		start      = time.Now()
+	)
+	switch query := filter.GetQuery().(type) {
+	case *apipb.ExplainFilter_SearchDocs:
+		var docs *apipb.Docs
+		docs, err = g.SearchDocs(ctx, query.SearchDocs)
+		results = uint64(len(docs.GetDocs()))
+	case *apipb.ExplainFilter_SearchConnections:
+		var connections *apipb.Connections
+		connections, err = g.SearchConnections(ctx, query.SearchConnections)
+		results = uint64(len(connections.GetConnections()))
+	case *apipb.ExplainFilter_Traverse:
+		var traversals *apipb.Traversals
+		traversals, err = g.Traverse(ctx, query.Traverse)
+		results = uint64(len(traversals.GetTraversals()))
+		accessPath = fmt.Sprintf("traversal(%s)", query.Traverse.GetAlgorithm().String())
+	default:
+		return nil, status.Error(codes.InvalidArgument, "a search_docs, search_connections or traverse query is required")
+	}
+	if err != nil {
+		return nil, err
+	}
+	explanation := &apipb.Explanation{
+		AccessPath:         accessPath,
+		KeysScanned:        stats.keysScanned,
+		DocsDecoded:        stats.docsDecoded,
+		ConnectionsDecoded: stats.connectionsDecoded,
+		Evaluations:        stats.evaluations,
+		Results:            results,
+		Phases:             stats.phases,
+		Duration:           durationpb.New(time.Since(start)),
+	}
+	if stats.plan != nil {
+		explanation.AccessPath = stats.plan.String()
+		explanation.Estimate = uint64(stats.plan.estimate)
+	}
+	for _, candidate := range stats.candidates {
+		explanation.Candidates = append(explanation.Candidates, &apipb.ExplainCandidate{
+			AccessPath: candidate.String(),
+			Estimate:   uint64(candidate.estimate),
+		})
+	}
+	for msg, count := range stats.errors {
+		explanation.Errors = append(explanation.Errors, &apipb.ExplainError{Error: msg, Count: count})
+	}
+	sort.Slice(explanation.Errors, func(i, j int) bool {
+		if explanation.Errors[i].GetCount() == explanation.Errors[j].GetCount() {
+			return explanation.Errors[i].GetError() < explanation.Errors[j].GetError()
+		}
+		return explanation.Errors[i].GetCount() > explanation.Errors[j].GetCount()
+	})
+	return explanation, nil
+}
@@ -0,0 +1,66 @@
+package database
+
+import (
+	"fmt"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"strings"
+	"testing"
+)
+
+func TestExplainSearchDocs(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	for i := 0; i < 10; i++ {
+		attributes := map[string]interface{}{"age": float64(i)}
+		// docs without an age make the expression fail with a swallowed "no such key" error
+		if i >= 8 {
+			attributes = map[string]interface{}{}
+		}
+		createTestDoc(t, g, ctx, "person", fmt.Sprint(i), attributes)
+	}
+	explanation, err := g.Explain(withMethod(g, ctx, "Explain"), &apipb.ExplainFilter{Query: &apipb.ExplainFilter_SearchDocs{
+		SearchDocs: &apipb.Filter{Gtype: "person", Expression: "this.attributes.age >= 5.0", Limit: 100},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if explanation.GetAccessPath() != "bucket scan" || explanation.GetEstimate() != 10 {
+		t.Fatalf("expected a bucket scan of 10 keys, got %s(%v)", explanation.GetAccessPath(), explanation.GetEstimate())
+	}
+	if len(explanation.GetCandidates()) != 1 {
+		t.Fatalf("expected a single candidate, got %v", explanation.GetCandidates())
+	}
+	if explanation.GetKeysScanned() != 10 || explanation.GetDocsDecoded() != 10 || explanation.GetEvaluations() != 10 {
+		t.Fatalf("expected 10 keys scanned, docs decoded & evaluations, got %v", explanation)
+	}
+	if explanation.GetResults() != 3 {
+		t.Fatalf("expected 3 results, got %v", explanation.GetResults())
+	}
+	if len(explanation.GetErrors()) != 1 || explanation.GetErrors()[0].GetCount() != 2 || !strings.Contains(explanation.GetErrors()[0].GetError(), "no such key") {
+		t.Fatalf("expected 2 swallowed no such key errors, got %v", explanation.GetErrors())
+	}
+	phases := map[string]bool{}
+	for _, phase := range explanation.GetPhases() {
+		phases[phase.GetName()] = true
+	}
+	if !phases["plan"] || !phases["scan"] {
+		t.Fatalf("expected plan & scan phases, got %v", explanation.GetPhases())
+	}
+
+	if _, err := g.SetIndexes(ctx, &apipb.Indexes{Indexes: []*apipb.Index{
+		{Name: "adults", Gtype: "person", Docs: true, Expression: "this.attributes.age >= 5.0"},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	explanation, err = g.Explain(withMethod(g, ctx, "Explain"), &apipb.ExplainFilter{Query: &apipb.ExplainFilter_SearchDocs{
+		SearchDocs: &apipb.Filter{Gtype: "person", Expression: "this.attributes.age >= 5.0", Limit: 100},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if explanation.GetAccessPath() != "expression index adults" || len(explanation.GetCandidates()) != 2 {
+		t.Fatalf("expected the adults index to be picked over a bucket scan, got %s from %v", explanation.GetAccessPath(), explanation.GetCandidates())
+	}
+	if explanation.GetKeysScanned() != 3 || explanation.GetResults() != 3 {
+		t.Fatalf("expected 3 keys scanned & 3 results, got %v", explanation)
+	}
+}
@@ -0,0 +1,175 @@
+package database
+
+import (
+	"context"
+	"github.com/google/cel-go/cel"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExtractSubgraph visits the docs around the filter's roots breadth first(up to the filter's max depth & limit) & returns
+// them along with every connection that was traversed - including connections between docs that were already visited.
+// The roots are always included, other docs are only visited if they pass the doc expression.
+func (g *Graph) ExtractSubgraph(ctx context.Context, filter *apipb.ExtractFilter) (*apipb.Graph, error) {
+	e := &extraction{
+		g:       g,
+		filter:  filter,
+		visited: map[string]struct{}{},
+		used:    map[string]struct{}{},
+		graph: &apipb.Graph{
+			Docs:        &apipb.Docs{},
+			Connections: &apipb.Connections{},
+		},
+	}
+	if filter.GetDocExpression() != "" {
+		program, err := g.vm.Doc().Program(filter.GetDocExpression())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		e.docProgram = program
+	}
+	if filter.GetConnectionExpression() != "" {
+		program, err := g.vm.Connection().Program(filter.GetConnectionExpression())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		e.connectionProgram = program
+	}
+	ctx, finish := g.startQuery(ctx, filter, filter.GetBudget(), false)
+	e.stats = getQueryStats(ctx)
+	err := g.db.View(func(tx *bbolt.Tx) error {
+		return e.extract(ctx, tx)
+	})
+	results := len(e.graph.GetDocs().GetDocs()) + len(e.graph.GetConnections().GetConnections())
+	if err := finish(results, "", err); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return e.graph, nil
+}
+
+// extraction holds the state of a subgraph extraction
+type extraction struct {
+	g                 *Graph
+	filter            *apipb.ExtractFilter
+	docProgram        cel.Program
+	connectionProgram cel.Program
+	stats             *queryStats
+	// visited are the docs in the subgraph
+	visited map[string]struct{}
+	// used are the connections in the subgraph
+	used  map[string]struct{}
+	graph *apipb.Graph
+}
+
+func (e *extraction) extract(ctx context.Context, tx *bbolt.Tx) error {
+	var frontier []*apipb.Doc
+	for _, root := range e.filter.GetRoots() {
+		if _, ok := e.visited[refString(root)]; ok {
+			continue
+		}
+		doc, err := e.g.getDoc(ctx, tx, root)
+		if err != nil {
+			if err == ErrNotFound {
+				return status.Errorf(codes.NotFound, "root %s/%s not found", root.GetGtype(), root.GetGid())
+			}
+			return err
+		}
+		e.stats.decodedDoc()
+		if !e.visit(doc) {
+			return nil
+		}
+		frontier = append(frontier, doc)
+	}
+	for depth := uint64(0); depth < e.filter.GetMaxDepth() && len(frontier) > 0; depth++ {
+		var next []*apipb.Doc
+		for _, doc := range frontier {
+			if err := e.stats.err(); err != nil {
+				return err
+			}
+			found, more, err := e.expand(ctx, tx, doc)
+			if err != nil {
+				return err
+			}
+			next = append(next, found...)
+			if !more {
+				return e.stats.err()
+			}
+		}
+		frontier = next
+	}
+	return e.stats.err()
+}
+
+// visit adds the doc to the subgraph. It returns false if the subgraph has reached it's limit.
+func (e *extraction) visit(doc *apipb.Doc) bool {
+	e.visited[refString(doc.GetRef())] = struct{}{}
+	e.graph.Docs.Docs = append(e.graph.Docs.Docs, doc)
+	return len(e.graph.Docs.Docs) < int(e.filter.GetLimit())
+}
+
+// expand traverses the connections of the doc & returns the docs that were visited for the first time. It returns false
+// if the extraction should halt.
+func (e *extraction) expand(ctx context.Context, tx *bbolt.Tx, doc *apipb.Doc) ([]*apipb.Doc, bool, error) {
+	var (
+		found []*apipb.Doc
+		more  = true
+		fnErr error
+	)
+	if err := e.g.rangeDirection(ctx, tx, doc.GetRef(), e.filter.GetDirection(), func(connection *apipb.Connection) bool {
+		if fnErr = e.stats.scanned(); fnErr != nil {
+			return false
+		}
+		e.stats.decodedConnection()
+		key := refString(connection.GetRef())
+		if _, ok := e.used[key]; ok {
+			return true
+		}
+		if e.connectionProgram != nil {
+			pass, err := e.g.evalConnection(e.stats, connection, e.connectionProgram)
+			e.stats.swallowed(err)
+			if err != nil || !pass {
+				return true
+			}
+		}
+		// the doc on the other side of the connection
+		other := connection.GetTo()
+		if refString(other) == refString(doc.GetRef()) {
+			other = connection.GetFrom()
+		}
+		if _, ok := e.visited[refString(other)]; !ok {
+			otherDoc, err := e.g.getDoc(ctx, tx, other)
+			if err != nil {
+				if err != ErrNotFound {
+					fnErr = err
+					return false
+				}
+				e.stats.swallowed(err)
+				return true
+			}
+			e.stats.decodedDoc()
+			if e.docProgram != nil {
+				pass, err := e.g.evalDoc(e.stats, otherDoc, e.docProgram)
+				e.stats.swallowed(err)
+				if err != nil || !pass {
+					return true
+				}
+			}
+			found = append(found, otherDoc)
+			more = e.visit(otherDoc)
+		}
+		e.used[key] = struct{}{}
+		e.graph.Connections.Connections = append(e.graph.Connections.Connections, connection)
+		return more
+	}); err != nil {
+		return nil, false, err
+	}
+	if fnErr != nil {
+		return nil, false, fnErr
+	}
+	return found, more, nil
+}
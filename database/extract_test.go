@@ -0,0 +1,154 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sort"
+	"testing"
+)
+
+// createExtractTestGraph creates a -follows-> b, a -follows-> c, b -follows-> c, c -follows-> d & d -follows-> e where c
+// is private
+func createExtractTestGraph(t *testing.T, g *Graph, ctx context.Context) map[string]*apipb.Doc {
+	docs := map[string]*apipb.Doc{}
+	for _, gid := range []string{"a", "b", "c", "d", "e"} {
+		docs[gid] = createTestDoc(t, g, ctx, "person", gid, map[string]interface{}{"private": gid == "c"})
+	}
+	for _, pair := range [][2]string{{"a", "b"}, {"a", "c"}, {"b", "c"}, {"c", "d"}, {"d", "e"}} {
+		createTestConnection(t, g, ctx, "follows", docs[pair[0]].GetRef(), docs[pair[1]].GetRef(), true)
+	}
+	return docs
+}
+
+// subgraphGids returns the sorted gids of the subgraph's docs
+func subgraphGids(graph *apipb.Graph) string {
+	var gids []string
+	for _, doc := range graph.GetDocs().GetDocs() {
+		gids = append(gids, doc.GetRef().GetGid())
+	}
+	sort.Strings(gids)
+	return fmt.Sprint(gids)
+}
+
+func TestExtractSubgraph(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	docs := createExtractTestGraph(t, g, ctx)
+	for _, test := range []struct {
+		name        string
+		filter      *apipb.ExtractFilter
+		docs        string
+		connections string
+	}{
+		{
+			name:        "depth 1",
+			filter:      &apipb.ExtractFilter{Roots: []*apipb.Ref{docs["a"].GetRef()}, ConnectionExpression: "this.ref.gtype == 'follows'", MaxDepth: 1, Limit: 10},
+			docs:        "[a b c]",
+			connections: "[a->b a->c]",
+		},
+		{
+			// b -> c is traversed even though c was already visited
+			name:        "depth 2",
+			filter:      &apipb.ExtractFilter{Roots: []*apipb.Ref{docs["a"].GetRef()}, ConnectionExpression: "this.ref.gtype == 'follows'", MaxDepth: 2, Limit: 10},
+			docs:        "[a b c d]",
+			connections: "[a->b a->c b->c c->d]",
+		},
+		{
+			name:        "in",
+			filter:      &apipb.ExtractFilter{Roots: []*apipb.Ref{docs["c"].GetRef()}, ConnectionExpression: "this.ref.gtype == 'follows'", MaxDepth: 10, Limit: 10, Direction: apipb.Direction_IN},
+			docs:        "[a b c]",
+			connections: "[a->b a->c b->c]",
+		},
+		{
+			// connections to docs that fail the doc expression aren't traversed
+			name:        "doc expression",
+			filter:      &apipb.ExtractFilter{Roots: []*apipb.Ref{docs["a"].GetRef()}, DocExpression: "this.attributes.private == false", ConnectionExpression: "this.ref.gtype == 'follows'", MaxDepth: 10, Limit: 10},
+			docs:        "[a b]",
+			connections: "[a->b]",
+		},
+		{
+			// roots are included even if they fail the doc expression
+			name:        "root doc expression",
+			filter:      &apipb.ExtractFilter{Roots: []*apipb.Ref{docs["c"].GetRef()}, DocExpression: "this.attributes.private == false", ConnectionExpression: "this.ref.gtype == 'follows'", MaxDepth: 10, Limit: 10},
+			docs:        "[c d e]",
+			connections: "[c->d d->e]",
+		},
+		{
+			// without a connection expression the created_by connection to the user is traversed too
+			name:        "connection expression",
+			filter:      &apipb.ExtractFilter{Roots: []*apipb.Ref{docs["d"].GetRef()}, MaxDepth: 1, Limit: 10},
+			docs:        "[d e " + testRootUser + "]",
+			connections: "[d->e d->" + testRootUser + "]",
+		},
+	} {
+		graph, err := g.ExtractSubgraph(withMethod(g, ctx, "ExtractSubgraph"), test.filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := subgraphGids(graph); got != test.docs {
+			t.Fatalf("%s: expected docs %s, got %s", test.name, test.docs, got)
+		}
+		if got := connectionEnds(graph.GetConnections()); got != test.connections {
+			t.Fatalf("%s: expected connections %s, got %s", test.name, test.connections, got)
+		}
+	}
+}
+
+func TestExtractSubgraphLimit(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	docs := createExtractTestGraph(t, g, ctx)
+	graph, err := g.ExtractSubgraph(withMethod(g, ctx, "ExtractSubgraph"), &apipb.ExtractFilter{
+		Roots:                []*apipb.Ref{docs["a"].GetRef()},
+		ConnectionExpression: "this.ref.gtype == 'follows'",
+		MaxDepth:             10,
+		Limit:                2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(graph.GetDocs().GetDocs()) != 2 || graph.GetDocs().GetDocs()[0].GetRef().GetGid() != "a" {
+		t.Fatalf("expected the root & one other doc, got %s", subgraphGids(graph))
+	}
+	// the subgraph is truncated as soon as it's limit is reached, so every connection is between docs in it
+	visited := map[string]struct{}{}
+	for _, doc := range graph.GetDocs().GetDocs() {
+		visited[doc.GetRef().GetGid()] = struct{}{}
+	}
+	if len(graph.GetConnections().GetConnections()) != 1 {
+		t.Fatalf("expected 1 connection, got %s", connectionEnds(graph.GetConnections()))
+	}
+	for _, connection := range graph.GetConnections().GetConnections() {
+		_, from := visited[connection.GetFrom().GetGid()]
+		_, to := visited[connection.GetTo().GetGid()]
+		if !from || !to {
+			t.Fatalf("expected the connections to be between docs in the subgraph, got %s", connectionEnds(graph.GetConnections()))
+		}
+	}
+	// a root counts towards the limit too
+	graph, err = g.ExtractSubgraph(withMethod(g, ctx, "ExtractSubgraph"), &apipb.ExtractFilter{
+		Roots:    []*apipb.Ref{docs["a"].GetRef(), docs["e"].GetRef()},
+		MaxDepth: 10,
+		Limit:    1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := subgraphGids(graph); got != "[a]" || len(graph.GetConnections().GetConnections()) != 0 {
+		t.Fatalf("expected only the first root, got %s %s", got, connectionEnds(graph.GetConnections()))
+	}
+}
+
+func TestExtractSubgraphRootNotFound(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	docs := createExtractTestGraph(t, g, ctx)
+	_, err := g.ExtractSubgraph(withMethod(g, ctx, "ExtractSubgraph"), &apipb.ExtractFilter{
+		Roots:    []*apipb.Ref{docs["a"].GetRef(), {Gtype: "person", Gid: "z"}},
+		MaxDepth: 1,
+		Limit:    10,
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound, got %v", err)
+	}
+}
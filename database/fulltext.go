@@ -0,0 +1,293 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"github.com/google/cel-go/cel"
+	"github.com/graphikDB/graphik/aggregate"
+	"github.com/graphikDB/graphik/fulltext"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"github.com/graphikDB/graphik/logger"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"sort"
+	"strings"
+)
+
+var (
+	// textPostings maps term + \x00 + gid -> the term's frequency in the doc
+	textPostings = []byte("postings")
+	// textDocs maps gid -> the doc's term count followed by it's unique terms(so postings can be removed when the doc changes)
+	textDocs = []byte("docs")
+	// textStats holds the number of indexed docs & the total number of indexed terms(used to compute the average doc length)
+	textStats      = []byte("stats")
+	textStatsDocs  = []byte("docs")
+	textStatsTerms = []byte("terms")
+)
+
+// SearchText searches a FULL_TEXT index - returning docs ranked by relevance(BM25). Docs that don't pass the filter's
+// expression are skipped.
+func (g *Graph) SearchText(ctx context.Context, filter *apipb.TextSearchFilter) (*apipb.ScoredDocs, error) {
+	i := g.getIndex(ctx, filter.GetIndex())
+	if i == nil || i.index.GetKind() != apipb.IndexKind_FULL_TEXT {
+		return nil, status.Errorf(codes.NotFound, "full-text index %s not found", filter.GetIndex())
+	}
+	var (
+		program cel.Program
+		err     error
+	)
+	if filter.GetExpression() != "" {
+		program, err = g.vm.Doc().Program(filter.GetExpression())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	var terms []string
+	seen := map[string]struct{}{}
+	for _, term := range fulltext.Tokenize(filter.GetQuery()) {
+		if _, ok := seen[term]; !ok {
+			seen[term] = struct{}{}
+			terms = append(terms, term)
+		}
+	}
+	scoredDocs := &apipb.ScoredDocs{}
+	if len(terms) == 0 {
+		return scoredDocs, nil
+	}
+	if err := g.db.View(func(tx *bbolt.Tx) error {
+		bucket := g.textIndexBucket(ctx, tx, i.index.GetName())
+		if bucket == nil {
+			return ErrNotFound
+		}
+		stats := bucket.Bucket(textStats)
+		docCount := getUvarint(stats.Get(textStatsDocs))
+		var avgDocLen float64
+		if docCount > 0 {
+			avgDocLen = float64(getUvarint(stats.Get(textStatsTerms))) / float64(docCount)
+		}
+		postings := bucket.Bucket(textPostings)
+		docs := bucket.Bucket(textDocs)
+		scores := map[string]float64{}
+		for _, term := range terms {
+			prefix := append([]byte(term), 0)
+			var df uint64
+			c := postings.Cursor()
+			for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+				df++
+			}
+			for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				gid := string(k[len(prefix):])
+				docLen := getUvarint(docs.Get([]byte(gid)))
+				scores[gid] += fulltext.BM25(getUvarint(v), df, docLen, avgDocLen, docCount)
+			}
+		}
+		gids := make([]string, 0, len(scores))
+		for gid := range scores {
+			gids = append(gids, gid)
+		}
+		sort.Slice(gids, func(x, y int) bool {
+			if scores[gids[x]] == scores[gids[y]] {
+				return gids[x] < gids[y]
+			}
+			return scores[gids[x]] > scores[gids[y]]
+		})
+		for _, gid := range gids {
+			if len(scoredDocs.Docs) >= int(filter.GetLimit()) {
+				break
+			}
+			doc, err := g.getDoc(ctx, tx, &apipb.Ref{Gtype: i.index.GetGtype(), Gid: gid})
+			if err != nil {
+				if err == ErrNotFound {
+					continue
+				}
+				return err
+			}
+			if program != nil {
+				pass, err := g.vm.Doc().Eval(doc, program)
+				if err != nil || !pass {
+					continue
+				}
+			}
+			scoredDocs.Docs = append(scoredDocs.Docs, &apipb.ScoredDoc{
+				Doc:   doc,
+				Score: scores[gid],
+			})
+		}
+		return nil
+	}); err != nil {
+		if err == ErrNotFound {
+			return nil, status.Errorf(codes.NotFound, "full-text index %s not found", filter.GetIndex())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return scoredDocs, nil
+}
+
+func (g *Graph) textIndexBucket(ctx context.Context, tx *bbolt.Tx, name string) *bbolt.Bucket {
+	return g.bucket(ctx, tx, dbTextIndexes).Bucket([]byte(name))
+}
+
+// reindexText rebuilds a FULL_TEXT index from every doc of the index's type
+func (g *Graph) reindexText(ctx context.Context, tx *bbolt.Tx, i *apipb.Index) error {
+	parent := g.bucket(ctx, tx, dbTextIndexes)
+	if parent.Bucket([]byte(i.GetName())) != nil {
+		if err := parent.DeleteBucket([]byte(i.GetName())); err != nil {
+			return err
+		}
+	}
+	bucket, err := parent.CreateBucket([]byte(i.GetName()))
+	if err != nil {
+		return err
+	}
+	for _, name := range [][]byte{textPostings, textDocs, textStats} {
+		if _, err := bucket.CreateBucket(name); err != nil {
+			return err
+		}
+	}
+	ind, err := g.compileIndex(ctx, i)
+	if err != nil {
+		return err
+	}
+	docs := g.bucket(ctx, tx, dbDocs).Bucket([]byte(i.GetGtype()))
+	if docs == nil {
+		return nil
+	}
+	return docs.ForEach(func(k, v []byte) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var doc apipb.Doc
+		if err := g.unmarshal(v, &doc); err != nil {
+			return err
+		}
+		return g.setTextIndexedDoc(ctx, tx, ind, &doc)
+	})
+}
+
+// setTextIndexedDoc replaces the doc's terms in a FULL_TEXT index
+func (g *Graph) setTextIndexedDoc(ctx context.Context, tx *bbolt.Tx, i *index, doc *apipb.Doc) error {
+	if err := g.delTextIndexedDoc(ctx, tx, i.index.GetName(), doc.GetRef().GetGid()); err != nil {
+		return err
+	}
+	if i.program != nil {
+		pass, err := g.vm.Doc().Eval(doc, i.program)
+		if err != nil {
+			if !strings.Contains(err.Error(), "no such key") {
+				logger.Error("set full-text index failure", zap.Error(err))
+			}
+			return nil
+		}
+		if !pass {
+			return nil
+		}
+	}
+	var text []string
+	for _, field := range i.index.GetFields() {
+		text = append(text, textValue(aggregate.DocPath(doc, field)))
+	}
+	freqs, total := fulltext.TermFrequencies(strings.Join(text, " "))
+	if total == 0 {
+		return nil
+	}
+	bucket := g.textIndexBucket(ctx, tx, i.index.GetName())
+	if bucket == nil {
+		return ErrNotFound
+	}
+	gid := []byte(doc.GetRef().GetGid())
+	entry := uvarint(total)
+	for term, freq := range freqs {
+		if err := bucket.Bucket(textPostings).Put(postingKey(term, gid), uvarint(freq)); err != nil {
+			return err
+		}
+		entry = append(entry, term...)
+		entry = append(entry, 0)
+	}
+	if err := bucket.Bucket(textDocs).Put(gid, entry); err != nil {
+		return err
+	}
+	return addTextStats(bucket, 1, int64(total))
+}
+
+// delTextIndexedDoc removes the doc's terms from a FULL_TEXT index
+func (g *Graph) delTextIndexedDoc(ctx context.Context, tx *bbolt.Tx, index string, gid string) error {
+	bucket := g.textIndexBucket(ctx, tx, index)
+	if bucket == nil {
+		return ErrNotFound
+	}
+	entry := bucket.Bucket(textDocs).Get([]byte(gid))
+	if entry == nil {
+		return nil
+	}
+	// values are only valid until the bucket is modified
+	entry = append([]byte{}, entry...)
+	total, n := binary.Uvarint(entry)
+	if n <= 0 {
+		return errors.Errorf("corrupt full-text index entry: %s", gid)
+	}
+	for _, term := range bytes.Split(entry[n:], []byte{0}) {
+		if len(term) == 0 {
+			continue
+		}
+		if err := bucket.Bucket(textPostings).Delete(postingKey(string(term), []byte(gid))); err != nil {
+			return err
+		}
+	}
+	if err := bucket.Bucket(textDocs).Delete([]byte(gid)); err != nil {
+		return err
+	}
+	return addTextStats(bucket, -1, -int64(total))
+}
+
+func addTextStats(bucket *bbolt.Bucket, docs, terms int64) error {
+	stats := bucket.Bucket(textStats)
+	for key, delta := range map[string]int64{string(textStatsDocs): docs, string(textStatsTerms): terms} {
+		current := int64(getUvarint(stats.Get([]byte(key)))) + delta
+		if current < 0 {
+			current = 0
+		}
+		if err := stats.Put([]byte(key), uvarint(uint64(current))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func postingKey(term string, gid []byte) []byte {
+	key := make([]byte, 0, len(term)+1+len(gid))
+	key = append(key, term...)
+	key = append(key, 0)
+	return append(key, gid...)
+}
+
+func uvarint(val uint64) []byte {
+	bits := make([]byte, binary.MaxVarintLen64)
+	return bits[:binary.PutUvarint(bits, val)]
+}
+
+func getUvarint(bits []byte) uint64 {
+	val, _ := binary.Uvarint(bits)
+	return val
+}
+
+// textValue returns the text of a string attribute or a list of string attributes
+func textValue(val *structpb.Value) string {
+	switch kind := val.GetKind().(type) {
+	case *structpb.Value_StringValue:
+		return kind.StringValue
+	case *structpb.Value_ListValue:
+		var values []string
+		for _, v := range kind.ListValue.GetValues() {
+			values = append(values, textValue(v))
+		}
+		return strings.Join(values, " ")
+	}
+	return ""
+}
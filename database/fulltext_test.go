@@ -0,0 +1,98 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"go.etcd.io/bbolt"
+	"sort"
+	"testing"
+)
+
+// scoredGids returns the sorted gids of the scored docs
+func scoredGids(docs *apipb.ScoredDocs) string {
+	var gids []string
+	for _, doc := range docs.GetDocs() {
+		gids = append(gids, doc.GetDoc().GetRef().GetGid())
+	}
+	sort.Strings(gids)
+	return fmt.Sprint(gids)
+}
+
+// assertTextIndexed checks the number of docs in a FULL_TEXT index's stats
+func assertTextIndexed(t *testing.T, g *Graph, ctx context.Context, index string, expected uint64) {
+	t.Helper()
+	if err := g.db.View(func(tx *bbolt.Tx) error {
+		if count := getUvarint(g.textIndexBucket(ctx, tx, index).Bucket(textStats).Get(textStatsDocs)); count != expected {
+			t.Fatalf("expected %v indexed docs, got %v", expected, count)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSearchText(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	if _, err := g.SetIndexes(withMethod(g, ctx, "SetIndexes"), &apipb.Indexes{Indexes: []*apipb.Index{
+		{Name: "titles", Gtype: "note", Docs: true, Kind: apipb.IndexKind_FULL_TEXT, Fields: []string{"attributes.title"}},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	createTestDoc(t, g, ctx, "note", "n1", map[string]interface{}{"title": "the quick brown fox"})
+	createTestDoc(t, g, ctx, "note", "n2", map[string]interface{}{"title": "lazy brown dog"})
+	createTestDoc(t, g, ctx, "note", "n3", map[string]interface{}{"title": "quick fox, quicker fox"})
+	// docs of other types aren't indexed even if they share a gid with an indexed doc
+	createTestDoc(t, g, ctx, "post", "n1", map[string]interface{}{"title": "brown cat"})
+	search := func(query, expected string) {
+		t.Helper()
+		docs, err := g.SearchText(withMethod(g, ctx, "SearchText"), &apipb.TextSearchFilter{Index: "titles", Query: query, Limit: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := scoredGids(docs); got != expected {
+			t.Fatalf("%s: expected %s, got %s", query, expected, got)
+		}
+	}
+	search("fox", "[n1 n3]")
+	search("brown", "[n1 n2]")
+	search("cat", "[]")
+	docs, err := g.SearchText(withMethod(g, ctx, "SearchText"), &apipb.TextSearchFilter{Index: "titles", Query: "quick fox", Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// n3 mentions fox twice in a shorter title
+	if len(docs.GetDocs()) != 1 || docs.GetDocs()[0].GetDoc().GetRef().GetGid() != "n3" {
+		t.Fatalf("expected n3 to be ranked first, got %v", docs.GetDocs())
+	}
+
+	// editing a doc replaces it's terms
+	if _, err := g.EditDoc(withMethod(g, ctx, "EditDoc"), &apipb.Edit{
+		Ref:        &apipb.Ref{Gtype: "note", Gid: "n2"},
+		Attributes: apipb.NewStruct(map[string]interface{}{"title": "sleeping cat"}),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	search("brown", "[n1]")
+	search("cat", "[n2]")
+
+	// a deleted doc's terms are removed, so they don't match a new doc with the same gid
+	if _, err := g.DelDoc(withMethod(g, ctx, "DelDoc"), &apipb.Ref{Gtype: "note", Gid: "n1"}); err != nil {
+		t.Fatal(err)
+	}
+	search("fox", "[n3]")
+	assertTextIndexed(t, g, ctx, "titles", 2)
+	createTestDoc(t, g, ctx, "note", "n1", map[string]interface{}{"title": "another cat"})
+	search("fox", "[n3]")
+	search("brown", "[]")
+	search("cat", "[n1 n2]")
+	assertTextIndexed(t, g, ctx, "titles", 3)
+
+	docs, err = g.SearchText(withMethod(g, ctx, "SearchText"), &apipb.TextSearchFilter{Index: "titles", Query: "cat", Expression: "this.ref.gid == 'n2'", Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := scoredGids(docs); got != "[n2]" {
+		t.Fatalf("expected the expression to filter the results, got %s", got)
+	}
+}
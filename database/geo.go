@@ -0,0 +1,347 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"github.com/google/cel-go/cel"
+	"github.com/graphikDB/graphik/aggregate"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"github.com/graphikDB/graphik/geo"
+	"go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"math"
+	"sort"
+)
+
+const (
+	// maxGeoCells is the max number of geohash cells scanned per bounding box
+	maxGeoCells = 32
+	// nearestStartMeters is the initial search radius of a nearest query - it's quadrupled until enough docs are found
+	nearestStartMeters = 1000
+)
+
+var (
+	// geoCells maps geohash + \x00 + gid -> the doc's latitude & longitude
+	geoCells = []byte("cells")
+	// geoDocs maps gid -> the doc's key in the cells bucket(so it can be removed when the doc changes)
+	geoDocs = []byte("docs")
+)
+
+// SearchGeo searches a GEO index for docs within a radius, within a bounding box, or nearest to a point.
+// Docs that don't pass the filter's expression or weren't found by the filter's traversal are skipped.
+func (g *Graph) SearchGeo(ctx context.Context, filter *apipb.GeoFilter) (*apipb.GeoDocs, error) {
+	i := g.getIndex(ctx, filter.GetIndex())
+	if i == nil || i.index.GetKind() != apipb.IndexKind_GEO {
+		return nil, status.Errorf(codes.NotFound, "geo index %s not found", filter.GetIndex())
+	}
+	var (
+		program cel.Program
+		err     error
+	)
+	if filter.GetExpression() != "" {
+		program, err = g.vm.Doc().Program(filter.GetExpression())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	var walker *traversal
+	traversed := map[string]struct{}{}
+	if filter.GetTraverse() != nil {
+		walker, err = g.newTraversal(filter.GetTraverse(), func(t *apipb.Traversal) error {
+			traversed[refString(t.GetDoc().GetRef())] = struct{}{}
+			return nil
+		})
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	results := &apipb.GeoDocs{}
+	if err := g.db.View(func(tx *bbolt.Tx) error {
+		bucket := g.geoIndexBucket(ctx, tx, i.index.GetName())
+		if bucket == nil {
+			return ErrNotFound
+		}
+		if walker != nil {
+			walkCtx, finish := g.startQuery(ctx, filter.GetTraverse(), filter.GetTraverse().GetBudget(), false)
+			if err := finish(len(traversed), "", walker.Walk(walkCtx, tx)); err != nil {
+				return err
+			}
+		}
+		s := &geoSearch{
+			g:     g,
+			ctx:   ctx,
+			tx:    tx,
+			index: i.index,
+			cells: bucket.Bucket(geoCells),
+			seen:  map[string]struct{}{},
+			filter: func(doc *apipb.Doc) bool {
+				if walker != nil {
+					if _, ok := traversed[refString(doc.GetRef())]; !ok {
+						return false
+					}
+				}
+				if program != nil {
+					pass, err := g.vm.Doc().Eval(doc, program)
+					if err != nil || !pass {
+						return false
+					}
+				}
+				return true
+			},
+		}
+		switch query := filter.GetQuery().(type) {
+		case *apipb.GeoFilter_Box:
+			box := geo.Box{
+				Min: geo.Point{Lat: query.Box.GetMin().GetLat(), Lng: query.Box.GetMin().GetLng()},
+				Max: geo.Point{Lat: query.Box.GetMax().GetLat(), Lng: query.Box.GetMax().GetLng()},
+			}
+			for _, box := range geo.SplitBox(box) {
+				if err := s.scan(box, func(doc *apipb.Doc, point geo.Point) bool {
+					results.Docs = append(results.Docs, &apipb.GeoDoc{Doc: doc})
+					return len(results.Docs) < int(filter.GetLimit())
+				}); err != nil {
+					return err
+				}
+			}
+		case *apipb.GeoFilter_Radius:
+			center := geo.Point{Lat: query.Radius.GetCenter().GetLat(), Lng: query.Radius.GetCenter().GetLng()}
+			docs, err := s.radius(center, query.Radius.GetMeters())
+			if err != nil {
+				return err
+			}
+			results.Docs = docs
+		case *apipb.GeoFilter_Nearest:
+			center := geo.Point{Lat: query.Nearest.GetLat(), Lng: query.Nearest.GetLng()}
+			// every doc within the radius is found, so once the radius contains k docs they're the k nearest
+			for meters := float64(nearestStartMeters); ; meters *= 4 {
+				docs, err := s.radius(center, math.Min(meters, geo.MaxDistance))
+				if err != nil {
+					return err
+				}
+				results.Docs = append(results.Docs, docs...)
+				sort.SliceStable(results.Docs, func(x, y int) bool {
+					return results.Docs[x].GetMeters() < results.Docs[y].GetMeters()
+				})
+				if len(results.Docs) >= int(filter.GetLimit()) || meters >= geo.MaxDistance {
+					break
+				}
+			}
+		default:
+			return status.Error(codes.InvalidArgument, "a radius, box or nearest query is required")
+		}
+		return nil
+	}); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		if err == ErrNotFound {
+			return nil, status.Errorf(codes.NotFound, "geo index %s not found", filter.GetIndex())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if len(results.Docs) > int(filter.GetLimit()) {
+		results.Docs = results.Docs[:filter.GetLimit()]
+	}
+	return results, nil
+}
+
+// geoSearch scans the cells of a GEO index
+type geoSearch struct {
+	g      *Graph
+	ctx    context.Context
+	tx     *bbolt.Tx
+	index  *apipb.Index
+	cells  *bbolt.Bucket
+	filter func(doc *apipb.Doc) bool
+	// seen are the docs that have already been returned - cells may overlap & nearest queries scan the same cells more than once
+	seen map[string]struct{}
+}
+
+// radius returns the docs within meters of the center sorted by distance
+func (s *geoSearch) radius(center geo.Point, meters float64) ([]*apipb.GeoDoc, error) {
+	var docs []*apipb.GeoDoc
+	for _, box := range geo.RadiusBoxes(center, meters) {
+		if err := s.scan(box, func(doc *apipb.Doc, point geo.Point) bool {
+			docs = append(docs, &apipb.GeoDoc{
+				Doc:    doc,
+				Meters: geo.Distance(center, point),
+			})
+			return true
+		}, func(point geo.Point) bool {
+			return geo.Distance(center, point) <= meters
+		}); err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(docs, func(x, y int) bool {
+		return docs[x].GetMeters() < docs[y].GetMeters()
+	})
+	return docs, nil
+}
+
+// scan executes fn against each doc within the box that passes the search filter & any additional point filters
+func (s *geoSearch) scan(box geo.Box, fn func(doc *apipb.Doc, point geo.Point) bool, pointFilters ...func(point geo.Point) bool) error {
+	c := s.cells.Cursor()
+	for _, cell := range geo.Cover(box, maxGeoCells) {
+		prefix := []byte(cell)
+	cursor:
+		for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			if err := s.ctx.Err(); err != nil {
+				return err
+			}
+			point := decodeGeoPoint(v)
+			if !box.Contains(point) {
+				continue
+			}
+			for _, pointFilter := range pointFilters {
+				if !pointFilter(point) {
+					continue cursor
+				}
+			}
+			gid := string(k[bytes.IndexByte(k, 0)+1:])
+			if _, ok := s.seen[gid]; ok {
+				continue
+			}
+			doc, err := s.g.getDoc(s.ctx, s.tx, &apipb.Ref{Gtype: s.index.GetGtype(), Gid: gid})
+			if err != nil {
+				if err == ErrNotFound {
+					continue
+				}
+				return err
+			}
+			if !s.filter(doc) {
+				continue
+			}
+			s.seen[gid] = struct{}{}
+			if !fn(doc, point) {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+func (g *Graph) geoIndexBucket(ctx context.Context, tx *bbolt.Tx, name string) *bbolt.Bucket {
+	return g.bucket(ctx, tx, dbGeoIndexes).Bucket([]byte(name))
+}
+
+// reindexGeo rebuilds a GEO index from every doc of the index's type
+func (g *Graph) reindexGeo(ctx context.Context, tx *bbolt.Tx, i *apipb.Index) error {
+	parent := g.bucket(ctx, tx, dbGeoIndexes)
+	if parent.Bucket([]byte(i.GetName())) != nil {
+		if err := parent.DeleteBucket([]byte(i.GetName())); err != nil {
+			return err
+		}
+	}
+	bucket, err := parent.CreateBucket([]byte(i.GetName()))
+	if err != nil {
+		return err
+	}
+	for _, name := range [][]byte{geoCells, geoDocs} {
+		if _, err := bucket.CreateBucket(name); err != nil {
+			return err
+		}
+	}
+	ind, err := g.compileIndex(ctx, i)
+	if err != nil {
+		return err
+	}
+	docs := g.bucket(ctx, tx, dbDocs).Bucket([]byte(i.GetGtype()))
+	if docs == nil {
+		return nil
+	}
+	return docs.ForEach(func(k, v []byte) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var doc apipb.Doc
+		if err := g.unmarshal(v, &doc); err != nil {
+			return err
+		}
+		return g.setGeoIndexedDoc(ctx, tx, ind, &doc)
+	})
+}
+
+// setGeoIndexedDoc replaces the doc's coordinates in a GEO index. Docs without valid coordinates aren't indexed.
+func (g *Graph) setGeoIndexedDoc(ctx context.Context, tx *bbolt.Tx, i *index, doc *apipb.Doc) error {
+	if err := g.delGeoIndexedDoc(ctx, tx, i.index.GetName(), doc.GetRef().GetGid()); err != nil {
+		return err
+	}
+	if i.program != nil {
+		pass, err := g.vm.Doc().Eval(doc, i.program)
+		if err != nil || !pass {
+			return nil
+		}
+	}
+	if len(i.index.GetFields()) != 2 {
+		return nil
+	}
+	lat, ok := numberAt(doc, i.index.GetFields()[0])
+	if !ok {
+		return nil
+	}
+	lng, ok := numberAt(doc, i.index.GetFields()[1])
+	if !ok {
+		return nil
+	}
+	point := geo.Point{Lat: lat, Lng: lng}
+	if !point.Valid() {
+		return nil
+	}
+	bucket := g.geoIndexBucket(ctx, tx, i.index.GetName())
+	if bucket == nil {
+		return ErrNotFound
+	}
+	key := append([]byte(geo.Encode(point, geo.MaxPrecision)), 0)
+	key = append(key, doc.GetRef().GetGid()...)
+	if err := bucket.Bucket(geoCells).Put(key, encodeGeoPoint(point)); err != nil {
+		return err
+	}
+	return bucket.Bucket(geoDocs).Put([]byte(doc.GetRef().GetGid()), key)
+}
+
+// delGeoIndexedDoc removes the doc's coordinates from a GEO index
+func (g *Graph) delGeoIndexedDoc(ctx context.Context, tx *bbolt.Tx, index string, gid string) error {
+	bucket := g.geoIndexBucket(ctx, tx, index)
+	if bucket == nil {
+		return ErrNotFound
+	}
+	key := bucket.Bucket(geoDocs).Get([]byte(gid))
+	if key == nil {
+		return nil
+	}
+	// values are only valid until the bucket is modified
+	key = append([]byte{}, key...)
+	if err := bucket.Bucket(geoCells).Delete(key); err != nil {
+		return err
+	}
+	return bucket.Bucket(geoDocs).Delete([]byte(gid))
+}
+
+func numberAt(doc *apipb.Doc, path string) (float64, bool) {
+	val, ok := aggregate.DocPath(doc, path).GetKind().(*structpb.Value_NumberValue)
+	if !ok {
+		return 0, false
+	}
+	return val.NumberValue, true
+}
+
+func encodeGeoPoint(point geo.Point) []byte {
+	bits := make([]byte, 16)
+	binary.BigEndian.PutUint64(bits[:8], math.Float64bits(point.Lat))
+	binary.BigEndian.PutUint64(bits[8:], math.Float64bits(point.Lng))
+	return bits
+}
+
+func decodeGeoPoint(bits []byte) geo.Point {
+	if len(bits) < 16 {
+		return geo.Point{Lat: math.NaN(), Lng: math.NaN()}
+	}
+	return geo.Point{
+		Lat: math.Float64frombits(binary.BigEndian.Uint64(bits[:8])),
+		Lng: math.Float64frombits(binary.BigEndian.Uint64(bits[8:])),
+	}
+}
@@ -0,0 +1,116 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"go.etcd.io/bbolt"
+	"sort"
+	"testing"
+)
+
+// geoGids returns the sorted gids of the geo docs
+func geoGids(docs *apipb.GeoDocs) string {
+	var gids []string
+	for _, doc := range docs.GetDocs() {
+		gids = append(gids, doc.GetDoc().GetRef().GetGid())
+	}
+	sort.Strings(gids)
+	return fmt.Sprint(gids)
+}
+
+// assertGeoIndexed checks the number of docs in a GEO index
+func assertGeoIndexed(t *testing.T, g *Graph, ctx context.Context, index string, expected int) {
+	t.Helper()
+	if err := g.db.View(func(tx *bbolt.Tx) error {
+		if count := g.geoIndexBucket(ctx, tx, index).Bucket(geoDocs).Stats().KeyN; count != expected {
+			t.Fatalf("expected %v indexed docs, got %v", expected, count)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSearchGeo(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	if _, err := g.SetIndexes(withMethod(g, ctx, "SetIndexes"), &apipb.Indexes{Indexes: []*apipb.Index{
+		{Name: "places", Gtype: "place", Docs: true, Kind: apipb.IndexKind_GEO, Fields: []string{"attributes.lat", "attributes.lng"}},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	for gid, point := range map[string][2]float64{
+		"sf":  {37.7749, -122.4194},
+		"oak": {37.8044, -122.2712},
+		"la":  {34.0522, -118.2437},
+		"ny":  {40.7128, -74.0060},
+	} {
+		createTestDoc(t, g, ctx, "place", gid, map[string]interface{}{"lat": point[0], "lng": point[1]})
+	}
+	// docs of other types aren't indexed even if they share a gid with an indexed doc
+	createTestDoc(t, g, ctx, "city", "sf", map[string]interface{}{"lat": 37.7749, "lng": -122.4194})
+	var (
+		sf         = &apipb.GeoPoint{Lat: 37.7749, Lng: -122.4194}
+		ny         = &apipb.GeoPoint{Lat: 40.7128, Lng: -74.0060}
+		california = &apipb.GeoBox{Min: &apipb.GeoPoint{Lat: 32, Lng: -125}, Max: &apipb.GeoPoint{Lat: 42, Lng: -114}}
+	)
+	search := func(filter *apipb.GeoFilter, expected string) *apipb.GeoDocs {
+		t.Helper()
+		filter.Index = "places"
+		if filter.Limit == 0 {
+			filter.Limit = 10
+		}
+		docs, err := g.SearchGeo(withMethod(g, ctx, "SearchGeo"), filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := geoGids(docs); got != expected {
+			t.Fatalf("%v: expected %s, got %s", filter.GetQuery(), expected, got)
+		}
+		return docs
+	}
+	radius := func(center *apipb.GeoPoint, meters float64) *apipb.GeoFilter {
+		return &apipb.GeoFilter{Query: &apipb.GeoFilter_Radius{Radius: &apipb.GeoRadius{Center: center, Meters: meters}}}
+	}
+	// oakland is ~13km from san francisco
+	docs := search(radius(sf, 20000), "[oak sf]")
+	if docs.GetDocs()[0].GetDoc().GetRef().GetGid() != "sf" || docs.GetDocs()[0].GetMeters() > 1 {
+		t.Fatalf("expected sf to be nearest the center, got %v", docs.GetDocs())
+	}
+	if meters := docs.GetDocs()[1].GetMeters(); meters < 10000 || meters > 16000 {
+		t.Fatalf("expected oak to be ~13km from sf, got %vm", meters)
+	}
+	search(radius(sf, 5000), "[sf]")
+	search(&apipb.GeoFilter{Query: &apipb.GeoFilter_Box{Box: california}}, "[la oak sf]")
+	search(&apipb.GeoFilter{Query: &apipb.GeoFilter_Nearest{Nearest: sf}, Limit: 2}, "[oak sf]")
+	search(&apipb.GeoFilter{Query: &apipb.GeoFilter_Box{Box: california}, Expression: "this.attributes.lat < 35.0"}, "[la]")
+
+	// editing a doc's coordinates moves it
+	if _, err := g.EditDoc(withMethod(g, ctx, "EditDoc"), &apipb.Edit{
+		Ref:        &apipb.Ref{Gtype: "place", Gid: "oak"},
+		Attributes: apipb.NewStruct(map[string]interface{}{"lat": 40.7306, "lng": -73.9866}),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	search(radius(sf, 20000), "[sf]")
+	search(radius(ny, 20000), "[ny oak]")
+	// & removing them removes it from the index
+	if _, err := g.EditDoc(withMethod(g, ctx, "EditDoc"), &apipb.Edit{
+		Ref:        &apipb.Ref{Gtype: "place", Gid: "la"},
+		Attributes: apipb.NewStruct(map[string]interface{}{"lat": "unknown"}),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	search(&apipb.GeoFilter{Query: &apipb.GeoFilter_Box{Box: california}}, "[sf]")
+	assertGeoIndexed(t, g, ctx, "places", 3)
+
+	// a deleted doc's coordinates are removed, so they don't match a new doc with the same gid
+	if _, err := g.DelDoc(withMethod(g, ctx, "DelDoc"), &apipb.Ref{Gtype: "place", Gid: "sf"}); err != nil {
+		t.Fatal(err)
+	}
+	assertGeoIndexed(t, g, ctx, "places", 2)
+	createTestDoc(t, g, ctx, "place", "sf", map[string]interface{}{"name": "san francisco"})
+	search(radius(sf, 20000), "[]")
+	search(&apipb.GeoFilter{Query: &apipb.GeoFilter_Nearest{Nearest: sf}, Limit: 10}, "[ny oak]")
+	assertGeoIndexed(t, g, ctx, "places", 2)
+}
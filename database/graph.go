@@ -7,9 +7,9 @@ import (
 	"github.com/autom8ter/machine"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/google/cel-go/cel"
+	"github.com/graphikDB/graphik/aggregate"
 	"github.com/graphikDB/graphik/gen/grpc/go"
 	"github.com/graphikDB/graphik/generic"
-	"github.com/graphikDB/graphik/helpers"
 	"github.com/graphikDB/graphik/logger"
 	"github.com/graphikDB/graphik/vm"
 	"github.com/lestrrat-go/jwx/jwk"
@@ -20,8 +20,10 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"io/ioutil"
+	"math"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -43,6 +45,7 @@ type Graph struct {
 	mu              sync.RWMutex
 	connectionsTo   map[string]map[string]struct{}
 	connectionsFrom map[string]map[string]struct{}
+	degrees         map[string]map[string]*degree
 	machine         *machine.Machine
 	closers         []func()
 	closeOnce       sync.Once
@@ -50,6 +53,18 @@ type Graph struct {
 	authorizers     *generic.Cache
 	typeValidators  *generic.Cache
 	rootUsers       []string
+	// namespaceClaim is the userinfo claim used to determine the namespace of a request
+	namespaceClaim string
+	namespaces     *generic.Cache
+	crypter        *crypter
+	// idempotencyWindow is how long idempotency keys are remembered
+	idempotencyWindow time.Duration
+	// limits are the server-wide query limits
+	limits limits
+	// analytics are the running & recently finished analytics jobs
+	analytics *generic.Cache
+	// staged are the adjacency changes made by the open write transaction - they're applied once it commits
+	staged *adjacencyChanges
 }
 
 // NewGraph takes a file path and returns a connected Raft backend.
@@ -60,6 +75,21 @@ func NewGraph(ctx context.Context, flgs *apipb.Flags) (*Graph, error) {
 	if err != nil {
 		return nil, err
 	}
+	crypter, err := newCrypter(flgs)
+	if err != nil {
+		return nil, err
+	}
+	idempotencyWindow := 24 * time.Hour
+	if flgs.GetIdempotencyWindow() != "" {
+		idempotencyWindow, err = time.ParseDuration(flgs.GetIdempotencyWindow())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse idempotency window")
+		}
+	}
+	limits, err := newLimits(flgs)
+	if err != nil {
+		return nil, err
+	}
 	vMachine, err := vm.NewVM()
 	if err != nil {
 		return nil, err
@@ -67,22 +97,29 @@ func NewGraph(ctx context.Context, flgs *apipb.Flags) (*Graph, error) {
 	var closers []func()
 	m := machine.New(ctx, machine.WithMaxRoutines(100000))
 	g := &Graph{
-		vm:              vMachine,
-		db:              handle,
-		jwksMu:          sync.RWMutex{},
-		jwksSet:         nil,
-		path:            path,
-		mu:              sync.RWMutex{},
-		connectionsTo:   map[string]map[string]struct{}{},
-		connectionsFrom: map[string]map[string]struct{}{},
-		machine:         m,
-		closers:         closers,
-		closeOnce:       sync.Once{},
-		jwtCache:        generic.NewCache(m, 1*time.Minute),
-		indexes:         generic.NewCache(m, 1*time.Hour),
-		authorizers:     generic.NewCache(m, 1*time.Hour),
-		typeValidators:  generic.NewCache(m, 1*time.Hour),
-		rootUsers:       flgs.RootUsers,
+		vm:                vMachine,
+		db:                handle,
+		jwksMu:            sync.RWMutex{},
+		jwksSet:           nil,
+		path:              path,
+		mu:                sync.RWMutex{},
+		connectionsTo:     map[string]map[string]struct{}{},
+		connectionsFrom:   map[string]map[string]struct{}{},
+		degrees:           map[string]map[string]*degree{},
+		machine:           m,
+		closers:           closers,
+		closeOnce:         sync.Once{},
+		jwtCache:          generic.NewCache(m, 1*time.Minute),
+		indexes:           generic.NewCache(m, 1*time.Hour),
+		authorizers:       generic.NewCache(m, 1*time.Hour),
+		typeValidators:    generic.NewCache(m, 1*time.Hour),
+		rootUsers:         flgs.RootUsers,
+		crypter:           crypter,
+		idempotencyWindow: idempotencyWindow,
+		namespaceClaim:    flgs.GetNamespaceClaim(),
+		namespaces:        generic.NewCache(m, 1*time.Hour),
+		limits:            limits,
+		analytics:         generic.NewCache(m, 1*time.Hour),
 	}
 	if flgs.OpenIdDiscovery != "" {
 		resp, err := http.DefaultClient.Get(flgs.OpenIdDiscovery)
@@ -136,18 +173,65 @@ func NewGraph(ctx context.Context, flgs *apipb.Flags) (*Graph, error) {
 		if err != nil {
 			return errors.Wrap(err, "failed to create connection/index bucket")
 		}
+		_, err = tx.CreateBucketIfNotExists(dbIdempotency)
+		if err != nil {
+			return errors.Wrap(err, "failed to create idempotency bucket")
+		}
+		_, err = tx.CreateBucketIfNotExists(dbNamespaces)
+		if err != nil {
+			return errors.Wrap(err, "failed to create namespaces bucket")
+		}
+		_, err = tx.CreateBucketIfNotExists(dbMigrations)
+		if err != nil {
+			return errors.Wrap(err, "failed to create migrations bucket")
+		}
+		_, err = tx.CreateBucketIfNotExists(dbTextIndexes)
+		if err != nil {
+			return errors.Wrap(err, "failed to create full-text index bucket")
+		}
+		_, err = tx.CreateBucketIfNotExists(dbGeoIndexes)
+		if err != nil {
+			return errors.Wrap(err, "failed to create geo index bucket")
+		}
+		_, err = tx.CreateBucketIfNotExists(dbVectorIndexes)
+		if err != nil {
+			return errors.Wrap(err, "failed to create vector index bucket")
+		}
+		_, err = tx.CreateBucketIfNotExists(dbValueIndexes)
+		if err != nil {
+			return errors.Wrap(err, "failed to create value index bucket")
+		}
+		_, err = tx.CreateBucketIfNotExists(dbBackfilledIndexes)
+		if err != nil {
+			return errors.Wrap(err, "failed to create backfilled index bucket")
+		}
 		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
-	if err := g.cacheConnectionRefs(); err != nil {
-		return nil, err
-	}
-	if err := g.cacheIndexes(); err != nil {
-		return nil, err
-	}
-	if err := g.cacheAuthorizers(); err != nil {
+	if err := g.rangeNamespaces(ctx, func(ctx context.Context) error {
+		// namespaces created by an older version may be missing buckets
+		if err := g.setNamespace(g.getNamespace(ctx)); err != nil {
+			return err
+		}
+		if err := g.cacheConnectionRefs(ctx); err != nil {
+			return err
+		}
+		if err := g.cacheIndexes(ctx); err != nil {
+			return err
+		}
+		if err := g.backfillIndexes(ctx); err != nil {
+			return err
+		}
+		if err := g.cacheAuthorizers(ctx); err != nil {
+			return err
+		}
+		if err := g.cacheTypeValidators(ctx); err != nil {
+			return err
+		}
+		return g.failInterruptedMigrations(ctx)
+	}); err != nil {
 		return nil, err
 	}
 	g.machine.Go(func(routine machine.Routine) {
@@ -162,6 +246,11 @@ func NewGraph(ctx context.Context, flgs *apipb.Flags) (*Graph, error) {
 			g.jwksMu.Unlock()
 		}
 	}, machine.GoWithMiddlewares(machine.Cron(time.NewTicker(1*time.Minute))))
+	g.machine.Go(func(routine machine.Routine) {
+		if err := g.rangeNamespaces(routine.Context(), g.expireIdempotencyKeys); err != nil {
+			logger.Error("failed to expire idempotency keys", zap.Error(err))
+		}
+	}, machine.GoWithMiddlewares(machine.Cron(time.NewTicker(1*time.Minute))))
 	return g, nil
 }
 
@@ -193,7 +282,7 @@ func (g *Graph) GetSchema(ctx context.Context, _ *empty.Empty) (*apipb.Schema, e
 		return nil, err
 	}
 	var indexes []*apipb.Index
-	g.rangeIndexes(func(index *index) bool {
+	g.rangeIndexes(ctx, func(index *index) bool {
 		indexes = append(indexes, index.index)
 		return true
 	})
@@ -201,7 +290,7 @@ func (g *Graph) GetSchema(ctx context.Context, _ *empty.Empty) (*apipb.Schema, e
 		return indexes[i].Name < indexes[j].Name
 	})
 	var authorizers []*apipb.Authorizer
-	g.rangeAuthorizers(func(a *authorizer) bool {
+	g.rangeAuthorizers(ctx, func(a *authorizer) bool {
 		authorizers = append(authorizers, a.authorizer)
 		return true
 	})
@@ -209,7 +298,7 @@ func (g *Graph) GetSchema(ctx context.Context, _ *empty.Empty) (*apipb.Schema, e
 		return authorizers[i].Name < authorizers[j].Name
 	})
 	var typeValidators []*apipb.TypeValidator
-	g.rangeTypeValidators(func(v *typeValidator) bool {
+	g.rangeTypeValidators(ctx, func(v *typeValidator) bool {
 		typeValidators = append(typeValidators, v.validator)
 		return true
 	})
@@ -232,6 +321,14 @@ func (g *Graph) SetIndexes(ctx context.Context, index2 *apipb.Indexes) (*empty.E
 	if user == nil {
 		return nil, status.Error(codes.Unauthenticated, "failed to get user")
 	}
+	for _, index := range index2.GetIndexes() {
+		if err := validateIndex(index); err != nil {
+			return nil, err
+		}
+		if err := g.checkIndexEncryption(index); err != nil {
+			return nil, err
+		}
+	}
 	var indexes []*apipb.Index
 	if err := g.db.Update(func(tx *bbolt.Tx) error {
 		for _, index := range index2.GetIndexes() {
@@ -239,13 +336,75 @@ func (g *Graph) SetIndexes(ctx context.Context, index2 *apipb.Indexes) (*empty.E
 			if err != nil {
 				return err
 			}
+			// indexes are backfilled with the docs/connections that already exist
+			if err := g.reindex(ctx, tx, i); err != nil {
+				return err
+			}
 			indexes = append(indexes, i)
 		}
 		return nil
 	}); err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	return &empty.Empty{}, g.cacheIndexes()
+	return &empty.Empty{}, g.cacheIndexes(ctx)
+}
+
+// validateIndex checks the fields required by the index's kind
+func validateIndex(i *apipb.Index) error {
+	if i.GetDocs() && i.GetConnections() {
+		return status.Errorf(codes.InvalidArgument, "index %s: either docs or connections may be true, but not both", i.GetName())
+	}
+	switch i.GetKind() {
+	case apipb.IndexKind_FULL_TEXT:
+		if !i.GetDocs() {
+			return status.Errorf(codes.InvalidArgument, "index %s: full-text indexes only support docs", i.GetName())
+		}
+		if len(i.GetFields()) == 0 {
+			return status.Errorf(codes.InvalidArgument, "index %s: full-text indexes require at least one field", i.GetName())
+		}
+		for _, field := range i.GetFields() {
+			if !strings.HasPrefix(field, "attributes.") {
+				return status.Errorf(codes.InvalidArgument, "index %s: full-text fields must be attributes ex: attributes.title", i.GetName())
+			}
+		}
+	case apipb.IndexKind_GEO:
+		if !i.GetDocs() {
+			return status.Errorf(codes.InvalidArgument, "index %s: geo indexes only support docs", i.GetName())
+		}
+		if len(i.GetFields()) != 2 {
+			return status.Errorf(codes.InvalidArgument, "index %s: geo indexes require exactly two fields(latitude & longitude)", i.GetName())
+		}
+		for _, field := range i.GetFields() {
+			if !strings.HasPrefix(field, "attributes.") {
+				return status.Errorf(codes.InvalidArgument, "index %s: geo fields must be attributes ex: attributes.lat", i.GetName())
+			}
+		}
+	case apipb.IndexKind_VECTOR:
+		if !i.GetDocs() {
+			return status.Errorf(codes.InvalidArgument, "index %s: vector indexes only support docs", i.GetName())
+		}
+		if len(i.GetFields()) != 1 || !strings.HasPrefix(i.GetFields()[0], "attributes.") {
+			return status.Errorf(codes.InvalidArgument, "index %s: vector indexes require exactly one attribute field ex: attributes.embedding", i.GetName())
+		}
+		if i.GetDimensions() == 0 {
+			return status.Errorf(codes.InvalidArgument, "index %s: vector indexes require dimensions", i.GetName())
+		}
+		if _, ok := apipb.VectorMetric_name[int32(i.GetMetric())]; !ok {
+			return status.Errorf(codes.InvalidArgument, "index %s: unsupported vector metric %v", i.GetName(), i.GetMetric())
+		}
+	case apipb.IndexKind_VALUE:
+		if !i.GetDocs() {
+			return status.Errorf(codes.InvalidArgument, "index %s: value indexes only support docs", i.GetName())
+		}
+		if len(i.GetFields()) != 1 || !strings.HasPrefix(i.GetFields()[0], "attributes.") {
+			return status.Errorf(codes.InvalidArgument, "index %s: value indexes require exactly one attribute field ex: attributes.age", i.GetName())
+		}
+	default:
+		if i.GetExpression() == "" {
+			return status.Errorf(codes.InvalidArgument, "index %s: expression indexes require an expression", i.GetName())
+		}
+	}
+	return nil
 }
 
 func (g *Graph) SetAuthorizers(ctx context.Context, as *apipb.Authorizers) (*empty.Empty, error) {
@@ -264,7 +423,7 @@ func (g *Graph) SetAuthorizers(ctx context.Context, as *apipb.Authorizers) (*emp
 	}); err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	return &empty.Empty{}, g.cacheAuthorizers()
+	return &empty.Empty{}, g.cacheAuthorizers(ctx)
 }
 
 func (g *Graph) SetTypeValidators(ctx context.Context, as *apipb.TypeValidators) (*empty.Empty, error) {
@@ -272,6 +431,14 @@ func (g *Graph) SetTypeValidators(ctx context.Context, as *apipb.TypeValidators)
 	if user == nil {
 		return nil, status.Error(codes.Unauthenticated, "failed to get user")
 	}
+	for _, v := range as.GetValidators() {
+		if v.GetAcyclic() && !v.GetConnections() {
+			return nil, status.Errorf(codes.InvalidArgument, "validator %s: acyclic validators must be applied to connections", v.GetName())
+		}
+		if v.GetExpression() == "" && !v.GetAcyclic() {
+			return nil, status.Errorf(codes.InvalidArgument, "validator %s: an expression is required", v.GetName())
+		}
+	}
 	if err := g.db.Update(func(tx *bbolt.Tx) error {
 		for _, v := range as.GetValidators() {
 			_, err := g.setTypedValidator(ctx, tx, v)
@@ -283,7 +450,7 @@ func (g *Graph) SetTypeValidators(ctx context.Context, as *apipb.TypeValidators)
 	}); err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	return &empty.Empty{}, g.cacheTypeValidators()
+	return &empty.Empty{}, g.cacheTypeValidators(ctx)
 }
 
 func (g *Graph) Me(ctx context.Context, _ *empty.Empty) (*apipb.Doc, error) {
@@ -306,8 +473,20 @@ func (g *Graph) CreateDocs(ctx context.Context, constructors *apipb.DocConstruct
 	method := g.getMethod(ctx)
 	var docs = &apipb.Docs{}
 	if err := g.db.Update(func(tx *bbolt.Tx) error {
-		docBucket := tx.Bucket(dbDocs)
-		for _, constructor := range constructors.GetDocs() {
+		docBucket := g.bucket(ctx, tx, dbDocs)
+		for i, constructor := range constructors.GetDocs() {
+			idempotencyKey := g.idempotencyKey(ctx, "docs", constructor.GetIdempotencyKey(), i, len(constructors.GetDocs()))
+			if idempotencyKey != "" {
+				var original apipb.Doc
+				ok, err := g.getIdempotent(ctx, tx, idempotencyKey, &original)
+				if err != nil {
+					return err
+				}
+				if ok {
+					docs.Docs = append(docs.Docs, &original)
+					continue
+				}
+			}
 			bucket := docBucket.Bucket([]byte(constructor.GetRef().GetGtype()))
 			if bucket == nil {
 				bucket, err = docBucket.CreateBucketIfNotExists([]byte(constructor.GetRef().GetGtype()))
@@ -333,29 +512,11 @@ func (g *Graph) CreateDocs(ctx context.Context, constructors *apipb.DocConstruct
 			if err != nil {
 				return err
 			}
-			if doc.GetRef().GetGid() != user.GetRef().GetGid() && doc.GetRef().GetGtype() != user.GetRef().GetGtype() {
-				_, err := g.setConnection(ctx, tx, &apipb.Connection{
-					Ref: &apipb.Ref{Gtype: "created", Gid: ksuid.New().String()},
-					Attributes: apipb.NewStruct(map[string]interface{}{
-						"method": method,
-					}),
-					Directed: true,
-					From:     user.GetRef(),
-					To:       doc.GetRef(),
-				})
-				if err != nil {
-					return err
-				}
-				_, err = g.setConnection(ctx, tx, &apipb.Connection{
-					Ref: &apipb.Ref{Gtype: "created_by", Gid: ksuid.New().String()},
-					Attributes: apipb.NewStruct(map[string]interface{}{
-						"method": method,
-					}),
-					Directed: true,
-					To:       user.GetRef(),
-					From:     doc.GetRef(),
-				})
-				if err != nil {
+			if err := g.setCreatedConnections(ctx, tx, user, doc, method); err != nil {
+				return err
+			}
+			if idempotencyKey != "" {
+				if err := g.setIdempotent(ctx, tx, idempotencyKey, doc); err != nil {
 					return err
 				}
 			}
@@ -392,8 +553,20 @@ func (g *Graph) CreateConnections(ctx context.Context, constructors *apipb.Conne
 	}
 	var connections = &apipb.Connections{}
 	if err := g.db.Update(func(tx *bbolt.Tx) error {
-		connectionBucket := tx.Bucket(dbConnections)
-		for _, constructor := range constructors.GetConnections() {
+		connectionBucket := g.bucket(ctx, tx, dbConnections)
+		for i, constructor := range constructors.GetConnections() {
+			idempotencyKey := g.idempotencyKey(ctx, "connections", constructor.GetIdempotencyKey(), i, len(constructors.GetConnections()))
+			if idempotencyKey != "" {
+				var original apipb.Connection
+				ok, err := g.getIdempotent(ctx, tx, idempotencyKey, &original)
+				if err != nil {
+					return err
+				}
+				if ok {
+					connections.Connections = append(connections.Connections, &original)
+					continue
+				}
+			}
 			bucket := connectionBucket.Bucket([]byte(constructor.GetRef().GetGtype()))
 			if bucket == nil {
 				bucket, err = connectionBucket.CreateBucketIfNotExists([]byte(constructor.GetRef().GetGtype()))
@@ -422,6 +595,81 @@ func (g *Graph) CreateConnections(ctx context.Context, constructors *apipb.Conne
 			if err != nil {
 				return err
 			}
+			if idempotencyKey != "" {
+				if err := g.setIdempotent(ctx, tx, idempotencyKey, connection); err != nil {
+					return err
+				}
+			}
+			connections.Connections = append(connections.Connections, connection)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	connections.Sort("")
+	return connections, nil
+}
+
+func (g *Graph) UpsertConnection(ctx context.Context, upsert *apipb.ConnectionUpsert) (*apipb.Connection, error) {
+	connections, err := g.UpsertConnections(ctx, &apipb.ConnectionUpserts{Connections: []*apipb.ConnectionUpsert{upsert}})
+	if err != nil {
+		return nil, err
+	}
+	return connections.GetConnections()[0], nil
+}
+
+func (g *Graph) UpsertConnections(ctx context.Context, upserts *apipb.ConnectionUpserts) (*apipb.Connections, error) {
+	user := g.getIdentity(ctx)
+	if user == nil {
+		return nil, status.Error(codes.Unauthenticated, "failed to get user")
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var connections = &apipb.Connections{}
+	if err := g.db.Update(func(tx *bbolt.Tx) error {
+		for _, upsert := range upserts.GetConnections() {
+			connection, err := g.getConnection(ctx, tx, upsert.GetRef())
+			if err != nil && err != ErrNotFound {
+				return err
+			}
+			if connection == nil {
+				connection, err = g.setConnection(ctx, tx, &apipb.Connection{
+					Ref:        upsert.GetRef(),
+					Attributes: upsert.GetAttributes(),
+					Directed:   upsert.GetDirected(),
+					From:       upsert.GetFrom(),
+					To:         upsert.GetTo(),
+				})
+				if err != nil {
+					return err
+				}
+				connections.Connections = append(connections.Connections, connection)
+				continue
+			}
+			if upsert.GetExpression() != "" {
+				program, err := g.vm.Connection().Program(upsert.GetExpression())
+				if err != nil {
+					return status.Error(codes.InvalidArgument, err.Error())
+				}
+				pass, err := g.vm.Connection().Eval(connection, program)
+				if err != nil {
+					return status.Errorf(codes.FailedPrecondition, "connection %s/%s upsert expression failed: %s", connection.GetRef().GetGtype(), connection.GetRef().GetGid(), err)
+				}
+				if !pass {
+					return status.Errorf(codes.FailedPrecondition, "connection %s/%s failed upsert expression: %s", connection.GetRef().GetGtype(), connection.GetRef().GetGid(), upsert.GetExpression())
+				}
+			}
+			if connection.Attributes == nil {
+				connection.Attributes = apipb.NewStruct(map[string]interface{}{})
+			}
+			for k, v := range upsert.GetAttributes().GetFields() {
+				connection.Attributes.GetFields()[k] = v
+			}
+			connection, err = g.setConnection(ctx, tx, connection)
+			if err != nil {
+				return err
+			}
 			connections.Connections = append(connections.Connections, connection)
 		}
 		return nil
@@ -440,7 +688,7 @@ func (g *Graph) Broadcast(ctx context.Context, message *apipb.OutboundMessage) (
 	if message.GetChannel() == changeChannel {
 		return nil, status.Error(codes.PermissionDenied, "forbidden from publishing to the changes channel")
 	}
-	return &empty.Empty{}, g.machine.PubSub().Publish(message.Channel, &apipb.Message{
+	return &empty.Empty{}, g.machine.PubSub().Publish(g.namespacedKey(ctx, message.Channel), &apipb.Message{
 		Channel:   message.Channel,
 		Data:      message.Data,
 		User:      user.GetRef(),
@@ -481,7 +729,7 @@ func (g *Graph) Stream(filter *apipb.StreamFilter, server apipb.DatabaseService_
 			return result
 		}
 	}
-	if err := g.machine.PubSub().SubscribeFilter(server.Context(), filter.Channel, filterFunc, func(msg interface{}) {
+	if err := g.machine.PubSub().SubscribeFilter(server.Context(), g.namespacedKey(server.Context(), filter.Channel), filterFunc, func(msg interface{}) {
 		if err, ok := msg.(error); ok && err != nil {
 			logger.Error("failed to send subscription", zap.Error(err))
 			return
@@ -587,6 +835,80 @@ func (g *Graph) CreateDoc(ctx context.Context, constructor *apipb.DocConstructor
 	return docs.GetDocs()[0], nil
 }
 
+func (g *Graph) UpsertDoc(ctx context.Context, upsert *apipb.DocUpsert) (*apipb.Doc, error) {
+	docs, err := g.UpsertDocs(ctx, &apipb.DocUpserts{Docs: []*apipb.DocUpsert{upsert}})
+	if err != nil {
+		return nil, err
+	}
+	return docs.GetDocs()[0], nil
+}
+
+func (g *Graph) UpsertDocs(ctx context.Context, upserts *apipb.DocUpserts) (*apipb.Docs, error) {
+	user := g.getIdentity(ctx)
+	if user == nil {
+		return nil, status.Error(codes.Unauthenticated, "failed to get user")
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	method := g.getMethod(ctx)
+	var docs = &apipb.Docs{}
+	if err := g.db.Update(func(tx *bbolt.Tx) error {
+		for _, upsert := range upserts.GetDocs() {
+			doc, err := g.getDoc(ctx, tx, upsert.GetRef())
+			if err != nil && err != ErrNotFound {
+				return err
+			}
+			if doc == nil {
+				doc, err = g.setDoc(ctx, tx, &apipb.Doc{
+					Ref:        upsert.GetRef(),
+					Attributes: upsert.GetAttributes(),
+				})
+				if err != nil {
+					return err
+				}
+				if err := g.setCreatedConnections(ctx, tx, user, doc, method); err != nil {
+					return err
+				}
+				docs.Docs = append(docs.Docs, doc)
+				continue
+			}
+			if upsert.GetExpression() != "" {
+				program, err := g.vm.Doc().Program(upsert.GetExpression())
+				if err != nil {
+					return status.Error(codes.InvalidArgument, err.Error())
+				}
+				pass, err := g.vm.Doc().Eval(doc, program)
+				if err != nil {
+					return status.Errorf(codes.FailedPrecondition, "doc %s/%s upsert expression failed: %s", doc.GetRef().GetGtype(), doc.GetRef().GetGid(), err)
+				}
+				if !pass {
+					return status.Errorf(codes.FailedPrecondition, "doc %s/%s failed upsert expression: %s", doc.GetRef().GetGtype(), doc.GetRef().GetGid(), upsert.GetExpression())
+				}
+			}
+			if doc.Attributes == nil {
+				doc.Attributes = apipb.NewStruct(map[string]interface{}{})
+			}
+			for k, v := range upsert.GetAttributes().GetFields() {
+				doc.Attributes.GetFields()[k] = v
+			}
+			doc, err = g.setDoc(ctx, tx, doc)
+			if err != nil {
+				return err
+			}
+			if err := g.setEditedConnections(ctx, tx, user, doc); err != nil {
+				return err
+			}
+			docs.Docs = append(docs.Docs, doc)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	docs.Sort("")
+	return docs, nil
+}
+
 func (n *Graph) EditDoc(ctx context.Context, value *apipb.Edit) (*apipb.Doc, error) {
 	user := n.getIdentity(ctx)
 	var doc *apipb.Doc
@@ -603,37 +925,7 @@ func (n *Graph) EditDoc(ctx context.Context, value *apipb.Edit) (*apipb.Doc, err
 		if err != nil {
 			return err
 		}
-		if doc.GetRef().GetGid() != user.GetRef().GetGid() && doc.GetRef().GetGtype() != user.GetRef().GetGtype() {
-			id := helpers.Hash([]byte(fmt.Sprintf("%s-%s", user.GetRef().String(), doc.GetRef().String())))
-			editedRef := &apipb.Ref{Gid: id, Gtype: "edited"}
-			if !n.hasConnectionFrom(user.GetRef(), editedRef) {
-				_, err := n.setConnection(ctx, tx, &apipb.Connection{
-					Ref:        editedRef,
-					Attributes: apipb.NewStruct(map[string]interface{}{}),
-					Directed:   true,
-					From:       user.GetRef(),
-					To:         doc.GetRef(),
-				})
-				if err != nil {
-					return err
-				}
-			}
-			editedByRef := &apipb.Ref{Gtype: "edited_by", Gid: id}
-			if !n.hasConnectionFrom(doc.GetRef(), editedByRef) {
-				_, err := n.setConnection(ctx, tx, &apipb.Connection{
-					Ref:        editedByRef,
-					Attributes: apipb.NewStruct(map[string]interface{}{}),
-					Directed:   true,
-					To:         user.GetRef(),
-					From:       doc.GetRef(),
-				})
-				if err != nil {
-					return err
-				}
-			}
-		}
-
-		return nil
+		return n.setEditedConnections(ctx, tx, user, doc)
 	}); err != nil {
 		return nil, err
 	}
@@ -641,24 +933,39 @@ func (n *Graph) EditDoc(ctx context.Context, value *apipb.Edit) (*apipb.Doc, err
 	return doc, err
 }
 
-func (n *Graph) EditDocs(ctx context.Context, patch *apipb.EditFilter) (*apipb.Docs, error) {
+// EditDocs patches the attributes of the docs that pass the filter & returns the docs as they are(or would be if dry_run)
+// after the edit
+func (n *Graph) EditDocs(ctx context.Context, patch *apipb.EditFilter) (*apipb.MutationResult, error) {
 	var docs []*apipb.Doc
-	before, err := n.SearchDocs(ctx, patch.GetFilter())
+	before, err := n.SearchDocs(ctx, mutationSearch(patch.GetFilter()))
 	if err != nil {
 		return nil, err
 	}
 	for _, doc := range before.GetDocs() {
+		if doc.Attributes == nil {
+			doc.Attributes = apipb.NewStruct(map[string]interface{}{})
+		}
 		for k, v := range patch.GetAttributes().GetFields() {
 			doc.Attributes.GetFields()[k] = v
 		}
 		docs = append(docs, doc)
 	}
-
-	docss, err := n.setDocs(ctx, docs...)
-	if err != nil {
-		return nil, err
+	edited := &apipb.Docs{Docs: docs}
+	if !patch.GetFilter().GetDryRun() {
+		edited, err = n.setDocs(ctx, docs...)
+		if err != nil {
+			return nil, err
+		}
 	}
-	return docss, nil
+	result := &apipb.MutationResult{
+		DryRun: patch.GetFilter().GetDryRun(),
+		Docs:   edited,
+	}
+	for _, doc := range edited.GetDocs() {
+		result.Refs = append(result.Refs, doc.GetRef())
+	}
+	result.Affected = uint64(len(result.GetRefs()))
+	return result, nil
 }
 
 func (g *Graph) ConnectionTypes(ctx context.Context) ([]string, error) {
@@ -667,7 +974,7 @@ func (g *Graph) ConnectionTypes(ctx context.Context) ([]string, error) {
 	}
 	var types []string
 	if err := g.db.View(func(tx *bbolt.Tx) error {
-		return tx.Bucket(dbConnections).ForEach(func(name []byte, _ []byte) error {
+		return g.bucket(ctx, tx, dbConnections).ForEach(func(name []byte, _ []byte) error {
 			types = append(types, string(name))
 			return nil
 		})
@@ -684,7 +991,7 @@ func (g *Graph) DocTypes(ctx context.Context) ([]string, error) {
 	}
 	var types []string
 	if err := g.db.View(func(tx *bbolt.Tx) error {
-		return tx.Bucket(dbDocs).ForEach(func(name []byte, _ []byte) error {
+		return g.bucket(ctx, tx, dbDocs).ForEach(func(name []byte, _ []byte) error {
 			types = append(types, string(name))
 			return nil
 		})
@@ -709,7 +1016,7 @@ func (g *Graph) ConnectionsFrom(ctx context.Context, filter *apipb.ConnectFilter
 	var connections []*apipb.Connection
 	var pass bool
 	if err := g.db.View(func(tx *bbolt.Tx) error {
-		if err = g.rangeFrom(ctx, tx, filter.GetDocRef(), func(connection *apipb.Connection) bool {
+		if err = g.rangeDirection(ctx, tx, filter.GetDocRef(), connectDirection(filter, apipb.Direction_OUT), func(connection *apipb.Connection) bool {
 			if filter.Gtype != "*" {
 				if connection.GetRef().GetGtype() != filter.Gtype {
 					return true
@@ -743,77 +1050,332 @@ func (g *Graph) ConnectionsFrom(ctx context.Context, filter *apipb.ConnectFilter
 }
 
 func (n *Graph) SearchDocs(ctx context.Context, filter *apipb.Filter) (*apipb.Docs, error) {
+	if err := rejectDryRun(filter); err != nil {
+		return nil, err
+	}
+	if direction, gtype, ok := degreeSort(filter.GetSort()); ok {
+		return n.searchDocsByDegree(ctx, filter, direction, gtype)
+	}
 	var docs []*apipb.Doc
+	ctx, finish := n.startQuery(ctx, filter, filter.GetBudget(), false)
+	seek, err := n.searchDocs(ctx, filter, func(doc *apipb.Doc) error {
+		docs = append(docs, doc)
+		return nil
+	})
+	if err := finish(len(docs), seek, err); err != nil {
+		return nil, err
+	}
+	toReturn := &apipb.Docs{
+		Docs:     docs,
+		SeekNext: seek,
+	}
+	stopSort := getQueryStats(ctx).phase("sort")
+	toReturn.Sort(filter.GetSort())
+	stopSort()
+	return toReturn, nil
+}
+
+// streamPageSize is the number of docs/connections read per read transaction when streaming
+const streamPageSize = 100
+
+// StreamDocs sends docs that pass the filter to the client a page at a time. Each page is read in it's own read
+// transaction & sent once the transaction has finished, so a slow client(flow control) never holds a transaction open.
+// The search halts once the client cancels the stream.
+func (n *Graph) StreamDocs(filter *apipb.Filter, server apipb.DatabaseService_StreamDocsServer) error {
+	if filter.GetSort() != "" {
+		return status.Error(codes.InvalidArgument, "sort is not supported when streaming")
+	}
+	if err := rejectDryRun(filter); err != nil {
+		return err
+	}
+	var (
+		count int
+		seek  = filter.GetSeek()
+		err   error
+	)
+	ctx, finish := n.startQuery(server.Context(), filter, filter.GetBudget(), true)
+	for resume := false; count < int(filter.GetLimit()); resume = true {
+		var (
+			page = streamPage(filter, seek, count, resume)
+			docs []*apipb.Doc
+			read int
+		)
+		seek, err = n.searchDocs(ctx, page, func(doc *apipb.Doc) error {
+			read++
+			if resume && read == 1 && doc.GetRef().GetGid() == page.GetSeek() {
+				return nil
+			}
+			docs = append(docs, doc)
+			return nil
+		})
+		for _, doc := range docs {
+			if err := server.Send(doc); err != nil {
+				return queryError(server.Context(), finish(count, seek, err))
+			}
+			count++
+		}
+		if err != nil || read < int(page.GetLimit()) {
+			break
+		}
+	}
+	return queryError(server.Context(), finish(count, seek, err))
+}
+
+// streamPage returns the filter of the next page of a stream that has sent count results. Pages after the first resume
+// from the last key read by the previous page, so they read one extra key.
+func streamPage(filter *apipb.Filter, seek string, count int, resume bool) *apipb.Filter {
+	page := proto.Clone(filter).(*apipb.Filter)
+	page.Seek = seek
+	page.Limit = filter.GetLimit() - uint64(count)
+	if page.Limit > streamPageSize {
+		page.Limit = streamPageSize
+	}
+	if resume {
+		page.Limit++
+	}
+	return page
+}
+
+// streamError converts the error that halted a stream to a status - client cancellations & send errors keep their code
+func queryError(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// searchDocs executes fn against each doc that passes the filter until the filter's limit is reached
+func (n *Graph) searchDocs(ctx context.Context, filter *apipb.Filter, fn func(doc *apipb.Doc) error) (string, error) {
 	var program cel.Program
 	var err error
 	if filter.Expression != "" {
 		program, err = n.vm.Doc().Program(filter.Expression)
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 	}
-	seek, err := n.rangeSeekDocs(ctx, filter.Gtype, filter.GetSeek(), filter.GetIndex(), filter.GetReverse(), func(doc *apipb.Doc) bool {
+	var (
+		count int
+		fnErr error
+		stats = getQueryStats(ctx)
+	)
+	seek, err := n.rangeSeekDocs(ctx, filter.Gtype, filter.GetExpression(), filter.GetSeek(), filter.GetIndex(), filter.GetReverse(), func(doc *apipb.Doc) bool {
 		if program != nil {
-			pass, err := n.vm.Doc().Eval(doc, program)
+			pass, err := n.evalDoc(stats, doc, program)
 			if err != nil {
 				if !strings.Contains(err.Error(), "no such key") {
 					logger.Error("search docs failure", zap.Error(err))
 				}
+				stats.swallowed(err)
 				return true
 			}
-			if pass {
-				docs = append(docs, doc)
+			if !pass {
+				return true
 			}
-		} else {
-			docs = append(docs, doc)
 		}
-		return len(docs) < int(filter.Limit)
+		if fnErr = fn(doc); fnErr != nil {
+			return false
+		}
+		count++
+		return count < int(filter.Limit)
 	})
 	if err != nil {
 		if err == ErrNotFound {
-			return nil, status.Error(codes.NotFound, err.Error())
+			return "", status.Error(codes.NotFound, err.Error())
 		}
-		return nil, err
+		return seek, err
 	}
-	toReturn := &apipb.Docs{
-		Docs:     docs,
-		SeekNext: seek,
-	}
-	toReturn.Sort(filter.GetSort())
-	return toReturn, nil
+	return seek, fnErr
 }
 
 func (n *Graph) AggregateDocs(ctx context.Context, filter *apipb.AggFilter) (*apipb.Number, error) {
-	docs, err := n.SearchDocs(ctx, filter.GetFilter())
+	groupFilter, err := toGroupAggFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := n.aggregateDocGroups(ctx, groupFilter, filter.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	return &apipb.Number{Value: docs.Aggregate(filter.GetAggregate(), filter.GetField())}, nil
+	return groupsToNumber(groups), nil
 }
 
 func (n *Graph) AggregateConnections(ctx context.Context, filter *apipb.AggFilter) (*apipb.Number, error) {
-	connections, err := n.SearchConnections(ctx, filter.GetFilter())
+	groupFilter, err := toGroupAggFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := n.aggregateConnectionGroups(ctx, groupFilter, filter.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	return &apipb.Number{Value: connections.Aggregate(filter.GetAggregate(), filter.GetField())}, nil
+	return groupsToNumber(groups), nil
+}
+
+// toGroupAggFilter converts an AggFilter to a GroupAggFilter with a single group
+func toGroupAggFilter(filter *apipb.AggFilter) (*apipb.GroupAggFilter, error) {
+	if filter.GetAggregate() == apipb.Aggregate_HISTOGRAM {
+		return nil, status.Error(codes.InvalidArgument, "HISTOGRAM is only supported by grouped aggregations")
+	}
+	return &apipb.GroupAggFilter{
+		Filter: filter.GetFilter(),
+		Aggregates: []*apipb.AggField{{
+			Aggregate:   filter.GetAggregate(),
+			Field:       filter.GetField(),
+			Percentile:  filter.GetPercentile(),
+			Approximate: filter.GetApproximate(),
+		}},
+	}, nil
+}
+
+func groupsToNumber(groups *apipb.AggGroups) *apipb.Number {
+	if len(groups.GetGroups()) == 0 || len(groups.GetGroups()[0].GetValues()) == 0 {
+		return &apipb.Number{}
+	}
+	return &apipb.Number{Value: groups.GetGroups()[0].GetValues()[0].GetValue()}
+}
+
+// AggregateDocGroups groups every doc that passes the filter by one or more paths & executes the aggregates against each group
+// as docs are read from the db
+func (n *Graph) AggregateDocGroups(ctx context.Context, filter *apipb.GroupAggFilter) (*apipb.AggGroups, error) {
+	return n.aggregateDocGroups(ctx, filter, aggregationSearch(filter.GetFilter()))
+}
+
+// aggregateDocGroups executes the grouped aggregation against the docs returned by the search
+func (n *Graph) aggregateDocGroups(ctx context.Context, filter *apipb.GroupAggFilter, search *apipb.Filter) (*apipb.AggGroups, error) {
+	if err := validateGroupAggFilter(filter); err != nil {
+		return nil, err
+	}
+	if err := rejectDryRun(filter.GetFilter()); err != nil {
+		return nil, err
+	}
+	aggregator := aggregate.NewGroupAggregator(filter)
+	ctx, finish := n.startQuery(ctx, filter, filter.GetFilter().GetBudget(), false)
+	seek, err := n.searchDocs(ctx, search, func(doc *apipb.Doc) error {
+		aggregator.Add(func(path string) *structpb.Value {
+			return aggregate.DocPath(doc, path)
+		})
+		return nil
+	})
+	groups := aggregator.Groups()
+	if err := finish(len(groups.GetGroups()), seek, err); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// AggregateConnectionGroups groups every connection that passes the filter by one or more paths & executes the aggregates against each group
+// as connections are read from the db
+func (n *Graph) AggregateConnectionGroups(ctx context.Context, filter *apipb.GroupAggFilter) (*apipb.AggGroups, error) {
+	return n.aggregateConnectionGroups(ctx, filter, aggregationSearch(filter.GetFilter()))
+}
+
+// aggregateConnectionGroups executes the grouped aggregation against the connections returned by the search
+func (n *Graph) aggregateConnectionGroups(ctx context.Context, filter *apipb.GroupAggFilter, search *apipb.Filter) (*apipb.AggGroups, error) {
+	if err := validateGroupAggFilter(filter); err != nil {
+		return nil, err
+	}
+	if err := rejectDryRun(filter.GetFilter()); err != nil {
+		return nil, err
+	}
+	aggregator := aggregate.NewGroupAggregator(filter)
+	ctx, finish := n.startQuery(ctx, filter, filter.GetFilter().GetBudget(), false)
+	seek, err := n.searchConnections(ctx, search, func(connection *apipb.Connection) error {
+		aggregator.Add(func(path string) *structpb.Value {
+			return aggregate.ConnectionPath(connection, path)
+		})
+		return nil
+	})
+	groups := aggregator.Groups()
+	if err := finish(len(groups.GetGroups()), seek, err); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// aggregationSearch returns a copy of a grouped aggregation's filter without a limit - every doc/connection that passes the filter
+// is aggregated, so the work done is bounded by the filter's budget rather than it's limit
+func aggregationSearch(filter *apipb.Filter) *apipb.Filter {
+	search := proto.Clone(filter).(*apipb.Filter)
+	search.Limit = math.MaxInt32
+	return search
+}
+
+func validateGroupAggFilter(filter *apipb.GroupAggFilter) error {
+	for _, path := range filter.GetGroupBy() {
+		if path == "" {
+			return status.Error(codes.InvalidArgument, "empty group_by path")
+		}
+	}
+	for _, agg := range filter.GetAggregates() {
+		if agg.GetAggregate() != apipb.Aggregate_COUNT && agg.GetField() == "" {
+			return status.Errorf(codes.InvalidArgument, "%s requires a field", agg.GetAggregate().String())
+		}
+		if agg.GetAggregate() == apipb.Aggregate_PERCENTILE && agg.GetPercentile() <= 0 {
+			return status.Error(codes.InvalidArgument, "PERCENTILE requires a percentile between 0 & 100 ex: 99")
+		}
+		if agg.GetAggregate() == apipb.Aggregate_HISTOGRAM && agg.GetBucketSize() <= 0 {
+			return status.Error(codes.InvalidArgument, "HISTOGRAM requires a bucket_size > 0")
+		}
+	}
+	return nil
 }
 
 func (n *Graph) Traverse(ctx context.Context, filter *apipb.TraverseFilter) (*apipb.Traversals, error) {
-	dfs, err := n.newTraversal(filter)
+	traversals := &apipb.Traversals{}
+	dfs, err := n.newTraversal(filter, func(t *apipb.Traversal) error {
+		traversals.Traversals = append(traversals.Traversals, t)
+		return nil
+	})
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	if err := n.db.View(func(tx *bbolt.Tx) error {
+	ctx, finish := n.startQuery(ctx, filter, filter.GetBudget(), false)
+	err = n.db.View(func(tx *bbolt.Tx) error {
 		return dfs.Walk(ctx, tx)
-	}); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+	})
+	if err := finish(len(traversals.GetTraversals()), "", err); err != nil {
+		return nil, queryError(ctx, err)
 	}
 
-	return dfs.traversals, nil
+	return traversals, nil
+}
+
+// StreamTraverse sends docs to the client as they're found by the traversal. A traversal can't be resumed from another
+// transaction, so the traversals(bounded by the limit) are buffered & sent once the read transaction has finished - a
+// slow client(flow control) never holds the transaction open. Sending halts once the client cancels the stream.
+func (n *Graph) StreamTraverse(filter *apipb.TraverseFilter, server apipb.DatabaseService_StreamTraverseServer) error {
+	var traversals []*apipb.Traversal
+	dfs, err := n.newTraversal(filter, func(t *apipb.Traversal) error {
+		traversals = append(traversals, t)
+		return nil
+	})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx, finish := n.startQuery(server.Context(), filter, filter.GetBudget(), true)
+	err = n.db.View(func(tx *bbolt.Tx) error {
+		return dfs.Walk(ctx, tx)
+	})
+	// the traversals found before the budget was exceeded are sent as partial results
+	var count int
+	for _, t := range traversals {
+		if err := server.Send(t); err != nil {
+			return queryError(server.Context(), finish(count, "", err))
+		}
+		count++
+	}
+	return queryError(server.Context(), finish(count, "", err))
 }
 
 func (n *Graph) TraverseMe(ctx context.Context, filter *apipb.TraverseMeFilter) (*apipb.Traversals, error) {
+	traversals := &apipb.Traversals{}
 	dfs, err := n.newTraversal(&apipb.TraverseFilter{
 		Root:                 n.getIdentity(ctx).GetRef(),
 		DocExpression:        filter.GetDocExpression(),
@@ -821,20 +1383,35 @@ func (n *Graph) TraverseMe(ctx context.Context, filter *apipb.TraverseMeFilter)
 		Limit:                filter.GetLimit(),
 		Sort:                 filter.GetSort(),
 		Reverse:              filter.GetReverse(),
+		Direction:            filter.GetDirection(),
 		Algorithm:            filter.GetAlgorithm(),
 		MaxDepth:             filter.GetMaxDepth(),
 		MaxHops:              filter.GetMaxHops(),
+		Budget:               filter.GetBudget(),
+	}, func(t *apipb.Traversal) error {
+		traversals.Traversals = append(traversals.Traversals, t)
+		return nil
 	})
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	if err := n.db.View(func(tx *bbolt.Tx) error {
+	ctx, finish := n.startQuery(ctx, filter, filter.GetBudget(), false)
+	err = n.db.View(func(tx *bbolt.Tx) error {
 		return dfs.Walk(ctx, tx)
-	}); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+	})
+	if err := finish(len(traversals.GetTraversals()), "", err); err != nil {
+		return nil, queryError(ctx, err)
 	}
 
-	return dfs.traversals, nil
+	return traversals, nil
+}
+
+// connectDirection returns the direction of a ConnectionsFrom/ConnectionsTo request - BOTH overrides the method's direction
+func connectDirection(filter *apipb.ConnectFilter, direction apipb.Direction) apipb.Direction {
+	if filter.GetDirection() == apipb.Direction_BOTH {
+		return apipb.Direction_BOTH
+	}
+	return direction
 }
 
 func (g *Graph) ConnectionsTo(ctx context.Context, filter *apipb.ConnectFilter) (*apipb.Connections, error) {
@@ -851,7 +1428,7 @@ func (g *Graph) ConnectionsTo(ctx context.Context, filter *apipb.ConnectFilter)
 	var connections []*apipb.Connection
 	var pass bool
 	if err := g.db.View(func(tx *bbolt.Tx) error {
-		if err = g.rangeTo(ctx, tx, filter.GetDocRef(), func(connection *apipb.Connection) bool {
+		if err = g.rangeDirection(ctx, tx, filter.GetDocRef(), connectDirection(filter, apipb.Direction_IN), func(connection *apipb.Connection) bool {
 			if filter.Gtype != "*" {
 				if connection.GetRef().GetGtype() != filter.Gtype {
 					return true
@@ -903,15 +1480,13 @@ func (n *Graph) EditConnection(ctx context.Context, value *apipb.Edit) (*apipb.C
 	var connection *apipb.Connection
 	var err error
 	if err = n.db.Update(func(tx *bbolt.Tx) error {
-		bucket := tx.Bucket(dbConnections).Bucket([]byte(value.GetRef().Gtype))
-		if bucket == nil {
-			return ErrNotFound
-		}
-		var e apipb.Connection
-		bits := bucket.Get([]byte(value.GetRef().Gid))
-		if err := proto.Unmarshal(bits, &e); err != nil {
+		connection, err = n.getConnection(ctx, tx, value.GetRef())
+		if err != nil {
 			return err
 		}
+		if connection.Attributes == nil {
+			connection.Attributes = apipb.NewStruct(map[string]interface{}{})
+		}
 		for k, v := range value.GetAttributes().GetFields() {
 			connection.Attributes.GetFields()[k] = v
 		}
@@ -926,31 +1501,115 @@ func (n *Graph) EditConnection(ctx context.Context, value *apipb.Edit) (*apipb.C
 	return connection, nil
 }
 
-func (n *Graph) EditConnections(ctx context.Context, patch *apipb.EditFilter) (*apipb.Connections, error) {
-	var connections = &apipb.Connections{}
-	before, err := n.SearchConnections(ctx, patch.GetFilter())
+// EditConnections patches the attributes of the connections that pass the filter & returns the connections as they are(or
+// would be if dry_run) after the edit
+func (n *Graph) EditConnections(ctx context.Context, patch *apipb.EditFilter) (*apipb.MutationResult, error) {
+	before, err := n.SearchConnections(ctx, mutationSearch(patch.GetFilter()))
 	if err != nil {
 		return nil, err
 	}
-	if err := n.db.Update(func(tx *bbolt.Tx) error {
-		for _, connection := range before.GetConnections() {
-			for k, v := range patch.GetAttributes().GetFields() {
-				connection.Attributes.GetFields()[k] = v
-			}
-			connection, err := n.setConnection(ctx, tx, connection)
-			if err != nil {
-				return err
+	for _, connection := range before.GetConnections() {
+		if connection.Attributes == nil {
+			connection.Attributes = apipb.NewStruct(map[string]interface{}{})
+		}
+		for k, v := range patch.GetAttributes().GetFields() {
+			connection.Attributes.GetFields()[k] = v
+		}
+	}
+	edited := &apipb.Connections{Connections: before.GetConnections()}
+	if !patch.GetFilter().GetDryRun() {
+		edited = &apipb.Connections{}
+		if err := n.db.Update(func(tx *bbolt.Tx) error {
+			for _, connection := range before.GetConnections() {
+				connection, err := n.setConnection(ctx, tx, connection)
+				if err != nil {
+					return err
+				}
+				edited.Connections = append(edited.Connections, connection)
 			}
-			connections.Connections = append(connections.Connections, connection)
+			return nil
+		}); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
-		return nil
-	}); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	return connections, nil
+	result := &apipb.MutationResult{
+		DryRun:      patch.GetFilter().GetDryRun(),
+		Connections: edited,
+	}
+	for _, connection := range edited.GetConnections() {
+		result.Refs = append(result.Refs, connection.GetRef())
+	}
+	result.Affected = uint64(len(result.GetRefs()))
+	return result, nil
 }
 
 func (e *Graph) SearchConnections(ctx context.Context, filter *apipb.Filter) (*apipb.Connections, error) {
+	if err := rejectDryRun(filter); err != nil {
+		return nil, err
+	}
+	var connections []*apipb.Connection
+	ctx, finish := e.startQuery(ctx, filter, filter.GetBudget(), false)
+	seek, err := e.searchConnections(ctx, filter, func(connection *apipb.Connection) error {
+		connections = append(connections, connection)
+		return nil
+	})
+	if err := finish(len(connections), seek, err); err != nil {
+		return nil, err
+	}
+	toReturn := &apipb.Connections{
+		Connections: connections,
+		SeekNext:    seek,
+	}
+	stopSort := getQueryStats(ctx).phase("sort")
+	toReturn.Sort(filter.GetSort())
+	stopSort()
+	return toReturn, nil
+}
+
+// StreamConnections sends connections that pass the filter to the client a page at a time(see StreamDocs). The search
+// halts once the client cancels the stream.
+func (e *Graph) StreamConnections(filter *apipb.Filter, server apipb.DatabaseService_StreamConnectionsServer) error {
+	if filter.GetSort() != "" {
+		return status.Error(codes.InvalidArgument, "sort is not supported when streaming")
+	}
+	if err := rejectDryRun(filter); err != nil {
+		return err
+	}
+	var (
+		count int
+		seek  = filter.GetSeek()
+		err   error
+	)
+	ctx, finish := e.startQuery(server.Context(), filter, filter.GetBudget(), true)
+	for resume := false; count < int(filter.GetLimit()); resume = true {
+		var (
+			page        = streamPage(filter, seek, count, resume)
+			connections []*apipb.Connection
+			read        int
+		)
+		seek, err = e.searchConnections(ctx, page, func(connection *apipb.Connection) error {
+			read++
+			if resume && read == 1 && connection.GetRef().GetGid() == page.GetSeek() {
+				return nil
+			}
+			connections = append(connections, connection)
+			return nil
+		})
+		for _, connection := range connections {
+			if err := server.Send(connection); err != nil {
+				return queryError(server.Context(), finish(count, seek, err))
+			}
+			count++
+		}
+		if err != nil || read < int(page.GetLimit()) {
+			break
+		}
+	}
+	return queryError(server.Context(), finish(count, seek, err))
+}
+
+// searchConnections executes fn against each connection that passes the filter until the filter's limit is reached
+func (e *Graph) searchConnections(ctx context.Context, filter *apipb.Filter, fn func(connection *apipb.Connection) error) (string, error) {
 	var (
 		program cel.Program
 		err     error
@@ -958,36 +1617,38 @@ func (e *Graph) SearchConnections(ctx context.Context, filter *apipb.Filter) (*a
 	if filter.Expression != "" {
 		program, err = e.vm.Connection().Program(filter.Expression)
 		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, err.Error())
+			return "", status.Error(codes.InvalidArgument, err.Error())
 		}
 	}
-	var connections []*apipb.Connection
-	seek, err := e.rangeSeekConnections(ctx, filter.Gtype, filter.GetSeek(), filter.GetIndex(), filter.GetReverse(), func(connection *apipb.Connection) bool {
+	var (
+		count int
+		fnErr error
+		stats = getQueryStats(ctx)
+	)
+	seek, err := e.rangeSeekConnections(ctx, filter.Gtype, filter.GetExpression(), filter.GetSeek(), filter.GetIndex(), filter.GetReverse(), func(connection *apipb.Connection) bool {
 		if program != nil {
-			pass, err := e.vm.Connection().Eval(connection, program)
-			if err != nil {
+			pass, err := e.evalConnection(stats, connection, program)
+			stats.swallowed(err)
+			if err != nil || !pass {
 				return true
 			}
-			if pass {
-				connections = append(connections, connection)
-			}
-		} else {
-			connections = append(connections, connection)
 		}
-		return len(connections) < int(filter.Limit)
+		if fnErr = fn(connection); fnErr != nil {
+			return false
+		}
+		count++
+		return count < int(filter.Limit)
 	})
 	if err != nil {
 		if err == ErrNotFound {
-			return nil, status.Error(codes.NotFound, err.Error())
+			return "", status.Error(codes.NotFound, err.Error())
 		}
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-	toReturn := &apipb.Connections{
-		Connections: connections,
-		SeekNext:    seek,
+		if _, ok := err.(*budgetError); ok {
+			return seek, err
+		}
+		return "", status.Error(codes.InvalidArgument, err.Error())
 	}
-	toReturn.Sort(filter.GetSort())
-	return toReturn, nil
+	return seek, fnErr
 }
 
 func (g *Graph) DelDoc(ctx context.Context, path *apipb.Ref) (*empty.Empty, error) {
@@ -1009,16 +1670,52 @@ func (g *Graph) DelDoc(ctx context.Context, path *apipb.Ref) (*empty.Empty, erro
 	return &empty.Empty{}, nil
 }
 
-func (g *Graph) DelDocs(ctx context.Context, filter *apipb.Filter) (*empty.Empty, error) {
-	before, err := g.SearchDocs(ctx, filter)
+// rejectDryRun returns an error if a dry run was requested from a method that doesn't mutate the graph - a dry run is only
+// meaningful to bulk mutations, so it's an error rather than being silently ignored
+func rejectDryRun(filter *apipb.Filter) error {
+	if filter.GetDryRun() {
+		return status.Error(codes.InvalidArgument, "dry_run is only supported by bulk mutations(DelDocs, DelConnections, EditDocs, EditConnections)")
+	}
+	return nil
+}
+
+// mutationSearch returns a copy of a bulk mutation's filter that's used to search for the docs/connections it mutates
+func mutationSearch(filter *apipb.Filter) *apipb.Filter {
+	search := proto.Clone(filter).(*apipb.Filter)
+	search.DryRun = false
+	return search
+}
+
+func (g *Graph) DelDocs(ctx context.Context, filter *apipb.Filter) (*apipb.MutationResult, error) {
+	before, err := g.SearchDocs(ctx, mutationSearch(filter))
 	if err != nil {
 		return nil, err
 	}
 	if len(before.GetDocs()) == 0 {
-		return nil, ErrNotFound
+		return nil, status.Error(codes.NotFound, ErrNotFound.Error())
 	}
-	if err := g.db.Update(func(tx *bbolt.Tx) error {
+	result := &apipb.MutationResult{
+		DryRun: filter.GetDryRun(),
+	}
+	// a dry run only reads, so it's executed in a read-only transaction that can't be committed
+	txFunc := g.db.Update
+	if filter.GetDryRun() {
+		txFunc = g.db.View
+	}
+	if err := txFunc(func(tx *bbolt.Tx) error {
+		cascaded := map[string]struct{}{}
 		for _, doc := range before.GetDocs() {
+			for _, ref := range g.connectionRefs(ctx, tx, doc.GetRef()) {
+				if _, ok := cascaded[refString(ref)]; ok {
+					continue
+				}
+				cascaded[refString(ref)] = struct{}{}
+				result.Cascaded = append(result.Cascaded, ref)
+			}
+			result.Refs = append(result.Refs, doc.GetRef())
+			if filter.GetDryRun() {
+				continue
+			}
 			if err := g.delDoc(ctx, tx, doc.GetRef()); err != nil {
 				return err
 			}
@@ -1030,7 +1727,8 @@ func (g *Graph) DelDocs(ctx context.Context, filter *apipb.Filter) (*empty.Empty
 		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	return &empty.Empty{}, nil
+	result.Affected = uint64(len(result.GetRefs()))
+	return result, nil
 }
 
 func (g *Graph) DelConnection(ctx context.Context, path *apipb.Ref) (*empty.Empty, error) {
@@ -1048,17 +1746,27 @@ func (g *Graph) DelConnection(ctx context.Context, path *apipb.Ref) (*empty.Empt
 	return &empty.Empty{}, nil
 }
 
-func (g *Graph) DelConnections(ctx context.Context, filter *apipb.Filter) (*empty.Empty, error) {
-	before, err := g.SearchConnections(ctx, filter)
+func (g *Graph) DelConnections(ctx context.Context, filter *apipb.Filter) (*apipb.MutationResult, error) {
+	before, err := g.SearchConnections(ctx, mutationSearch(filter))
 	if err != nil {
 		return nil, err
 	}
 	if len(before.GetConnections()) == 0 {
-		return nil, ErrNotFound
+		return nil, status.Error(codes.NotFound, ErrNotFound.Error())
+	}
+	result := &apipb.MutationResult{
+		DryRun: filter.GetDryRun(),
+	}
+	for _, connection := range before.GetConnections() {
+		result.Refs = append(result.Refs, connection.GetRef())
+	}
+	result.Affected = uint64(len(result.GetRefs()))
+	if filter.GetDryRun() {
+		return result, nil
 	}
 	if err := g.db.Update(func(tx *bbolt.Tx) error {
-		for _, doc := range before.GetConnections() {
-			if err := g.delConnection(ctx, tx, doc.GetRef()); err != nil {
+		for _, connection := range before.GetConnections() {
+			if err := g.delConnection(ctx, tx, connection.GetRef()); err != nil {
 				return err
 			}
 		}
@@ -1069,7 +1777,7 @@ func (g *Graph) DelConnections(ctx context.Context, filter *apipb.Filter) (*empt
 		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	return &empty.Empty{}, nil
+	return result, nil
 }
 
 func (g *Graph) PushDocConstructors(server apipb.DatabaseService_PushDocConstructorsServer) error {
@@ -1213,7 +1921,7 @@ func (g *Graph) ExistsDoc(ctx context.Context, has *apipb.ExistsFilter) (*apipb.
 		res    bool
 	)
 
-	_, err = g.rangeSeekDocs(ctx, has.GetGtype(), has.GetSeek(), has.GetIndex(), has.GetReverse(), func(n *apipb.Doc) bool {
+	_, err = g.rangeSeekDocs(ctx, has.GetGtype(), has.GetExpression(), has.GetSeek(), has.GetIndex(), has.GetReverse(), func(n *apipb.Doc) bool {
 		res, hasErr = g.vm.Doc().Eval(n, program)
 		if hasErr != nil {
 			return false
@@ -1247,7 +1955,7 @@ func (g *Graph) ExistsConnection(ctx context.Context, has *apipb.ExistsFilter) (
 		hasErr error
 		res    bool
 	)
-	_, err = g.rangeSeekConnections(ctx, has.GetGtype(), has.GetSeek(), has.GetIndex(), has.GetReverse(), func(n *apipb.Connection) bool {
+	_, err = g.rangeSeekConnections(ctx, has.GetGtype(), has.GetExpression(), has.GetSeek(), has.GetIndex(), has.GetReverse(), func(n *apipb.Connection) bool {
 		res, hasErr = g.vm.Connection().Eval(n, program)
 		if hasErr != nil {
 			return false
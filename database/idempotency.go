@@ -0,0 +1,93 @@
+package database
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"github.com/graphikDB/graphik/helpers"
+	"go.etcd.io/bbolt"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"strings"
+	"time"
+)
+
+// idempotencyMethods are the methods that honor an idempotency key set in request metadata.
+// streaming methods are excluded since a single key would otherwise apply to every message on the stream.
+var idempotencyMethods = []string{
+	"/api.DatabaseService/CreateDoc",
+	"/api.DatabaseService/CreateDocs",
+	"/api.DatabaseService/CreateConnection",
+	"/api.DatabaseService/CreateConnections",
+}
+
+// idempotencyKey returns the storage key for a constructor within a create request. The constructor's key takes precedence
+// over the request metadata key. When the metadata key is applied to a batch, each constructor's position is appended to it.
+// Keys are scoped to the requesting user so users can never see objects created by one another. An empty string is returned
+// if no idempotency key was provided.
+func (g *Graph) idempotencyKey(ctx context.Context, kind, constructorKey string, index, count int) string {
+	key := constructorKey
+	if key == "" && helpers.ContainsString(g.getMethod(ctx), idempotencyMethods) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(idempotencyKeyHeader); len(values) > 0 && values[0] != "" {
+				key = values[0]
+				if count > 1 {
+					key = fmt.Sprintf("%s/%v", key, index)
+				}
+			}
+		}
+	}
+	if key == "" {
+		return ""
+	}
+	return helpers.Hash([]byte(strings.Join([]string{g.getIdentity(ctx).GetRef().String(), kind, key}, "|")))
+}
+
+// getIdempotent unmarshals the object created with the given idempotency key into msg. It returns false if the key is unknown or expired.
+func (g *Graph) getIdempotent(ctx context.Context, tx *bbolt.Tx, key string, msg proto.Message) (bool, error) {
+	bits := g.bucket(ctx, tx, dbIdempotency).Get([]byte(key))
+	if len(bits) < 8 {
+		return false, nil
+	}
+	if time.Now().UnixNano() > int64(binary.BigEndian.Uint64(bits[:8])) {
+		return false, nil
+	}
+	if err := g.unmarshal(bits[8:], msg); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// setIdempotent remembers the object created with the given idempotency key for the configured idempotency window
+func (g *Graph) setIdempotent(ctx context.Context, tx *bbolt.Tx, key string, msg proto.Message) error {
+	bits, err := g.marshal(msg)
+	if err != nil {
+		return err
+	}
+	value := make([]byte, 8, 8+len(bits))
+	binary.BigEndian.PutUint64(value, uint64(time.Now().Add(g.idempotencyWindow).UnixNano()))
+	return g.bucket(ctx, tx, dbIdempotency).Put([]byte(key), append(value, bits...))
+}
+
+// expireIdempotencyKeys removes all idempotency keys within the namespace that are older than the configured idempotency window
+func (g *Graph) expireIdempotencyKeys(ctx context.Context) error {
+	return g.db.Update(func(tx *bbolt.Tx) error {
+		bucket := g.bucket(ctx, tx, dbIdempotency)
+		now := time.Now().UnixNano()
+		var expired [][]byte
+		if err := bucket.ForEach(func(k, v []byte) error {
+			if len(v) < 8 || now > int64(binary.BigEndian.Uint64(v[:8])) {
+				expired = append(expired, append([]byte{}, k...))
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		for _, k := range expired {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,73 @@
+package database
+
+import (
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"google.golang.org/grpc/metadata"
+	"testing"
+)
+
+func TestIdempotentCreateDoc(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	constructor := &apipb.DocConstructor{
+		Ref:            &apipb.RefConstructor{Gtype: "order"},
+		Attributes:     apipb.NewStruct(map[string]interface{}{"total": 10}),
+		IdempotencyKey: "order-1",
+	}
+	first, err := g.CreateDoc(withMethod(g, ctx, "CreateDoc"), constructor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a retry with different attributes still returns the stored response
+	constructor.Attributes = apipb.NewStruct(map[string]interface{}{"total": 20})
+	second, err := g.CreateDoc(withMethod(g, ctx, "CreateDoc"), constructor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.GetRef().GetGid() != second.GetRef().GetGid() {
+		t.Fatalf("expected replay to return %s, got %s", first.GetRef().GetGid(), second.GetRef().GetGid())
+	}
+	if second.GetAttributes().GetFields()["total"].GetNumberValue() != 10 {
+		t.Fatalf("expected replay to return the stored doc, got %v", second.GetAttributes())
+	}
+	docs, err := g.SearchDocs(ctx, &apipb.Filter{Gtype: "order", Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(docs.GetDocs()) != 1 {
+		t.Fatalf("expected 1 order, got %v", len(docs.GetDocs()))
+	}
+}
+
+func TestIdempotentCreateConnectionsMetadata(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	a := createTestDoc(t, g, ctx, "person", "a", map[string]interface{}{})
+	b := createTestDoc(t, g, ctx, "person", "b", map[string]interface{}{})
+	ctx = metadata.NewIncomingContext(withMethod(g, ctx, "CreateConnections"), metadata.Pairs(idempotencyKeyHeader, "follows-batch"))
+	constructors := &apipb.ConnectionConstructors{Connections: []*apipb.ConnectionConstructor{
+		{Ref: &apipb.RefConstructor{Gtype: "follows"}, Directed: true, From: a.GetRef(), To: b.GetRef()},
+		{Ref: &apipb.RefConstructor{Gtype: "follows"}, Directed: true, From: b.GetRef(), To: a.GetRef()},
+	}}
+	first, err := g.CreateConnections(ctx, constructors)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := g.CreateConnections(ctx, constructors)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first.GetConnections()) != 2 || len(second.GetConnections()) != 2 {
+		t.Fatalf("expected 2 connections, got %v & %v", len(first.GetConnections()), len(second.GetConnections()))
+	}
+	for i := range first.GetConnections() {
+		if first.GetConnections()[i].GetRef().String() != second.GetConnections()[i].GetRef().String() {
+			t.Fatalf("expected replay to return %v, got %v", first.GetConnections()[i].GetRef(), second.GetConnections()[i].GetRef())
+		}
+	}
+	connections, err := g.SearchConnections(ctx, &apipb.Filter{Gtype: "follows", Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(connections.GetConnections()) != 2 {
+		t.Fatalf("expected 2 connections, got %v", len(connections.GetConnections()))
+	}
+}
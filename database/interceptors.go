@@ -166,10 +166,6 @@ func (s *Graph) getIdentity(ctx context.Context) *apipb.Doc {
 	if ok {
 		return val
 	}
-	val2, ok := ctx.Value(authCtxKey).(apipb.Doc)
-	if ok {
-		return &val2
-	}
 	return nil
 }
 
@@ -235,6 +231,14 @@ func (g *Graph) verifyJWT(token string) (map[string]interface{}, error) {
 
 func (g *Graph) check(ctx context.Context, method string, req interface{}, payload map[string]interface{}) (context.Context, error) {
 	ctx = g.methodToContext(ctx, method)
+	namespace, err := g.namespaceFromPayload(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+	if err := g.setNamespace(namespace); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	ctx = g.namespaceToContext(ctx, namespace)
 	ctx, user, err := g.userToContext(ctx, payload)
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, err.Error())
@@ -255,7 +259,7 @@ func (g *Graph) check(ctx context.Context, method string, req interface{}, paylo
 			request.Request = apipb.NewStruct(reqMap)
 		}
 		var programs []cel.Program
-		g.rangeAuthorizers(func(a *authorizer) bool {
+		g.rangeAuthorizers(ctx, func(a *authorizer) bool {
 			programs = append(programs, a.program)
 			return true
 		})
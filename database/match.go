@@ -0,0 +1,281 @@
+package database
+
+import (
+	"context"
+	"github.com/google/cel-go/cel"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Match finds the docs & connections that match the filter's pattern. Docs that may match the first node are found by
+// it's ref or the query planner & each of them is expanded along the pattern's edges depth first. A connection is only
+// used once per match.
+func (g *Graph) Match(ctx context.Context, filter *apipb.MatchFilter) (*apipb.Matches, error) {
+	m, err := g.newMatcher(filter)
+	if err != nil {
+		return nil, err
+	}
+	matches := &apipb.Matches{}
+	ctx, finish := g.startQuery(ctx, filter, filter.GetBudget(), false)
+	m.stats = getQueryStats(ctx)
+	m.emit = func(match *apipb.Match) bool {
+		matches.Matches = append(matches.Matches, match)
+		return len(matches.Matches) < int(filter.GetLimit())
+	}
+	if err := finish(len(matches.GetMatches()), "", m.match(ctx)); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return matches, nil
+}
+
+type nodeMatcher struct {
+	pattern *apipb.NodePattern
+	program cel.Program
+}
+
+type edgeMatcher struct {
+	pattern  *apipb.EdgePattern
+	program  cel.Program
+	min, max int
+}
+
+// matcher holds the state of a pattern match - the docs & connections bound to the pattern's variables so far
+type matcher struct {
+	g           *Graph
+	nodes       []nodeMatcher
+	edges       []edgeMatcher
+	stats       *queryStats
+	docs        map[string]*apipb.Doc
+	connections map[string][]*apipb.Connection
+	// used are the connections of the current match
+	used map[string]struct{}
+	// emit is called with each match - it returns false if the match should halt
+	emit   func(match *apipb.Match) bool
+	halted bool
+}
+
+func (g *Graph) newMatcher(filter *apipb.MatchFilter) (*matcher, error) {
+	if len(filter.GetEdges()) != len(filter.GetNodes())-1 {
+		return nil, status.Errorf(codes.InvalidArgument, "a pattern with %v nodes requires %v edges", len(filter.GetNodes()), len(filter.GetNodes())-1)
+	}
+	if first := filter.GetNodes()[0]; first.GetRef() == nil && (first.GetGtype() == "" || first.GetGtype() == apipb.Any) {
+		return nil, status.Error(codes.InvalidArgument, "the first node of a pattern requires a gtype or ref")
+	}
+	m := &matcher{
+		g:           g,
+		docs:        map[string]*apipb.Doc{},
+		connections: map[string][]*apipb.Connection{},
+		used:        map[string]struct{}{},
+	}
+	for _, node := range filter.GetNodes() {
+		n := nodeMatcher{pattern: node}
+		if node.GetExpression() != "" {
+			program, err := g.vm.Doc().Program(node.GetExpression())
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			n.program = program
+		}
+		m.nodes = append(m.nodes, n)
+	}
+	for i, edge := range filter.GetEdges() {
+		e := edgeMatcher{
+			pattern: edge,
+			min:     int(edge.GetMinHops()),
+			max:     int(edge.GetMaxHops()),
+		}
+		if e.min == 0 {
+			e.min = 1
+		}
+		if e.max == 0 {
+			e.max = e.min
+		}
+		if e.max < e.min {
+			return nil, status.Errorf(codes.InvalidArgument, "edges[%v]: max_hops(%v) < min_hops(%v)", i, e.max, e.min)
+		}
+		if edge.GetExpression() != "" {
+			program, err := g.vm.Connection().Program(edge.GetExpression())
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			e.program = program
+		}
+		m.edges = append(m.edges, e)
+	}
+	return m, nil
+}
+
+func (m *matcher) match(ctx context.Context) error {
+	var (
+		first  = m.nodes[0].pattern
+		starts []*apipb.Doc
+	)
+	if first.GetRef() == nil {
+		if _, err := m.g.rangeSeekDocs(ctx, first.GetGtype(), first.GetExpression(), "", "", false, func(doc *apipb.Doc) bool {
+			starts = append(starts, doc)
+			return true
+		}); err != nil && err != ErrNotFound {
+			return err
+		}
+	}
+	return m.g.db.View(func(tx *bbolt.Tx) error {
+		if first.GetRef() != nil {
+			doc, err := m.g.getDoc(ctx, tx, first.GetRef())
+			if err != nil {
+				if err == ErrNotFound {
+					return nil
+				}
+				return err
+			}
+			m.stats.decodedDoc()
+			starts = append(starts, doc)
+		}
+		for _, doc := range starts {
+			if err := m.visit(ctx, tx, 0, doc); err != nil {
+				return err
+			}
+			if m.halted {
+				return nil
+			}
+		}
+		return m.stats.err()
+	})
+}
+
+// visit binds the doc to the i'th node if it matches & continues the match along the next edge
+func (m *matcher) visit(ctx context.Context, tx *bbolt.Tx, i int, doc *apipb.Doc) error {
+	if !m.matches(i, doc) {
+		return nil
+	}
+	name := m.nodes[i].pattern.GetName()
+	if name != "" {
+		if _, ok := m.docs[name]; !ok {
+			m.docs[name] = doc
+			defer delete(m.docs, name)
+		}
+	}
+	if i == len(m.nodes)-1 {
+		m.halted = !m.emit(m.bindings())
+		return nil
+	}
+	return m.expand(ctx, tx, i, doc, nil)
+}
+
+// matches returns whether the doc matches the i'th node
+func (m *matcher) matches(i int, doc *apipb.Doc) bool {
+	node := m.nodes[i]
+	if gtype := node.pattern.GetGtype(); gtype != "" && gtype != apipb.Any && gtype != doc.GetRef().GetGtype() {
+		return false
+	}
+	if ref := node.pattern.GetRef(); ref != nil && refString(ref) != refString(doc.GetRef()) {
+		return false
+	}
+	if bound, ok := m.docs[node.pattern.GetName()]; ok && refString(bound.GetRef()) != refString(doc.GetRef()) {
+		return false
+	}
+	if node.program != nil {
+		pass, err := m.g.evalDoc(m.stats, doc, node.program)
+		m.stats.swallowed(err)
+		return err == nil && pass
+	}
+	return true
+}
+
+// expand follows the i'th edge from the doc - once the chain of connections is long enough, the doc it leads to is
+// visited as the next node
+func (m *matcher) expand(ctx context.Context, tx *bbolt.Tx, i int, doc *apipb.Doc, chain []*apipb.Connection) error {
+	edge := m.edges[i]
+	if len(chain) >= edge.min {
+		name := edge.pattern.GetName()
+		if name != "" {
+			m.connections[name] = chain
+		}
+		err := m.visit(ctx, tx, i+1, doc)
+		if name != "" {
+			delete(m.connections, name)
+		}
+		if err != nil || m.halted {
+			return err
+		}
+	}
+	if len(chain) == edge.max {
+		return nil
+	}
+	var (
+		next  []*apipb.Connection
+		fnErr error
+	)
+	if err := m.g.rangeDirection(ctx, tx, doc.GetRef(), edge.pattern.GetDirection(), func(connection *apipb.Connection) bool {
+		if fnErr = m.stats.scanned(); fnErr != nil {
+			return false
+		}
+		m.stats.decodedConnection()
+		if gtype := edge.pattern.GetGtype(); gtype != "" && gtype != apipb.Any && gtype != connection.GetRef().GetGtype() {
+			return true
+		}
+		if _, ok := m.used[refString(connection.GetRef())]; ok {
+			return true
+		}
+		if edge.program != nil {
+			pass, err := m.g.evalConnection(m.stats, connection, edge.program)
+			m.stats.swallowed(err)
+			if err != nil || !pass {
+				return true
+			}
+		}
+		next = append(next, connection)
+		return true
+	}); err != nil {
+		return err
+	}
+	if fnErr != nil {
+		return fnErr
+	}
+	for _, connection := range next {
+		if err := m.stats.err(); err != nil {
+			return err
+		}
+		// the doc on the other side of the connection
+		to := connection.GetTo()
+		if refString(connection.GetFrom()) != refString(doc.GetRef()) {
+			to = connection.GetFrom()
+		}
+		toDoc, err := m.g.getDoc(ctx, tx, to)
+		if err != nil {
+			if err == ErrNotFound {
+				m.stats.swallowed(err)
+				continue
+			}
+			return err
+		}
+		m.stats.decodedDoc()
+		key := refString(connection.GetRef())
+		m.used[key] = struct{}{}
+		err = m.expand(ctx, tx, i, toDoc, append(chain[:len(chain):len(chain)], connection))
+		delete(m.used, key)
+		if err != nil || m.halted {
+			return err
+		}
+	}
+	return nil
+}
+
+// bindings returns the docs & connections bound to the pattern's variables
+func (m *matcher) bindings() *apipb.Match {
+	match := &apipb.Match{
+		Docs:        map[string]*apipb.Doc{},
+		Connections: map[string]*apipb.Connections{},
+	}
+	for name, doc := range m.docs {
+		match.Docs[name] = doc
+	}
+	for name, chain := range m.connections {
+		match.Connections[name] = &apipb.Connections{Connections: chain}
+	}
+	return match
+}
@@ -0,0 +1,146 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sort"
+	"testing"
+)
+
+// createMatchTestGraph creates a chain a -follows-> b -follows-> c -follows-> d, a cycle e -follows-> f -follows-> g
+// -follows-> e & an undirected h -knows- i
+func createMatchTestGraph(t *testing.T, g *Graph, ctx context.Context) map[string]*apipb.Doc {
+	docs := map[string]*apipb.Doc{}
+	for _, gid := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i"} {
+		docs[gid] = createTestDoc(t, g, ctx, "person", gid, map[string]interface{}{})
+	}
+	for _, pair := range [][2]string{{"a", "b"}, {"b", "c"}, {"c", "d"}, {"e", "f"}, {"f", "g"}, {"g", "e"}} {
+		createTestConnection(t, g, ctx, "follows", docs[pair[0]].GetRef(), docs[pair[1]].GetRef(), true)
+	}
+	createTestConnection(t, g, ctx, "knows", docs["h"].GetRef(), docs["i"].GetRef(), false)
+	return docs
+}
+
+// matchedGids returns the sorted gids of the docs bound to the name in each match
+func matchedGids(matches *apipb.Matches, name string) string {
+	var gids []string
+	for _, match := range matches.GetMatches() {
+		gids = append(gids, match.GetDocs()[name].GetRef().GetGid())
+	}
+	sort.Strings(gids)
+	return fmt.Sprint(gids)
+}
+
+func TestMatchVariableLengthEdges(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	docs := createMatchTestGraph(t, g, ctx)
+	for _, test := range []struct {
+		min, max uint32
+		expected string
+	}{
+		{0, 0, "[b]"},
+		{1, 3, "[b c d]"},
+		{2, 3, "[c d]"},
+		{3, 10, "[d]"},
+	} {
+		matches, err := g.Match(withMethod(g, ctx, "Match"), &apipb.MatchFilter{
+			Nodes: []*apipb.NodePattern{{Name: "x", Ref: docs["a"].GetRef()}, {Name: "y", Gtype: "person"}},
+			Edges: []*apipb.EdgePattern{{Name: "path", Gtype: "follows", MinHops: test.min, MaxHops: test.max}},
+			Limit: 10,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := matchedGids(matches, "y"); got != test.expected {
+			t.Fatalf("%v-%v hops: expected %s, got %s", test.min, test.max, test.expected, got)
+		}
+		for _, match := range matches.GetMatches() {
+			// the chain of connections bound to the edge leads from x to y
+			chain := match.GetConnections()["path"].GetConnections()
+			if chain[0].GetFrom().GetGid() != "a" || chain[len(chain)-1].GetTo().GetGid() != match.GetDocs()["y"].GetRef().GetGid() {
+				t.Fatalf("expected a chain from a to %s, got %v", match.GetDocs()["y"].GetRef().GetGid(), chain)
+			}
+		}
+	}
+	if _, err := g.Match(withMethod(g, ctx, "Match"), &apipb.MatchFilter{
+		Nodes: []*apipb.NodePattern{{Ref: docs["a"].GetRef()}, {}},
+		Edges: []*apipb.EdgePattern{{MinHops: 3, MaxHops: 2}},
+		Limit: 10,
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected max_hops < min_hops to be rejected, got %v", err)
+	}
+}
+
+func TestMatchRebindsNamedNodes(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	createMatchTestGraph(t, g, ctx)
+	follows := &apipb.EdgePattern{Gtype: "follows"}
+	// x appears twice, so only docs on a cycle of 3 follows match
+	matches, err := g.Match(withMethod(g, ctx, "Match"), &apipb.MatchFilter{
+		Nodes: []*apipb.NodePattern{{Name: "x", Gtype: "person"}, {Name: "y"}, {Name: "z"}, {Name: "x"}},
+		Edges: []*apipb.EdgePattern{follows, follows, follows},
+		Limit: 10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := matchedGids(matches, "x"); got != "[e f g]" {
+		t.Fatalf("expected [e f g], got %s", got)
+	}
+}
+
+func TestMatchUndirectedConnections(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	docs := createMatchTestGraph(t, g, ctx)
+	for _, direction := range []apipb.Direction{apipb.Direction_OUT, apipb.Direction_IN, apipb.Direction_BOTH} {
+		// the connection was created from h, but it's followed from i in every direction(& only once when it's BOTH)
+		matches, err := g.Match(withMethod(g, ctx, "Match"), &apipb.MatchFilter{
+			Nodes: []*apipb.NodePattern{{Name: "x", Ref: docs["i"].GetRef()}, {Name: "y"}},
+			Edges: []*apipb.EdgePattern{{Gtype: "knows", Direction: direction}},
+			Limit: 10,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := matchedGids(matches, "y"); got != "[h]" {
+			t.Fatalf("%s: expected [h], got %s", direction, got)
+		}
+	}
+	// h -knows- i -knows- h would use the same connection twice
+	matches, err := g.Match(withMethod(g, ctx, "Match"), &apipb.MatchFilter{
+		Nodes: []*apipb.NodePattern{{Name: "x", Ref: docs["h"].GetRef()}, {Name: "y"}},
+		Edges: []*apipb.EdgePattern{{Gtype: "knows", Direction: apipb.Direction_BOTH, MinHops: 2}},
+		Limit: 10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches.GetMatches()) != 0 {
+		t.Fatalf("expected a connection to only be used once per match, got %v", matchedGids(matches, "y"))
+	}
+}
+
+func TestMatchLimitAndBudget(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	createMatchTestGraph(t, g, ctx)
+	filter := &apipb.MatchFilter{
+		Nodes: []*apipb.NodePattern{{Name: "x", Gtype: "person"}, {Name: "y"}},
+		Edges: []*apipb.EdgePattern{{Gtype: "follows"}},
+		Limit: 2,
+	}
+	matches, err := g.Match(withMethod(g, ctx, "Match"), filter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches.GetMatches()) != 2 {
+		t.Fatalf("expected the match to halt at it's limit, got %v matches", len(matches.GetMatches()))
+	}
+	filter.Limit = 10
+	filter.Budget = &apipb.Budget{MaxScan: 3}
+	if _, err := g.Match(withMethod(g, ctx, "Match"), filter); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted, got %v", err)
+	}
+}
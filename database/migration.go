@@ -0,0 +1,484 @@
+package database
+
+import (
+	"context"
+	"encoding/binary"
+	"github.com/autom8ter/machine"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"github.com/graphikDB/graphik/logger"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+)
+
+// Migrate validates a migration, records it as running & applies it in a background job. The migration is applied within a single
+// transaction that also records it as complete, so a version is either applied exactly once or not at all.
+// A failed migration may be retried with the same version.
+func (g *Graph) Migrate(ctx context.Context, migration *apipb.Migration) (*apipb.MigrationStatus, error) {
+	user := g.getIdentity(ctx)
+	if user == nil {
+		return nil, status.Error(codes.Unauthenticated, "failed to get user")
+	}
+	if !g.isGraphikAdmin(user) {
+		return nil, status.Error(codes.PermissionDenied, "migrations are restricted to root users")
+	}
+	for _, renames := range [][]*apipb.TypeRename{migration.GetRenameDocs(), migration.GetRenameConnections()} {
+		for _, rename := range renames {
+			if rename.GetFrom() == rename.GetTo() {
+				return nil, status.Errorf(codes.InvalidArgument, "cannot rename %s to itself", rename.GetFrom())
+			}
+		}
+	}
+	for _, rename := range migration.GetRenameDocs() {
+		if rename.GetFrom() == string(userType) || rename.GetTo() == string(userType) {
+			return nil, status.Errorf(codes.InvalidArgument, "the %s doc type is reserved", userType)
+		}
+	}
+	for _, transform := range migration.GetTransformDocs() {
+		if _, err := g.vm.Doc().Program(transform.GetExpression()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	for _, transform := range migration.GetTransformConnections() {
+		if _, err := g.vm.Connection().Program(transform.GetExpression()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	migrationStatus := &apipb.MigrationStatus{
+		Migration: migration,
+		State:     apipb.JobState_RUNNING,
+		User:      user.GetRef(),
+		StartedAt: timestamppb.Now(),
+	}
+	var current *apipb.MigrationStatus
+	if err := g.db.Update(func(tx *bbolt.Tx) error {
+		existing, err := g.getMigrationStatus(ctx, tx, migration.GetVersion())
+		if err != nil && err != ErrNotFound {
+			return err
+		}
+		if existing != nil && existing.GetState() != apipb.JobState_FAILED {
+			current = existing
+			return nil
+		}
+		return g.setMigrationStatus(ctx, tx, migrationStatus)
+	}); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if current != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "migration %v is %s", migration.GetVersion(), strings.ToLower(current.GetState().String()))
+	}
+	// the job outlives the request so it needs its own context scoped to the request's namespace & user
+	namespace := g.getNamespace(ctx)
+	method := g.getMethod(ctx)
+	g.machine.Go(func(routine machine.Routine) {
+		ctx := g.namespaceToContext(routine.Context(), namespace)
+		ctx = g.methodToContext(context.WithValue(ctx, authCtxKey, user), method)
+		logger.Info("starting migration", zap.Uint64("version", migration.GetVersion()))
+		affected, err := g.migrate(ctx, migration, proto.Clone(migrationStatus).(*apipb.MigrationStatus))
+		if err != nil {
+			logger.Error("migration failure", zap.Uint64("version", migration.GetVersion()), zap.Error(err))
+			failed := proto.Clone(migrationStatus).(*apipb.MigrationStatus)
+			failed.State = apipb.JobState_FAILED
+			failed.Error = err.Error()
+			failed.CompletedAt = timestamppb.Now()
+			if err := g.db.Update(func(tx *bbolt.Tx) error {
+				return g.setMigrationStatus(ctx, tx, failed)
+			}); err != nil {
+				logger.Error("failed to record migration failure", zap.Error(err))
+			}
+			return
+		}
+		logger.Info("migration complete", zap.Uint64("version", migration.GetVersion()), zap.Uint64("affected", affected))
+	})
+	return migrationStatus, nil
+}
+
+// GetMigrations returns the status of every migration within the namespace ordered by version
+func (g *Graph) GetMigrations(ctx context.Context, _ *empty.Empty) (*apipb.MigrationStatuses, error) {
+	statuses := &apipb.MigrationStatuses{}
+	if err := g.db.View(func(tx *bbolt.Tx) error {
+		return g.bucket(ctx, tx, dbMigrations).ForEach(func(k, v []byte) error {
+			var migrationStatus apipb.MigrationStatus
+			if err := proto.Unmarshal(v, &migrationStatus); err != nil {
+				return err
+			}
+			statuses.Migrations = append(statuses.Migrations, &migrationStatus)
+			return nil
+		})
+	}); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return statuses, nil
+}
+
+func (g *Graph) migrate(ctx context.Context, migration *apipb.Migration, migrationStatus *apipb.MigrationStatus) (uint64, error) {
+	var affected uint64
+	if err := g.db.Update(func(tx *bbolt.Tx) error {
+		for _, rename := range migration.GetRenameDocs() {
+			count, err := g.renameDocType(ctx, tx, rename)
+			if err != nil {
+				return errors.Wrapf(err, "failed to rename doc type %s", rename.GetFrom())
+			}
+			affected += count
+		}
+		for _, rename := range migration.GetRenameConnections() {
+			count, err := g.renameConnectionType(ctx, tx, rename)
+			if err != nil {
+				return errors.Wrapf(err, "failed to rename connection type %s", rename.GetFrom())
+			}
+			affected += count
+		}
+		for _, transform := range migration.GetTransformDocs() {
+			count, err := g.transformDocs(ctx, tx, transform)
+			if err != nil {
+				return errors.Wrapf(err, "failed to transform doc type %s", transform.GetGtype())
+			}
+			affected += count
+		}
+		for _, transform := range migration.GetTransformConnections() {
+			count, err := g.transformConnections(ctx, tx, transform)
+			if err != nil {
+				return errors.Wrapf(err, "failed to transform connection type %s", transform.GetGtype())
+			}
+			affected += count
+		}
+		migrationStatus.State = apipb.JobState_COMPLETE
+		migrationStatus.Affected = affected
+		migrationStatus.CompletedAt = timestamppb.Now()
+		return g.setMigrationStatus(ctx, tx, migrationStatus)
+	}); err != nil {
+		// renamed indexes & validators are cached as soon as they're renamed, so they're reloaded from the rolled back db
+		if err := g.cacheIndexes(ctx); err != nil {
+			logger.Error("failed to reload indexes", zap.Error(err))
+		}
+		if err := g.cacheTypeValidators(ctx); err != nil {
+			logger.Error("failed to reload type validators", zap.Error(err))
+		}
+		return 0, err
+	}
+	if err := g.cacheIndexes(ctx); err != nil {
+		return affected, err
+	}
+	return affected, g.cacheTypeValidators(ctx)
+}
+
+// renameDocType moves every doc of a type to the new type & rewrites the from/to of every connection referencing them
+func (g *Graph) renameDocType(ctx context.Context, tx *bbolt.Tx, rename *apipb.TypeRename) (uint64, error) {
+	docBucket := g.bucket(ctx, tx, dbDocs)
+	bucket := docBucket.Bucket([]byte(rename.GetFrom()))
+	if bucket == nil {
+		return 0, errors.Errorf("doc type %s does not exist", rename.GetFrom())
+	}
+	if docBucket.Bucket([]byte(rename.GetTo())) != nil {
+		return 0, errors.Errorf("doc type %s already exists", rename.GetTo())
+	}
+	var docs []*apipb.Doc
+	if err := bucket.ForEach(func(k, v []byte) error {
+		var doc apipb.Doc
+		if err := g.unmarshal(v, &doc); err != nil {
+			return err
+		}
+		docs = append(docs, &doc)
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	var (
+		affected uint64
+		oldRefs  []*apipb.Ref
+	)
+	// docs must exist under their new type before the connections referencing them are rewritten
+	for _, doc := range docs {
+		oldRefs = append(oldRefs, doc.GetRef())
+		g.rangeIndexes(ctx, func(i *index) bool {
+			if i.index.Docs {
+				g.delIndexedDoc(ctx, tx, []byte(i.index.Name), []byte(doc.GetRef().GetGid()))
+			}
+			return true
+		})
+		doc.Ref = &apipb.Ref{Gtype: rename.GetTo(), Gid: doc.GetRef().GetGid()}
+		if _, err := g.setDoc(ctx, tx, doc); err != nil {
+			return affected, err
+		}
+		affected++
+	}
+	rewritten := map[string]struct{}{}
+	for _, ref := range oldRefs {
+		key := g.namespacedKey(ctx, ref.String())
+		var connectionRefs []string
+		g.mu.RLock()
+		connectionRefs = append(connectionRefs, g.adjacent(tx, key, true)...)
+		connectionRefs = append(connectionRefs, g.adjacent(tx, key, false)...)
+		g.mu.RUnlock()
+		for _, refstr := range connectionRefs {
+			if _, ok := rewritten[refstr]; ok {
+				continue
+			}
+			rewritten[refstr] = struct{}{}
+			connection, err := g.getConnection(ctx, tx, fromRefString(refstr))
+			if err != nil {
+				return affected, err
+			}
+			if err := g.delConnection(ctx, tx, connection.GetRef()); err != nil {
+				return affected, err
+			}
+			if connection.GetFrom().GetGtype() == rename.GetFrom() {
+				connection.From = &apipb.Ref{Gtype: rename.GetTo(), Gid: connection.GetFrom().GetGid()}
+			}
+			if connection.GetTo().GetGtype() == rename.GetFrom() {
+				connection.To = &apipb.Ref{Gtype: rename.GetTo(), Gid: connection.GetTo().GetGid()}
+			}
+			if _, err := g.setConnection(ctx, tx, connection); err != nil {
+				return affected, err
+			}
+			affected++
+		}
+	}
+	if err := docBucket.DeleteBucket([]byte(rename.GetFrom())); err != nil {
+		return affected, err
+	}
+	return affected, g.renameSchemaType(ctx, tx, rename, true)
+}
+
+// renameConnectionType moves every connection of a type to the new type
+func (g *Graph) renameConnectionType(ctx context.Context, tx *bbolt.Tx, rename *apipb.TypeRename) (uint64, error) {
+	connectionBucket := g.bucket(ctx, tx, dbConnections)
+	bucket := connectionBucket.Bucket([]byte(rename.GetFrom()))
+	if bucket == nil {
+		return 0, errors.Errorf("connection type %s does not exist", rename.GetFrom())
+	}
+	if connectionBucket.Bucket([]byte(rename.GetTo())) != nil {
+		return 0, errors.Errorf("connection type %s already exists", rename.GetTo())
+	}
+	var connections []*apipb.Connection
+	if err := bucket.ForEach(func(k, v []byte) error {
+		var connection apipb.Connection
+		if err := g.unmarshal(v, &connection); err != nil {
+			return err
+		}
+		connections = append(connections, &connection)
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	var affected uint64
+	for _, connection := range connections {
+		if err := g.delConnection(ctx, tx, connection.GetRef()); err != nil {
+			return affected, err
+		}
+		connection.Ref = &apipb.Ref{Gtype: rename.GetTo(), Gid: connection.GetRef().GetGid()}
+		if _, err := g.setConnection(ctx, tx, connection); err != nil {
+			return affected, err
+		}
+		affected++
+	}
+	if err := connectionBucket.DeleteBucket([]byte(rename.GetFrom())); err != nil {
+		return affected, err
+	}
+	return affected, g.renameSchemaType(ctx, tx, rename, false)
+}
+
+// renameSchemaType points the indexes & type validators of a renamed doc/connection type at the new type
+func (g *Graph) renameSchemaType(ctx context.Context, tx *bbolt.Tx, rename *apipb.TypeRename, docs bool) error {
+	indexBucket := g.bucket(ctx, tx, dbIndexes)
+	var indexes []*apipb.Index
+	if err := indexBucket.ForEach(func(k, v []byte) error {
+		var i apipb.Index
+		if err := proto.Unmarshal(v, &i); err != nil {
+			return err
+		}
+		if i.GetGtype() == rename.GetFrom() && i.GetDocs() == docs {
+			indexes = append(indexes, &i)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, i := range indexes {
+		i.Gtype = rename.GetTo()
+		i, err := g.setIndex(ctx, tx, i)
+		if err != nil {
+			return err
+		}
+		// the renamed docs/connections were written before the index was renamed
+		if err := g.reindex(ctx, tx, i); err != nil {
+			return err
+		}
+		// later steps of the migration write docs/connections of the new type before the transaction commits
+		ind, err := g.compileIndex(ctx, i)
+		if err != nil {
+			return err
+		}
+		g.indexes.Set(g.namespacedKey(ctx, i.GetName()), ind, 0)
+	}
+	validatorBucket := g.bucket(ctx, tx, dbTypeValidators)
+	var validators []*apipb.TypeValidator
+	if err := validatorBucket.ForEach(func(k, v []byte) error {
+		var validator apipb.TypeValidator
+		if err := proto.Unmarshal(v, &validator); err != nil {
+			return err
+		}
+		if validator.GetGtype() == rename.GetFrom() && validator.GetDocs() == docs {
+			validators = append(validators, &validator)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, validator := range validators {
+		validator.Gtype = rename.GetTo()
+		if _, err := g.setTypedValidator(ctx, tx, validator); err != nil {
+			return err
+		}
+		v, err := g.compileTypeValidator(ctx, validator)
+		if err != nil {
+			return err
+		}
+		g.typeValidators.Set(g.namespacedKey(ctx, validator.GetName()), v, 0)
+	}
+	return nil
+}
+
+// transformDocs merges the map returned by the transform's expression into the attributes of every doc of the given type
+func (g *Graph) transformDocs(ctx context.Context, tx *bbolt.Tx, transform *apipb.AttributeTransform) (uint64, error) {
+	program, err := g.vm.Doc().Program(transform.GetExpression())
+	if err != nil {
+		return 0, err
+	}
+	bucket := g.bucket(ctx, tx, dbDocs).Bucket([]byte(transform.GetGtype()))
+	if bucket == nil {
+		return 0, errors.Errorf("doc type %s does not exist", transform.GetGtype())
+	}
+	var docs []*apipb.Doc
+	if err := bucket.ForEach(func(k, v []byte) error {
+		var doc apipb.Doc
+		if err := g.unmarshal(v, &doc); err != nil {
+			return err
+		}
+		docs = append(docs, &doc)
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	var affected uint64
+	for _, doc := range docs {
+		attributes, err := g.vm.Doc().EvalMap(doc, program)
+		if err != nil {
+			return affected, errors.Wrapf(err, "failed to transform %s", doc.GetRef().String())
+		}
+		if doc.GetAttributes() == nil {
+			doc.Attributes = apipb.NewStruct(map[string]interface{}{})
+		}
+		for k, v := range attributes.GetFields() {
+			doc.GetAttributes().GetFields()[k] = v
+		}
+		if _, err := g.setDoc(ctx, tx, doc); err != nil {
+			return affected, err
+		}
+		affected++
+	}
+	return affected, nil
+}
+
+// transformConnections merges the map returned by the transform's expression into the attributes of every connection of the given type
+func (g *Graph) transformConnections(ctx context.Context, tx *bbolt.Tx, transform *apipb.AttributeTransform) (uint64, error) {
+	program, err := g.vm.Connection().Program(transform.GetExpression())
+	if err != nil {
+		return 0, err
+	}
+	bucket := g.bucket(ctx, tx, dbConnections).Bucket([]byte(transform.GetGtype()))
+	if bucket == nil {
+		return 0, errors.Errorf("connection type %s does not exist", transform.GetGtype())
+	}
+	var connections []*apipb.Connection
+	if err := bucket.ForEach(func(k, v []byte) error {
+		var connection apipb.Connection
+		if err := g.unmarshal(v, &connection); err != nil {
+			return err
+		}
+		connections = append(connections, &connection)
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	var affected uint64
+	for _, connection := range connections {
+		attributes, err := g.vm.Connection().EvalMap(connection, program)
+		if err != nil {
+			return affected, errors.Wrapf(err, "failed to transform %s", connection.GetRef().String())
+		}
+		if connection.GetAttributes() == nil {
+			connection.Attributes = apipb.NewStruct(map[string]interface{}{})
+		}
+		for k, v := range attributes.GetFields() {
+			connection.GetAttributes().GetFields()[k] = v
+		}
+		if _, err := g.setConnection(ctx, tx, connection); err != nil {
+			return affected, err
+		}
+		affected++
+	}
+	return affected, nil
+}
+
+func migrationKey(version uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, version)
+	return key
+}
+
+func (g *Graph) getMigrationStatus(ctx context.Context, tx *bbolt.Tx, version uint64) (*apipb.MigrationStatus, error) {
+	bits := g.bucket(ctx, tx, dbMigrations).Get(migrationKey(version))
+	if len(bits) == 0 {
+		return nil, ErrNotFound
+	}
+	var migrationStatus apipb.MigrationStatus
+	if err := proto.Unmarshal(bits, &migrationStatus); err != nil {
+		return nil, err
+	}
+	return &migrationStatus, nil
+}
+
+func (g *Graph) setMigrationStatus(ctx context.Context, tx *bbolt.Tx, migrationStatus *apipb.MigrationStatus) error {
+	bits, err := proto.Marshal(migrationStatus)
+	if err != nil {
+		return err
+	}
+	return g.bucket(ctx, tx, dbMigrations).Put(migrationKey(migrationStatus.GetMigration().GetVersion()), bits)
+}
+
+// failInterruptedMigrations marks migrations that were running when the server stopped as failed so they may be retried.
+// migrations are applied in a single transaction, so an interrupted migration never partially applied.
+func (g *Graph) failInterruptedMigrations(ctx context.Context) error {
+	return g.db.Update(func(tx *bbolt.Tx) error {
+		bucket := g.bucket(ctx, tx, dbMigrations)
+		var interrupted []*apipb.MigrationStatus
+		if err := bucket.ForEach(func(k, v []byte) error {
+			var migrationStatus apipb.MigrationStatus
+			if err := proto.Unmarshal(v, &migrationStatus); err != nil {
+				return err
+			}
+			if migrationStatus.GetState() == apipb.JobState_RUNNING {
+				interrupted = append(interrupted, &migrationStatus)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		for _, migrationStatus := range interrupted {
+			migrationStatus.State = apipb.JobState_FAILED
+			migrationStatus.Error = "interrupted"
+			migrationStatus.CompletedAt = timestamppb.Now()
+			if err := g.setMigrationStatus(ctx, tx, migrationStatus); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
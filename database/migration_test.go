@@ -0,0 +1,172 @@
+package database
+
+import (
+	"context"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+// waitForMigration waits for the migration to complete or fail & returns it's status
+func waitForMigration(t *testing.T, g *Graph, ctx context.Context, version uint64) *apipb.MigrationStatus {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		statuses, err := g.GetMigrations(ctx, &empty.Empty{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, migrationStatus := range statuses.GetMigrations() {
+			if migrationStatus.GetMigration().GetVersion() == version && migrationStatus.GetState() != apipb.JobState_RUNNING {
+				return migrationStatus
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("migration %v didn't finish", version)
+	return nil
+}
+
+func TestMigrateRenameAndTransform(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	customer := createTestDoc(t, g, ctx, "customer", "1", map[string]interface{}{"first": "Ada", "last": "Lovelace"})
+	item := createTestDoc(t, g, ctx, "item", "1", map[string]interface{}{})
+	bought := createTestConnection(t, g, ctx, "bought", customer.GetRef(), item.GetRef(), true)
+	migration := &apipb.Migration{
+		Version:           1,
+		RenameDocs:        []*apipb.TypeRename{{From: "customer", To: "client"}},
+		RenameConnections: []*apipb.TypeRename{{From: "bought", To: "purchased"}},
+		TransformDocs: []*apipb.AttributeTransform{{
+			Gtype:      "client",
+			Expression: "{'full_name': this.attributes.first + ' ' + this.attributes.last}",
+		}},
+	}
+	if _, err := g.Migrate(ctx, migration); err != nil {
+		t.Fatal(err)
+	}
+	if migrationStatus := waitForMigration(t, g, ctx, 1); migrationStatus.GetState() != apipb.JobState_COMPLETE {
+		t.Fatalf("expected migration to complete, got %v: %s", migrationStatus.GetState(), migrationStatus.GetError())
+	}
+	if _, err := g.GetDoc(ctx, customer.GetRef()); err == nil {
+		t.Fatal("expected the old doc type to be gone")
+	}
+	client, err := g.GetDoc(ctx, &apipb.Ref{Gtype: "client", Gid: "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := client.GetAttributes().GetFields()["full_name"].GetStringValue(); name != "Ada Lovelace" {
+		t.Fatalf("expected transformed full_name, got %q", name)
+	}
+	purchased, err := g.GetConnection(ctx, &apipb.Ref{Gtype: "purchased", Gid: bought.GetRef().GetGid()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purchased.GetFrom().GetGtype() != "client" {
+		t.Fatalf("expected the connection to reference the renamed doc type, got %v", purchased.GetFrom())
+	}
+	connections, err := g.ConnectionsFrom(ctx, &apipb.ConnectFilter{DocRef: client.GetRef(), Gtype: "purchased", Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(connections.GetConnections()) != 1 {
+		t.Fatalf("expected the renamed doc's adjacency to be rewritten, got %v connections", len(connections.GetConnections()))
+	}
+	if _, err := g.Migrate(ctx, migration); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("expected a version to only be applied once, got %v", err)
+	}
+}
+
+func TestMigrateInterrupted(t *testing.T) {
+	dir := t.TempDir()
+	g, ctx := openTestGraph(t, &apipb.Flags{StoragePath: dir})
+	createTestDoc(t, g, ctx, "customer", "1", map[string]interface{}{})
+	migration := &apipb.Migration{
+		Version:    1,
+		RenameDocs: []*apipb.TypeRename{{From: "customer", To: "client"}},
+	}
+	// simulate a server that stopped while the migration was running
+	if err := g.db.Update(func(tx *bbolt.Tx) error {
+		return g.setMigrationStatus(ctx, tx, &apipb.MigrationStatus{Migration: migration, State: apipb.JobState_RUNNING})
+	}); err != nil {
+		t.Fatal(err)
+	}
+	g.Close()
+
+	g, ctx = openTestGraph(t, &apipb.Flags{StoragePath: dir})
+	statuses, err := g.GetMigrations(ctx, &empty.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statuses.GetMigrations()) != 1 || statuses.GetMigrations()[0].GetState() != apipb.JobState_FAILED {
+		t.Fatalf("expected the interrupted migration to be marked failed, got %v", statuses.GetMigrations())
+	}
+	if _, err := g.GetDoc(ctx, &apipb.Ref{Gtype: "customer", Gid: "1"}); err != nil {
+		t.Fatalf("expected the interrupted migration not to be applied: %v", err)
+	}
+	// a failed migration may be retried with the same version
+	if _, err := g.Migrate(ctx, migration); err != nil {
+		t.Fatal(err)
+	}
+	if migrationStatus := waitForMigration(t, g, ctx, 1); migrationStatus.GetState() != apipb.JobState_COMPLETE {
+		t.Fatalf("expected the retried migration to complete, got %v: %s", migrationStatus.GetState(), migrationStatus.GetError())
+	}
+	if _, err := g.GetDoc(ctx, &apipb.Ref{Gtype: "client", Gid: "1"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMigrateRenamedIndexesBeforeTransforms(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	if _, err := g.SetIndexes(ctx, &apipb.Indexes{Indexes: []*apipb.Index{
+		{Name: "ages", Gtype: "member", Docs: true, Kind: apipb.IndexKind_VALUE, Fields: []string{"attributes.age"}},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	createTestDoc(t, g, ctx, "member", "1", map[string]interface{}{"age": 30.0})
+	if _, err := g.Migrate(ctx, &apipb.Migration{
+		Version:    1,
+		RenameDocs: []*apipb.TypeRename{{From: "member", To: "person"}},
+		TransformDocs: []*apipb.AttributeTransform{{
+			Gtype:      "person",
+			Expression: "{'age': 31.0}",
+		}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if migrationStatus := waitForMigration(t, g, ctx, 1); migrationStatus.GetState() != apipb.JobState_COMPLETE {
+		t.Fatalf("expected migration to complete, got %v: %s", migrationStatus.GetState(), migrationStatus.GetError())
+	}
+	for expression, expected := range map[string]int{
+		"this.attributes.age == 31.0": 1,
+		"this.attributes.age == 30.0": 0,
+	} {
+		docs, err := g.SearchDocs(withMethod(g, ctx, "SearchDocs"), &apipb.Filter{Gtype: "person", Expression: expression, Limit: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(docs.GetDocs()) != expected {
+			t.Fatalf("%s: expected %v docs, got %v", expression, expected, len(docs.GetDocs()))
+		}
+	}
+	// a failed migration restores the cached indexes to their type before the rename
+	if _, err := g.Migrate(ctx, &apipb.Migration{
+		Version:    2,
+		RenameDocs: []*apipb.TypeRename{{From: "person", To: "human"}},
+		TransformDocs: []*apipb.AttributeTransform{{
+			Gtype:      "human",
+			Expression: "{'age': this.attributes.missing}",
+		}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if migrationStatus := waitForMigration(t, g, ctx, 2); migrationStatus.GetState() != apipb.JobState_FAILED {
+		t.Fatalf("expected migration to fail, got %v", migrationStatus.GetState())
+	}
+	if i := g.getIndex(ctx, "ages"); i == nil || i.index.GetGtype() != "person" {
+		t.Fatalf("expected the index to be restored, got %v", i)
+	}
+}
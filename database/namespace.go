@@ -0,0 +1,137 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"github.com/graphikDB/graphik/helpers"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// namespacedBuckets are the buckets every namespace gets a private copy of
+var namespacedBuckets = [][]byte{
+	dbDocs,
+	dbConnections,
+	dbIndexes,
+	dbAuthorizers,
+	dbTypeValidators,
+	dbIndexDocs,
+	dbIndexConnections,
+	dbIdempotency,
+	dbMigrations,
+	dbTextIndexes,
+	dbGeoIndexes,
+	dbVectorIndexes,
+	dbValueIndexes,
+	dbBackfilledIndexes,
+}
+
+// getNamespace returns the namespace of the request. An empty string is the default namespace.
+func (g *Graph) getNamespace(ctx context.Context) string {
+	val, ok := ctx.Value(namespaceCtxKey).(string)
+	if ok {
+		return val
+	}
+	return ""
+}
+
+func (g *Graph) namespaceToContext(ctx context.Context, namespace string) context.Context {
+	return context.WithValue(ctx, namespaceCtxKey, namespace)
+}
+
+// namespacedKey scopes an in-memory key(cache key, adjacency key, pubsub channel) to the namespace of the request
+func (g *Graph) namespacedKey(ctx context.Context, key string) string {
+	return fmt.Sprintf("%s\x00%s", g.getNamespace(ctx), key)
+}
+
+// bucket returns the top level bucket with the given name within the namespace of the request
+func (g *Graph) bucket(ctx context.Context, tx *bbolt.Tx, name []byte) *bbolt.Bucket {
+	namespace := g.getNamespace(ctx)
+	if namespace == "" {
+		return tx.Bucket(name)
+	}
+	bucket := tx.Bucket(dbNamespaces).Bucket([]byte(namespace))
+	if bucket == nil {
+		return nil
+	}
+	return bucket.Bucket(name)
+}
+
+// namespaceFromPayload returns the namespace of a request. The namespace is taken from the configured userinfo claim &
+// users without the claim are denied. root users may override it with the x-graphik-namespace request header(an empty
+// header is the default namespace).
+func (g *Graph) namespaceFromPayload(ctx context.Context, payload map[string]interface{}) (string, error) {
+	var (
+		namespace string
+		claimed   bool
+	)
+	if g.namespaceClaim != "" {
+		namespace, claimed = payload[g.namespaceClaim].(string)
+		claimed = claimed && namespace != ""
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(namespaceHeader); len(values) > 0 {
+		if values[0] != namespace || (g.namespaceClaim != "" && !claimed) {
+			email, _ := payload["email"].(string)
+			if !helpers.ContainsString(email, g.rootUsers) {
+				return "", status.Error(codes.PermissionDenied, "only root users may set the namespace header")
+			}
+		}
+		namespace = values[0]
+	} else if g.namespaceClaim != "" && !claimed {
+		return "", status.Errorf(codes.PermissionDenied, "missing namespace claim: %s", g.namespaceClaim)
+	}
+	if strings.Contains(namespace, "\x00") {
+		return "", status.Error(codes.InvalidArgument, "invalid namespace")
+	}
+	return namespace, nil
+}
+
+// setNamespace creates the namespace's buckets if they don't already exist
+func (g *Graph) setNamespace(namespace string) error {
+	if namespace == "" {
+		return nil
+	}
+	if _, ok := g.namespaces.Get(namespace); ok {
+		return nil
+	}
+	if err := g.db.Update(func(tx *bbolt.Tx) error {
+		bucket, err := tx.Bucket(dbNamespaces).CreateBucketIfNotExists([]byte(namespace))
+		if err != nil {
+			return errors.Wrapf(err, "failed to create namespace %s", namespace)
+		}
+		for _, name := range namespacedBuckets {
+			if _, err := bucket.CreateBucketIfNotExists(name); err != nil {
+				return errors.Wrapf(err, "failed to create namespace %s bucket %s", namespace, string(name))
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	g.namespaces.Set(namespace, struct{}{}, 0)
+	return nil
+}
+
+// rangeNamespaces executes the function with a context for the default namespace & every other namespace in the db
+func (g *Graph) rangeNamespaces(ctx context.Context, fn func(ctx context.Context) error) error {
+	namespaces := []string{""}
+	if err := g.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(dbNamespaces).ForEach(func(name []byte, _ []byte) error {
+			namespaces = append(namespaces, string(name))
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+	for _, namespace := range namespaces {
+		if err := fn(g.namespaceToContext(ctx, namespace)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
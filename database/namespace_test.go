@@ -0,0 +1,139 @@
+package database
+
+import (
+	"context"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+// tenantContext authenticates a request the way the interceptors do - the namespace is taken from the user's tenant claim
+// or the namespace header
+func tenantContext(g *Graph, email, tenant, header string) (context.Context, error) {
+	ctx := context.Background()
+	if header != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(namespaceHeader, header))
+	}
+	return g.check(ctx, "/api.DatabaseService/CreateDoc", nil, map[string]interface{}{
+		"email":  email,
+		"tenant": tenant,
+	})
+}
+
+func mustTenantContext(t *testing.T, g *Graph, email, tenant, header string) context.Context {
+	t.Helper()
+	ctx, err := tenantContext(g, email, tenant, header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ctx
+}
+
+func TestNamespaceIsolation(t *testing.T) {
+	g, _ := openTestGraph(t, &apipb.Flags{NamespaceClaim: "tenant"})
+	var (
+		alice = mustTenantContext(t, g, "alice@a.com", "a", "")
+		bob   = mustTenantContext(t, g, "bob@b.com", "b", "")
+	)
+	from := createTestDoc(t, g, alice, "person", "1", map[string]interface{}{"tenant": "a"})
+	to := createTestDoc(t, g, alice, "person", "2", map[string]interface{}{"tenant": "a"})
+	createTestConnection(t, g, alice, "knows", from.GetRef(), to.GetRef(), true)
+
+	// docs
+	if _, err := g.GetDoc(bob, from.GetRef()); err == nil {
+		t.Fatal("expected another namespace's doc to be invisible")
+	}
+	if _, err := g.SearchDocs(bob, &apipb.Filter{Gtype: "person", Limit: 10}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected no docs in namespace b, got %v", err)
+	}
+	// the same ref may exist in both namespaces
+	createTestDoc(t, g, bob, "person", "1", map[string]interface{}{"tenant": "b"})
+	createTestDoc(t, g, bob, "person", "2", map[string]interface{}{"tenant": "b"})
+	doc, err := g.GetDoc(alice, from.GetRef())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc.GetAttributes().GetFields()["tenant"].GetStringValue() != "a" {
+		t.Fatalf("expected namespace a's doc to be untouched, got %v", doc.GetAttributes())
+	}
+
+	// connections & the adjacency cache
+	if _, err := g.SearchConnections(bob, &apipb.Filter{Gtype: "knows", Limit: 10}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected no connections in namespace b, got %v", err)
+	}
+	connections, err := g.ConnectionsFrom(bob, &apipb.ConnectFilter{DocRef: from.GetRef(), Gtype: "knows", Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(connections.GetConnections()) > 0 {
+		t.Fatalf("expected namespace b's doc to have no connections, got %v", connections.GetConnections())
+	}
+	connections, err = g.ConnectionsFrom(alice, &apipb.ConnectFilter{DocRef: from.GetRef(), Gtype: "knows", Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(connections.GetConnections()) != 1 {
+		t.Fatalf("expected namespace a's doc to have 1 connection, got %v", len(connections.GetConnections()))
+	}
+
+	// indexes
+	root := mustTenantContext(t, g, testRootUser, "", "a")
+	if _, err := g.SetIndexes(root, &apipb.Indexes{Indexes: []*apipb.Index{{
+		Name:       "people",
+		Gtype:      "person",
+		Expression: "this.attributes.tenant == 'a'",
+		Docs:       true,
+	}}}); err != nil {
+		t.Fatal(err)
+	}
+	schemaA, err := g.GetSchema(alice, &empty.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	schemaB, err := g.GetSchema(bob, &empty.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(schemaA.GetIndexes().GetIndexes()) != 1 || len(schemaB.GetIndexes().GetIndexes()) != 0 {
+		t.Fatalf("expected the index to only exist in namespace a, got %v & %v", schemaA.GetIndexes(), schemaB.GetIndexes())
+	}
+}
+
+func TestNamespaceHeader(t *testing.T) {
+	g, _ := openTestGraph(t, &apipb.Flags{NamespaceClaim: "tenant"})
+	if _, err := tenantContext(g, "alice@a.com", "a", "b"); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected a non-root user setting the namespace header to be denied, got %v", err)
+	}
+	// setting the header to the user's own namespace is allowed
+	mustTenantContext(t, g, "alice@a.com", "a", "a")
+	alice := mustTenantContext(t, g, "alice@a.com", "a", "")
+	createTestDoc(t, g, alice, "note", "1", map[string]interface{}{})
+	root := mustTenantContext(t, g, testRootUser, "", "a")
+	if g.getNamespace(root) != "a" {
+		t.Fatalf("expected root to switch to namespace a, got %q", g.getNamespace(root))
+	}
+	if _, err := g.GetDoc(root, &apipb.Ref{Gtype: "note", Gid: "1"}); err != nil {
+		t.Fatalf("expected root to read namespace a: %v", err)
+	}
+	// only root users may address the default namespace(with an empty header) when the claim is required
+	if _, err := tenantContext(g, "bob@b.com", "", ""); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected a user without the claim to be denied, got %v", err)
+	}
+	if _, err := tenantContext(g, testRootUser, "", ""); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected a root user without the claim or header to be denied, got %v", err)
+	}
+	defaultCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(namespaceHeader, ""))
+	if _, err := g.check(defaultCtx, "/api.DatabaseService/CreateDoc", nil, map[string]interface{}{"email": "bob@b.com"}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected a non-root user addressing the default namespace to be denied, got %v", err)
+	}
+	root, err := g.check(defaultCtx, "/api.DatabaseService/CreateDoc", nil, map[string]interface{}{"email": testRootUser})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.GetDoc(root, &apipb.Ref{Gtype: "note", Gid: "1"}); err == nil {
+		t.Fatal("expected namespace a's doc to be invisible from the default namespace")
+	}
+}
@@ -0,0 +1,177 @@
+package database
+
+import (
+	"context"
+	"github.com/google/cel-go/cel"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math"
+	"sort"
+)
+
+// SimilarNeighbors finds the candidates that share neighbors with the filter's doc & ranks them by the similarity of
+// their neighborhoods. A doc's neighbors are the docs joined to it by connections of the filter's type in the filter's
+// direction - candidates are the docs joined to those neighbors in the opposite direction.
+func (g *Graph) SimilarNeighbors(ctx context.Context, filter *apipb.NeighborFilter) (*apipb.NeighborDocs, error) {
+	var program cel.Program
+	if filter.GetExpression() != "" {
+		var err error
+		program, err = g.vm.Doc().Program(filter.GetExpression())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	ctx, finish := g.startQuery(ctx, filter, filter.GetBudget(), false)
+	docs, err := g.similarNeighbors(ctx, filter, program)
+	if err := finish(len(docs.GetDocs()), "", err); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return docs, nil
+}
+
+func (g *Graph) similarNeighbors(ctx context.Context, filter *apipb.NeighborFilter, program cel.Program) (*apipb.NeighborDocs, error) {
+	var (
+		stats     = getQueryStats(ctx)
+		direction = filter.GetDirection()
+		self      = refString(filter.GetDoc())
+		docs      = &apipb.NeighborDocs{}
+	)
+	// candidates are reached by following connections back from the doc's neighbors
+	reverse := apipb.Direction_BOTH
+	switch direction {
+	case apipb.Direction_OUT:
+		reverse = apipb.Direction_IN
+	case apipb.Direction_IN:
+		reverse = apipb.Direction_OUT
+	}
+	if err := g.db.View(func(tx *bbolt.Tx) error {
+		if _, err := g.getDoc(ctx, tx, filter.GetDoc()); err != nil {
+			if err == ErrNotFound {
+				return status.Errorf(codes.NotFound, "doc %s/%s not found", filter.GetDoc().GetGtype(), filter.GetDoc().GetGid())
+			}
+			return err
+		}
+		stats.decodedDoc()
+		neighbors, err := g.neighbors(ctx, tx, filter.GetDoc(), direction, filter.GetGtype())
+		if err != nil {
+			return err
+		}
+		var (
+			isNeighbor = map[string]struct{}{}
+			common     = map[string]int{}
+			adamicAdar = map[string]float64{}
+			candidates []string
+		)
+		for _, neighbor := range neighbors {
+			isNeighbor[refString(neighbor)] = struct{}{}
+		}
+		for _, neighbor := range neighbors {
+			reached, err := g.neighbors(ctx, tx, neighbor, reverse, filter.GetGtype())
+			if err != nil {
+				return err
+			}
+			for _, candidate := range reached {
+				key := refString(candidate)
+				if key == self {
+					continue
+				}
+				if _, ok := common[key]; !ok {
+					candidates = append(candidates, key)
+				}
+				common[key]++
+				// the neighbor is joined to the doc & the candidate at least, so it's degree is > 1
+				adamicAdar[key] += 1 / math.Log(float64(len(reached)))
+			}
+		}
+		for _, key := range candidates {
+			if err := stats.err(); err != nil {
+				return err
+			}
+			if _, ok := isNeighbor[key]; ok && filter.GetExcludeNeighbors() {
+				continue
+			}
+			doc, err := g.getDoc(ctx, tx, fromRefString(key))
+			if err != nil {
+				if err == ErrNotFound {
+					stats.swallowed(err)
+					continue
+				}
+				return err
+			}
+			stats.decodedDoc()
+			if program != nil {
+				pass, err := g.evalDoc(stats, doc, program)
+				stats.swallowed(err)
+				if err != nil || !pass {
+					continue
+				}
+			}
+			result := &apipb.NeighborDoc{Doc: doc, Common: uint64(common[key])}
+			switch filter.GetMetric() {
+			case apipb.NeighborMetric_JACCARD:
+				theirs, err := g.neighbors(ctx, tx, doc.GetRef(), direction, filter.GetGtype())
+				if err != nil {
+					return err
+				}
+				result.Score = float64(common[key]) / float64(len(neighbors)+len(theirs)-common[key])
+			case apipb.NeighborMetric_ADAMIC_ADAR:
+				result.Score = adamicAdar[key]
+			default:
+				result.Score = float64(common[key])
+			}
+			docs.Docs = append(docs.Docs, result)
+		}
+		return stats.err()
+	}); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(docs.Docs, func(i, j int) bool {
+		if docs.Docs[i].GetScore() != docs.Docs[j].GetScore() {
+			return docs.Docs[i].GetScore() > docs.Docs[j].GetScore()
+		}
+		return docs.Docs[i].GetCommon() > docs.Docs[j].GetCommon()
+	})
+	if len(docs.Docs) > int(filter.GetLimit()) {
+		docs.Docs = docs.Docs[:filter.GetLimit()]
+	}
+	return docs, nil
+}
+
+// neighbors returns the distinct docs joined to a doc by connections of the given type in the given direction
+func (g *Graph) neighbors(ctx context.Context, tx *bbolt.Tx, docRef *apipb.Ref, direction apipb.Direction, gtype string) ([]*apipb.Ref, error) {
+	var (
+		stats     = getQueryStats(ctx)
+		self      = refString(docRef)
+		seen      = map[string]struct{}{}
+		neighbors []*apipb.Ref
+		fnErr     error
+	)
+	if err := g.rangeTypes(ctx, tx, docRef, direction, map[string]struct{}{gtype: {}}, func(connection *apipb.Connection) bool {
+		if fnErr = stats.scanned(); fnErr != nil {
+			return false
+		}
+		stats.decodedConnection()
+		// the doc on the other side of the connection
+		other := connection.GetTo()
+		if refString(other) == self {
+			other = connection.GetFrom()
+		}
+		// a doc isn't it's own neighbor
+		if refString(other) == self {
+			return true
+		}
+		if _, ok := seen[refString(other)]; !ok {
+			seen[refString(other)] = struct{}{}
+			neighbors = append(neighbors, other)
+		}
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	return neighbors, fnErr
+}
@@ -0,0 +1,179 @@
+package database
+
+import (
+	"fmt"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"math"
+	"testing"
+)
+
+// neighborScore is the expected gid, score & number of common neighbors of a ranked doc
+type neighborScore struct {
+	gid    string
+	score  float64
+	common uint64
+}
+
+func assertNeighbors(t *testing.T, name string, docs *apipb.NeighborDocs, expected []neighborScore) {
+	t.Helper()
+	if len(docs.GetDocs()) != len(expected) {
+		t.Fatalf("%s: expected %v docs, got %v", name, len(expected), docs.GetDocs())
+	}
+	for i, doc := range docs.GetDocs() {
+		// docs with the same score & common neighbors may be ranked in any order
+		var found bool
+		for j, e := range expected {
+			if e.gid == doc.GetDoc().GetRef().GetGid() {
+				found = true
+				if math.Abs(e.score-doc.GetScore()) > 1e-9 || e.common != doc.GetCommon() {
+					t.Fatalf("%s: expected %s to have a score of %v & %v common neighbors, got %v & %v", name, e.gid, e.score, e.common, doc.GetScore(), doc.GetCommon())
+				}
+				if e.score != expected[i].score || e.common != expected[i].common {
+					t.Fatalf("%s: expected %s to be ranked %v, got %v", name, e.gid, j, i)
+				}
+			}
+		}
+		if !found {
+			t.Fatalf("%s: unexpected doc %s", name, doc.GetDoc().GetRef().GetGid())
+		}
+	}
+}
+
+func TestSimilarNeighborsMetrics(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	docs := map[string]*apipb.Doc{}
+	for _, gid := range []string{"alice", "bob", "carol", "dave", "erin", "frank"} {
+		docs[gid] = createTestDoc(t, g, ctx, "person", gid, map[string]interface{}{})
+	}
+	// alice: bob, carol, dave | bob: alice, carol, erin | carol: alice, bob, erin | dave: alice, frank
+	for _, pair := range [][2]string{{"alice", "bob"}, {"alice", "carol"}, {"alice", "dave"}, {"bob", "carol"}, {"bob", "erin"}, {"carol", "erin"}, {"dave", "frank"}} {
+		createTestConnection(t, g, ctx, "friend", docs[pair[0]].GetRef(), docs[pair[1]].GetRef(), false)
+	}
+	for _, test := range []struct {
+		name     string
+		metric   apipb.NeighborMetric
+		exclude  bool
+		expected []neighborScore
+	}{
+		{"common neighbors", apipb.NeighborMetric_COMMON_NEIGHBORS, false, []neighborScore{
+			{"erin", 2, 2},
+			{"bob", 1, 1},
+			{"carol", 1, 1},
+			{"frank", 1, 1},
+		}},
+		{"jaccard", apipb.NeighborMetric_JACCARD, false, []neighborScore{
+			// |{bob carol}| / |{bob carol dave}|
+			{"erin", 2.0 / 3, 2},
+			// |{dave}| / |{bob carol dave}|
+			{"frank", 1.0 / 3, 1},
+			// |{carol}| / |{alice bob carol dave erin}|
+			{"bob", 1.0 / 5, 1},
+			{"carol", 1.0 / 5, 1},
+		}},
+		{"adamic adar", apipb.NeighborMetric_ADAMIC_ADAR, false, []neighborScore{
+			// through bob & carol who both have 3 friends
+			{"erin", 2 / math.Log(3), 2},
+			// through dave who has 2 friends
+			{"frank", 1 / math.Log(2), 1},
+			{"bob", 1 / math.Log(3), 1},
+			{"carol", 1 / math.Log(3), 1},
+		}},
+		{"exclude neighbors", apipb.NeighborMetric_COMMON_NEIGHBORS, true, []neighborScore{
+			{"erin", 2, 2},
+			{"frank", 1, 1},
+		}},
+	} {
+		neighbors, err := g.SimilarNeighbors(withMethod(g, ctx, "SimilarNeighbors"), &apipb.NeighborFilter{
+			Doc:              docs["alice"].GetRef(),
+			Gtype:            "friend",
+			Metric:           test.metric,
+			ExcludeNeighbors: test.exclude,
+			Limit:            10,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertNeighbors(t, test.name, neighbors, test.expected)
+	}
+	neighbors, err := g.SimilarNeighbors(withMethod(g, ctx, "SimilarNeighbors"), &apipb.NeighborFilter{
+		Doc:        docs["alice"].GetRef(),
+		Gtype:      "friend",
+		Expression: "this.ref.gid != 'erin'",
+		Limit:      1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(neighbors.GetDocs()) != 1 || neighbors.GetDocs()[0].GetDoc().GetRef().GetGid() == "erin" {
+		t.Fatalf("expected 1 doc other than erin, got %v", neighbors.GetDocs())
+	}
+}
+
+func TestSimilarNeighborsIn(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	items := map[string]*apipb.Doc{}
+	for i := 1; i <= 4; i++ {
+		gid := fmt.Sprintf("item%v", i)
+		items[gid] = createTestDoc(t, g, ctx, "item", gid, map[string]interface{}{})
+	}
+	for user, bought := range map[string][]string{
+		"u1": {"item1", "item2"},
+		"u2": {"item1", "item2", "item3"},
+		"u3": {"item1", "item3", "item4"},
+		"u4": {"item4"},
+	} {
+		doc := createTestDoc(t, g, ctx, "user", user, map[string]interface{}{})
+		for _, item := range bought {
+			createTestConnection(t, g, ctx, "bought", doc.GetRef(), items[item].GetRef(), true)
+		}
+	}
+	// the users that bought item1(u1, u2 & u3), then the other items they bought
+	for _, test := range []struct {
+		name     string
+		metric   apipb.NeighborMetric
+		expected []neighborScore
+	}{
+		{"common neighbors", apipb.NeighborMetric_COMMON_NEIGHBORS, []neighborScore{
+			{"item2", 2, 2},
+			{"item3", 2, 2},
+			{"item4", 1, 1},
+		}},
+		{"jaccard", apipb.NeighborMetric_JACCARD, []neighborScore{
+			// |{u1 u2}| / |{u1 u2 u3}|
+			{"item2", 2.0 / 3, 2},
+			{"item3", 2.0 / 3, 2},
+			// |{u3}| / |{u1 u2 u3 u4}|
+			{"item4", 1.0 / 4, 1},
+		}},
+		{"adamic adar", apipb.NeighborMetric_ADAMIC_ADAR, []neighborScore{
+			// u1 bought 2 items, u2 & u3 bought 3
+			{"item2", 1/math.Log(2) + 1/math.Log(3), 2},
+			{"item3", 2 / math.Log(3), 2},
+			{"item4", 1 / math.Log(3), 1},
+		}},
+	} {
+		neighbors, err := g.SimilarNeighbors(withMethod(g, ctx, "SimilarNeighbors"), &apipb.NeighborFilter{
+			Doc:       items["item1"].GetRef(),
+			Gtype:     "bought",
+			Direction: apipb.Direction_IN,
+			Metric:    test.metric,
+			Limit:     10,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertNeighbors(t, test.name, neighbors, test.expected)
+	}
+	// nothing was bought by item1
+	neighbors, err := g.SimilarNeighbors(withMethod(g, ctx, "SimilarNeighbors"), &apipb.NeighborFilter{
+		Doc:   items["item1"].GetRef(),
+		Gtype: "bought",
+		Limit: 10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(neighbors.GetDocs()) != 0 {
+		t.Fatalf("expected no docs when following bought out of an item, got %v", neighbors.GetDocs())
+	}
+}
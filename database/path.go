@@ -0,0 +1,251 @@
+package database
+
+import (
+	"context"
+	"github.com/google/cel-go/cel"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"github.com/graphikDB/graphik/pathfind"
+	"go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// ShortestPath finds the lowest cost path(s) from the source doc to the target doc. Connections are weighted by the
+// filter's weight expression(Dijkstra) or all have a weight of 1 if it's empty(breadth-first-search).
+func (g *Graph) ShortestPath(ctx context.Context, filter *apipb.PathFilter) (*apipb.Paths, error) {
+	p, err := g.newPathGraph(filter.GetTarget(), filter.GetReverse(), "", filter.GetConnectionExpression(), filter.GetWeight())
+	if err != nil {
+		return nil, err
+	}
+	k := int(filter.GetK())
+	if k == 0 {
+		k = 1
+	}
+	ctx, finish := g.startQuery(ctx, filter, filter.GetBudget(), false)
+	paths, err := p.find(ctx, filter.GetSource(), func(neighbors pathfind.Neighbors) ([]*pathfind.Path, error) {
+		return pathfind.KShortest(refString(filter.GetSource()), refString(filter.GetTarget()), k, p.weightProgram != nil, neighbors)
+	})
+	if err := p.finish(finish, paths, err); err != nil {
+		return nil, err
+	}
+	return paths, nil
+}
+
+// AllPaths finds every loopless path from the source doc to the target doc(up to the filter's max depth & max paths)
+// that only passes through docs & connections that pass the filter's expressions. Shorter paths are returned first.
+func (g *Graph) AllPaths(ctx context.Context, filter *apipb.AllPathsFilter) (*apipb.Paths, error) {
+	p, err := g.newPathGraph(filter.GetTarget(), filter.GetReverse(), filter.GetDocExpression(), filter.GetConnectionExpression(), "")
+	if err != nil {
+		return nil, err
+	}
+	ctx, finish := g.startQuery(ctx, filter, filter.GetBudget(), false)
+	paths, err := p.find(ctx, filter.GetSource(), func(neighbors pathfind.Neighbors) ([]*pathfind.Path, error) {
+		return pathfind.All(refString(filter.GetSource()), refString(filter.GetTarget()), int(filter.GetMaxDepth()), int(filter.GetMaxPaths()), neighbors)
+	})
+	if err := p.finish(finish, paths, err); err != nil {
+		return nil, err
+	}
+	return paths, nil
+}
+
+// pathGraph explores the graph for path searches. The edges of each doc explored are cached since path searches explore
+// the same docs many times.
+type pathGraph struct {
+	g                 *Graph
+	target            *apipb.Ref
+	reverse           bool
+	docProgram        cel.Program
+	connectionProgram cel.Program
+	weightProgram     cel.Program
+	connections       map[string]*apipb.Connection
+	edges             map[string][]pathfind.Edge
+	// passed caches whether each doc passes the doc program
+	passed map[string]bool
+}
+
+func (g *Graph) newPathGraph(target *apipb.Ref, reverse bool, docExpression, connectionExpression, weight string) (*pathGraph, error) {
+	p := &pathGraph{
+		g:           g,
+		target:      target,
+		reverse:     reverse,
+		connections: map[string]*apipb.Connection{},
+		edges:       map[string][]pathfind.Edge{},
+		passed:      map[string]bool{},
+	}
+	var err error
+	if docExpression != "" {
+		p.docProgram, err = g.vm.Doc().Program(docExpression)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if connectionExpression != "" {
+		p.connectionProgram, err = g.vm.Connection().Program(connectionExpression)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if weight != "" {
+		p.weightProgram, err = g.vm.Connection().Program(weight)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	return p, nil
+}
+
+// find executes the search within a read-only transaction & converts the paths it finds to docs & connections
+func (p *pathGraph) find(ctx context.Context, source *apipb.Ref, search func(neighbors pathfind.Neighbors) ([]*pathfind.Path, error)) (*apipb.Paths, error) {
+	paths := &apipb.Paths{}
+	err := p.g.db.View(func(tx *bbolt.Tx) error {
+		for _, ref := range []*apipb.Ref{source, p.target} {
+			if _, err := p.g.getDoc(ctx, tx, ref); err != nil {
+				if err == ErrNotFound {
+					return status.Errorf(codes.NotFound, "doc %s/%s not found", ref.GetGtype(), ref.GetGid())
+				}
+				return err
+			}
+		}
+		found, err := search(func(node string) ([]pathfind.Edge, error) {
+			return p.neighbors(ctx, tx, node)
+		})
+		if err != nil {
+			if err == pathfind.ErrNegativeWeight {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+			return err
+		}
+		for _, f := range found {
+			path := &apipb.Path{Cost: f.Cost}
+			for _, node := range f.Nodes {
+				doc, err := p.g.getDoc(ctx, tx, fromRefString(node))
+				if err != nil {
+					return err
+				}
+				path.Docs = append(path.Docs, doc)
+			}
+			for _, edge := range f.Edges {
+				path.Connections = append(path.Connections, p.connections[edge.ID])
+			}
+			paths.Paths = append(paths.Paths, path)
+		}
+		return nil
+	})
+	return paths, err
+}
+
+// finish completes the query(see startQuery) & converts errors to status errors
+func (p *pathGraph) finish(finish func(results int, seek string, err error) error, paths *apipb.Paths, err error) error {
+	if err := finish(len(paths.GetPaths()), "", err); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// neighbors returns the edges leaving a doc - connections that don't pass the connection program or lead to docs that
+// don't pass the doc program(other than the target) are skipped
+func (p *pathGraph) neighbors(ctx context.Context, tx *bbolt.Tx, node string) ([]pathfind.Edge, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if cached, ok := p.edges[node]; ok {
+		return cached, nil
+	}
+	var (
+		stats = getQueryStats(ctx)
+		edges []pathfind.Edge
+		fnErr error
+	)
+	fn := func(connection *apipb.Connection) bool {
+		if fnErr = stats.scanned(); fnErr != nil {
+			return false
+		}
+		stats.decodedConnection()
+		if p.connectionProgram != nil {
+			pass, err := p.g.evalConnection(stats, connection, p.connectionProgram)
+			stats.swallowed(err)
+			if err != nil || !pass {
+				return true
+			}
+		}
+		to := connection.GetTo()
+		if p.reverse {
+			to = connection.GetFrom()
+		}
+		// undirected connections are followed in both directions - the next doc is whichever end isn't node
+		if !connection.GetDirected() {
+			to = connection.GetTo()
+			if refString(to) == node {
+				to = connection.GetFrom()
+			}
+		}
+		if p.docProgram != nil && refString(to) != refString(p.target) {
+			var pass bool
+			if pass, fnErr = p.passes(ctx, tx, stats, to); fnErr != nil || !pass {
+				return fnErr == nil
+			}
+		}
+		weight := 1.0
+		if p.weightProgram != nil {
+			if fnErr = stats.evaluated(); fnErr != nil {
+				return false
+			}
+			weight, fnErr = p.g.vm.Connection().EvalNumber(connection, p.weightProgram)
+			if fnErr != nil {
+				if strings.Contains(fnErr.Error(), "no such key") {
+					// connections without a weight aren't traversed
+					stats.swallowed(fnErr)
+					fnErr = nil
+					return true
+				}
+				fnErr = status.Errorf(codes.InvalidArgument, "failed to evaluate weight of connection %s/%s: %s", connection.GetRef().GetGtype(), connection.GetRef().GetGid(), fnErr.Error())
+				return false
+			}
+		}
+		p.connections[refString(connection.GetRef())] = connection
+		edges = append(edges, pathfind.Edge{
+			ID:     refString(connection.GetRef()),
+			To:     refString(to),
+			Weight: weight,
+		})
+		return true
+	}
+	var err error
+	if p.reverse {
+		err = p.g.rangeTo(ctx, tx, fromRefString(node), fn)
+	} else {
+		err = p.g.rangeFrom(ctx, tx, fromRefString(node), fn)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if fnErr != nil {
+		return nil, fnErr
+	}
+	p.edges[node] = edges
+	return edges, nil
+}
+
+// passes returns whether the doc passes the doc program
+func (p *pathGraph) passes(ctx context.Context, tx *bbolt.Tx, stats *queryStats, ref *apipb.Ref) (bool, error) {
+	key := refString(ref)
+	if pass, ok := p.passed[key]; ok {
+		return pass, nil
+	}
+	doc, err := p.g.getDoc(ctx, tx, ref)
+	if err != nil {
+		if err == ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	stats.decodedDoc()
+	pass, err := p.g.evalDoc(stats, doc, p.docProgram)
+	stats.swallowed(err)
+	p.passed[key] = err == nil && pass
+	return p.passed[key], nil
+}
@@ -0,0 +1,86 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"testing"
+)
+
+// pathGids returns the gids of the docs of a path in order
+func pathGids(path *apipb.Path) []string {
+	var gids []string
+	for _, doc := range path.GetDocs() {
+		gids = append(gids, doc.GetRef().GetGid())
+	}
+	return gids
+}
+
+// createUndirectedChain creates the docs a - b - c connected by undirected connections from a to b & from b to c
+func createUndirectedChain(t *testing.T, g *Graph, ctx context.Context) {
+	var prev *apipb.Doc
+	for _, gid := range []string{"a", "b", "c"} {
+		doc := createTestDoc(t, g, ctx, "station", gid, map[string]interface{}{})
+		if prev != nil {
+			createTestConnection(t, g, ctx, "track", prev.GetRef(), doc.GetRef(), false)
+		}
+		prev = doc
+	}
+}
+
+func TestShortestPathUndirected(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	createUndirectedChain(t, g, ctx)
+	ctx = withMethod(g, ctx, "ShortestPath")
+	for _, reverse := range []bool{false, true} {
+		// undirected connections are traversed against the direction they were created in
+		paths, err := g.ShortestPath(ctx, &apipb.PathFilter{
+			Source:  &apipb.Ref{Gtype: "station", Gid: "c"},
+			Target:  &apipb.Ref{Gtype: "station", Gid: "a"},
+			Reverse: reverse,
+			// docs are connected to the user that created them
+			ConnectionExpression: "this.ref.gtype == 'track'",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(paths.GetPaths()) != 1 {
+			t.Fatalf("reverse=%v: expected a single path, got %v", reverse, paths)
+		}
+		if got := pathGids(paths.GetPaths()[0]); len(got) != 3 || got[0] != "c" || got[1] != "b" || got[2] != "a" {
+			t.Fatalf("reverse=%v: expected c -> b -> a, got %v", reverse, got)
+		}
+		if cost := paths.GetPaths()[0].GetCost(); cost != 2 {
+			t.Fatalf("reverse=%v: expected a cost of 2, got %v", reverse, cost)
+		}
+	}
+}
+
+func TestAllPathsUndirected(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	docs := map[string]*apipb.Doc{}
+	for _, gid := range []string{"a", "b", "c", "d"} {
+		docs[gid] = createTestDoc(t, g, ctx, "station", gid, map[string]interface{}{})
+	}
+	// a square of undirected connections created in both directions: a - b - d & a - c - d
+	for _, pair := range [][2]string{{"a", "b"}, {"d", "b"}, {"c", "a"}, {"c", "d"}} {
+		createTestConnection(t, g, ctx, "track", docs[pair[0]].GetRef(), docs[pair[1]].GetRef(), false)
+	}
+	paths, err := g.AllPaths(withMethod(g, ctx, "AllPaths"), &apipb.AllPathsFilter{
+		Source:               docs["d"].GetRef(),
+		Target:               docs["a"].GetRef(),
+		ConnectionExpression: "this.ref.gtype == 'track'",
+		MaxDepth:             10,
+		MaxPaths:             10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := map[string]bool{}
+	for _, path := range paths.GetPaths() {
+		found[fmt.Sprint(pathGids(path))] = true
+	}
+	if len(found) != 2 || !found["[d b a]"] || !found["[d c a]"] {
+		t.Fatalf("expected d -> b -> a & d -> c -> a, got %v", found)
+	}
+}
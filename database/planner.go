@@ -0,0 +1,189 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"github.com/graphikDB/graphik/vm"
+	"go.etcd.io/bbolt"
+	"sort"
+)
+
+// planSampleSize caps the number of keys counted when estimating the cost of an access path
+const planSampleSize = 10000
+
+// queryPlan is the access path a search reads docs/connections from. Every access path reads in gid order so a search
+// may be paginated(seek) regardless of the path that's chosen.
+type queryPlan struct {
+	// index is the EXPRESSION index the search ranges over - empty if the search doesn't range over an EXPRESSION index
+	index string
+	// values is the VALUE index range the search reads gids from
+	values *valueRange
+	// estimate is the estimated number of keys the search reads(capped at planSampleSize)
+	estimate int
+}
+
+func (p *queryPlan) String() string {
+	switch {
+	case p.values != nil:
+		return fmt.Sprintf("value index %s", p.values.index)
+	case p.index != "":
+		return fmt.Sprintf("expression index %s", p.index)
+	default:
+		return "bucket scan"
+	}
+}
+
+// planDocs picks the cheapest access path for docs of the given type that may pass the expression. A non-empty index
+// overrides the planner.
+func (g *Graph) planDocs(ctx context.Context, tx *bbolt.Tx, gtype, expression, override string) *queryPlan {
+	if override != "" {
+		plan := &queryPlan{index: override, estimate: countKeys(g.bucket(ctx, tx, dbIndexDocs).Bucket([]byte(override)))}
+		getQueryStats(ctx).planned(plan, nil)
+		return plan
+	}
+	var clauses []vm.Conjunct
+	if expression != "" {
+		// invalid expressions are reported when they're compiled
+		clauses, _ = g.vm.Doc().Conjuncts(expression)
+	}
+	return g.plan(ctx, tx, g.bucket(ctx, tx, dbDocs).Bucket([]byte(gtype)), clauses, func(i *index) bool {
+		return i.index.GetDocs() && i.index.GetGtype() == gtype
+	}, dbIndexDocs)
+}
+
+// planConnections picks the cheapest access path for connections of the given type that may pass the expression. A
+// non-empty index overrides the planner.
+func (g *Graph) planConnections(ctx context.Context, tx *bbolt.Tx, gtype, expression, override string) *queryPlan {
+	if override != "" {
+		plan := &queryPlan{index: override, estimate: countKeys(g.bucket(ctx, tx, dbIndexConnections).Bucket([]byte(override)))}
+		getQueryStats(ctx).planned(plan, nil)
+		return plan
+	}
+	var clauses []vm.Conjunct
+	if expression != "" {
+		clauses, _ = g.vm.Connection().Conjuncts(expression)
+	}
+	return g.plan(ctx, tx, g.bucket(ctx, tx, dbConnections).Bucket([]byte(gtype)), clauses, func(i *index) bool {
+		return i.index.GetConnections() && i.index.GetGtype() == gtype
+	}, dbIndexConnections)
+}
+
+// plan compares a full scan with each index that contains every doc/connection that may pass the clauses. Ties are
+// broken in favor of VALUE equality lookups, then EXPRESSION indexes, then full scans, then VALUE range scans. The scanned
+// bucket's keys are only counted if an index is a candidate(or the query is being explained).
+func (g *Graph) plan(ctx context.Context, tx *bbolt.Tx, scanned *bbolt.Bucket, clauses []vm.Conjunct, match func(i *index) bool, indexBucket []byte) *queryPlan {
+	scan := &queryPlan{}
+	stats := getQueryStats(ctx)
+	if len(clauses) == 0 || scanned == nil {
+		if stats != nil {
+			scan.estimate = countKeys(scanned)
+		}
+		stats.planned(scan, []*queryPlan{scan})
+		return scan
+	}
+	filterClauses := map[string]struct{}{}
+	for _, clause := range clauses {
+		filterClauses[clause.Expression] = struct{}{}
+	}
+	var equalities, expressions, ranges []*queryPlan
+	g.rangeIndexes(ctx, func(i *index) bool {
+		if !match(i) {
+			return true
+		}
+		// an index only contains every doc/connection that may pass the filter if the filter includes the index's expression
+		for _, clause := range i.clauses {
+			if _, ok := filterClauses[clause]; !ok {
+				return true
+			}
+		}
+		switch i.index.GetKind() {
+		case apipb.IndexKind_EXPRESSION:
+			expressions = append(expressions, &queryPlan{
+				index:    i.index.GetName(),
+				estimate: countKeys(g.bucket(ctx, tx, indexBucket).Bucket([]byte(i.index.GetName()))),
+			})
+		case apipb.IndexKind_VALUE:
+			bucket := g.valueIndexBucket(ctx, tx, i.index.GetName())
+			if bucket == nil || len(i.index.GetFields()) != 1 {
+				return true
+			}
+			values := &valueRange{index: i.index.GetName()}
+			for _, clause := range clauses {
+				if clause.Path != i.index.GetFields()[0] {
+					continue
+				}
+				value, ok := encodeConstant(clause.Value)
+				if !ok {
+					continue
+				}
+				if values.kind == 0 {
+					values.kind = value[0]
+				}
+				values.narrow(clause.Operator, value)
+			}
+			if values.kind == 0 {
+				return true
+			}
+			p := &queryPlan{values: values}
+			values.scan(bucket, func(gid []byte) bool {
+				p.estimate++
+				return p.estimate < planSampleSize
+			})
+			if values.equality() {
+				equalities = append(equalities, p)
+			} else {
+				ranges = append(ranges, p)
+			}
+		}
+		return true
+	})
+	if len(equalities) == 0 && len(expressions) == 0 && len(ranges) == 0 {
+		if stats != nil {
+			scan.estimate = countKeys(scanned)
+		}
+		stats.planned(scan, []*queryPlan{scan})
+		return scan
+	}
+	scan.estimate = countKeys(scanned)
+	var candidates []*queryPlan
+	for _, group := range [][]*queryPlan{equalities, expressions, {scan}, ranges} {
+		// indexes are cached in a map so each group is sorted by name for deterministic plans
+		sort.Slice(group, func(i, j int) bool {
+			return group[i].String() < group[j].String()
+		})
+		candidates = append(candidates, group...)
+	}
+	best := candidates[0]
+	for _, candidate := range candidates[1:] {
+		if candidate.estimate < best.estimate {
+			best = candidate
+		}
+	}
+	stats.planned(best, candidates)
+	return best
+}
+
+// countKeys counts the keys in a bucket up to planSampleSize
+func countKeys(bucket *bbolt.Bucket) int {
+	if bucket == nil {
+		return 0
+	}
+	var count int
+	c := bucket.Cursor()
+	for k, _ := c.First(); k != nil && count < planSampleSize; k, _ = c.Next() {
+		count++
+	}
+	return count
+}
+
+// gids returns the gids of every doc in the VALUE index range sorted by gid
+func (r *valueRange) gids(bucket *bbolt.Bucket) []string {
+	var gids []string
+	r.scan(bucket, func(gid []byte) bool {
+		gids = append(gids, string(gid))
+		return true
+	})
+	sort.Strings(gids)
+	return gids
+}
@@ -0,0 +1,146 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"go.etcd.io/bbolt"
+	"testing"
+)
+
+var plannerTestExpressions = []string{
+	"this.attributes.age == 30.0",
+	"this.attributes.age >= 25.0 && this.attributes.age < 40.0",
+	"this.attributes.active == true",
+	"this.attributes.active == true && this.attributes.age > 45.0",
+	"this.attributes.name == 'user-7'",
+}
+
+func createPlannerTestDocs(t *testing.T, g *Graph, ctx context.Context) {
+	for i := 0; i < 60; i++ {
+		createTestDoc(t, g, ctx, "user", fmt.Sprintf("%03d", i), map[string]interface{}{
+			"name":   fmt.Sprintf("user-%v", i),
+			"age":    float64(20 + i%40),
+			"active": i%3 == 0,
+		})
+	}
+}
+
+// searchPlannerTestDocs returns the gids of the docs that pass each of the planner test expressions
+func searchPlannerTestDocs(t *testing.T, g *Graph, ctx context.Context) map[string][]string {
+	t.Helper()
+	results := map[string][]string{}
+	for _, expression := range plannerTestExpressions {
+		docs, err := g.SearchDocs(withMethod(g, ctx, "SearchDocs"), &apipb.Filter{Gtype: "user", Expression: expression, Limit: 1000})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, doc := range docs.GetDocs() {
+			results[expression] = append(results[expression], doc.GetRef().GetGid())
+		}
+	}
+	return results
+}
+
+func assertPlannerResults(t *testing.T, expected, got map[string][]string) {
+	t.Helper()
+	for _, expression := range plannerTestExpressions {
+		if fmt.Sprint(expected[expression]) != fmt.Sprint(got[expression]) {
+			t.Fatalf("%s: expected %v, got %v", expression, expected[expression], got[expression])
+		}
+	}
+}
+
+func TestPlannerMatchesFullScan(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	createPlannerTestDocs(t, g, ctx)
+	scanned := searchPlannerTestDocs(t, g, ctx)
+	if len(scanned["this.attributes.age == 30.0"]) == 0 {
+		t.Fatal("expected the full scan to return results")
+	}
+	if _, err := g.SetIndexes(ctx, &apipb.Indexes{Indexes: []*apipb.Index{
+		{Name: "active_users", Gtype: "user", Docs: true, Expression: "this.attributes.active == true"},
+		{Name: "ages", Gtype: "user", Docs: true, Kind: apipb.IndexKind_VALUE, Fields: []string{"attributes.age"}},
+		{Name: "names", Gtype: "user", Docs: true, Kind: apipb.IndexKind_VALUE, Fields: []string{"attributes.name"}},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	explanation, err := g.Explain(withMethod(g, ctx, "Explain"), &apipb.ExplainFilter{Query: &apipb.ExplainFilter_SearchDocs{
+		SearchDocs: &apipb.Filter{Gtype: "user", Expression: "this.attributes.age == 30.0", Limit: 1000},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if explanation.GetAccessPath() != "value index ages" {
+		t.Fatalf("expected the planner to use the ages index, got %s", explanation.GetAccessPath())
+	}
+	assertPlannerResults(t, scanned, searchPlannerTestDocs(t, g, ctx))
+	// docs written after the indexes were set are indexed too
+	createTestDoc(t, g, ctx, "user", "100", map[string]interface{}{"name": "user-100", "age": 30.0, "active": true})
+	scanned["this.attributes.age == 30.0"] = append(scanned["this.attributes.age == 30.0"], "100")
+	scanned["this.attributes.active == true"] = append(scanned["this.attributes.active == true"], "100")
+	scanned["this.attributes.age >= 25.0 && this.attributes.age < 40.0"] = append(scanned["this.attributes.age >= 25.0 && this.attributes.age < 40.0"], "100")
+	assertPlannerResults(t, scanned, searchPlannerTestDocs(t, g, ctx))
+}
+
+func TestPlannerBackfillsIndexesAtStartup(t *testing.T) {
+	dir := t.TempDir()
+	g, ctx := openTestGraph(t, &apipb.Flags{StoragePath: dir})
+	createPlannerTestDocs(t, g, ctx)
+	scanned := searchPlannerTestDocs(t, g, ctx)
+	if _, err := g.SetIndexes(ctx, &apipb.Indexes{Indexes: []*apipb.Index{
+		{Name: "active_users", Gtype: "user", Docs: true, Expression: "this.attributes.active == true"},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	// an index set by an older version only contains the docs written after it was set
+	if err := g.db.Update(func(tx *bbolt.Tx) error {
+		if err := tx.Bucket(dbIndexDocs).DeleteBucket([]byte("active_users")); err != nil {
+			return err
+		}
+		if _, err := tx.Bucket(dbIndexDocs).CreateBucket([]byte("active_users")); err != nil {
+			return err
+		}
+		return tx.Bucket(dbBackfilledIndexes).Delete([]byte("active_users"))
+	}); err != nil {
+		t.Fatal(err)
+	}
+	g.Close()
+	g, ctx = openTestGraph(t, &apipb.Flags{StoragePath: dir})
+	assertPlannerResults(t, scanned, searchPlannerTestDocs(t, g, ctx))
+}
+
+func TestPlannerIgnoresOtherTypesWithTheSameGid(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	if _, err := g.SetIndexes(ctx, &apipb.Indexes{Indexes: []*apipb.Index{
+		{Name: "active_people", Gtype: "person", Docs: true, Expression: "this.attributes.active == true"},
+		{Name: "heavy_likes", Gtype: "likes", Connections: true, Expression: "this.attributes.weight > 1.0"},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	person := createTestDoc(t, g, ctx, "person", "1", map[string]interface{}{"active": true})
+	// a doc of another type that fails the expression mustn't evict the person from the index
+	dog := createTestDoc(t, g, ctx, "dog", "1", map[string]interface{}{"active": false})
+	docs, err := g.SearchDocs(withMethod(g, ctx, "SearchDocs"), &apipb.Filter{Gtype: "person", Expression: "this.attributes.active == true", Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(docs.GetDocs()) != 1 || docs.GetDocs()[0].GetRef().GetGid() != "1" {
+		t.Fatalf("expected person 1, got %v", docs.GetDocs())
+	}
+	for _, constructor := range []*apipb.ConnectionConstructor{
+		{Ref: &apipb.RefConstructor{Gtype: "likes", Gid: "1"}, Attributes: apipb.NewStruct(map[string]interface{}{"weight": 2.0}), Directed: true, From: person.GetRef(), To: dog.GetRef()},
+		{Ref: &apipb.RefConstructor{Gtype: "follows", Gid: "1"}, Attributes: apipb.NewStruct(map[string]interface{}{"weight": 0.0}), Directed: true, From: person.GetRef(), To: dog.GetRef()},
+	} {
+		if _, err := g.CreateConnection(withMethod(g, ctx, "CreateConnection"), constructor); err != nil {
+			t.Fatal(err)
+		}
+	}
+	connections, err := g.SearchConnections(withMethod(g, ctx, "SearchConnections"), &apipb.Filter{Gtype: "likes", Expression: "this.attributes.weight > 1.0", Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(connections.GetConnections()) != 1 || connections.GetConnections()[0].GetRef().GetGid() != "1" {
+		t.Fatalf("expected likes 1, got %v", connections.GetConnections())
+	}
+}
@@ -0,0 +1,192 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+// testStream is a server stream whose sends are handled by send
+type testStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	send func(msg interface{}) error
+}
+
+func (s *testStream) Context() context.Context { return s.ctx }
+
+type docStream struct{ *testStream }
+
+func (s docStream) Send(doc *apipb.Doc) error { return s.send(doc) }
+
+type connectionStream struct{ *testStream }
+
+func (s connectionStream) Send(connection *apipb.Connection) error { return s.send(connection) }
+
+type traversalStream struct{ *testStream }
+
+func (s traversalStream) Send(t *apipb.Traversal) error { return s.send(t) }
+
+// newTestStream returns a stream that records every message sent & checks that no read transaction is held open while
+// sending. The stream is cancelled once cancelAfter messages have been sent(0 never cancels).
+func newTestStream(t *testing.T, g *Graph, ctx context.Context, method string, cancelAfter int) (*testStream, *[]interface{}) {
+	ctx, cancel := context.WithCancel(withMethod(g, ctx, method))
+	t.Cleanup(cancel)
+	var sent []interface{}
+	return &testStream{
+		ctx: ctx,
+		send: func(msg interface{}) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if open := g.db.Stats().OpenTxN; open != 0 {
+				t.Fatalf("expected no open read transactions while sending, got %v", open)
+			}
+			sent = append(sent, msg)
+			if len(sent) == cancelAfter {
+				cancel()
+			}
+			return nil
+		},
+	}, &sent
+}
+
+func createStreamTestDocs(t *testing.T, g *Graph, ctx context.Context) {
+	for i := 0; i < 250; i++ {
+		createTestDoc(t, g, ctx, "note", fmt.Sprintf("%03d", i), map[string]interface{}{"even": i%2 == 0})
+	}
+}
+
+func TestStreamDocs(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	createStreamTestDocs(t, g, ctx)
+	for _, filter := range []*apipb.Filter{
+		{Gtype: "note", Limit: 1000},
+		// the limit is reached part way through a page
+		{Gtype: "note", Expression: "this.attributes.even == true", Limit: 110},
+		{Gtype: "note", Expression: "this.attributes.even == true", Limit: 110, Reverse: true},
+	} {
+		expected, err := g.SearchDocs(withMethod(g, ctx, "SearchDocs"), filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stream, sent := newTestStream(t, g, ctx, "StreamDocs", 0)
+		if err := g.StreamDocs(filter, docStream{stream}); err != nil {
+			t.Fatal(err)
+		}
+		if len(*sent) != len(expected.GetDocs()) {
+			t.Fatalf("%v: expected %v docs, got %v", filter, len(expected.GetDocs()), len(*sent))
+		}
+		for i, doc := range expected.GetDocs() {
+			if got := (*sent)[i].(*apipb.Doc).GetRef().GetGid(); got != doc.GetRef().GetGid() {
+				t.Fatalf("%v: expected doc %v to be %s, got %s", filter, i, doc.GetRef().GetGid(), got)
+			}
+		}
+	}
+}
+
+func TestStreamDocsCancelled(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	createStreamTestDocs(t, g, ctx)
+	stream, sent := newTestStream(t, g, ctx, "StreamDocs", 5)
+	if err := g.StreamDocs(&apipb.Filter{Gtype: "note", Limit: 1000}, docStream{stream}); status.Code(err) != codes.Canceled {
+		t.Fatalf("expected Canceled, got %v", err)
+	}
+	if len(*sent) != 5 {
+		t.Fatalf("expected the stream to halt after 5 docs, got %v", len(*sent))
+	}
+	// send errors keep their code
+	stream.send = func(msg interface{}) error {
+		return status.Error(codes.Unavailable, "transport is closing")
+	}
+	stream.ctx = withMethod(g, ctx, "StreamDocs")
+	if err := g.StreamDocs(&apipb.Filter{Gtype: "note", Limit: 1000}, docStream{stream}); status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected Unavailable, got %v", err)
+	}
+}
+
+func TestStreamConnections(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	a := createTestDoc(t, g, ctx, "person", "a", map[string]interface{}{})
+	b := createTestDoc(t, g, ctx, "person", "b", map[string]interface{}{})
+	for i := 0; i < 150; i++ {
+		if _, err := g.CreateConnection(withMethod(g, ctx, "CreateConnection"), &apipb.ConnectionConstructor{
+			Ref:        &apipb.RefConstructor{Gtype: "likes", Gid: fmt.Sprintf("%03d", i)},
+			Attributes: apipb.NewStruct(map[string]interface{}{"weight": float64(i % 3)}),
+			Directed:   true,
+			From:       a.GetRef(),
+			To:         b.GetRef(),
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	filter := &apipb.Filter{Gtype: "likes", Expression: "this.attributes.weight == 1.0", Limit: 45}
+	stream, sent := newTestStream(t, g, ctx, "StreamConnections", 0)
+	if err := g.StreamConnections(filter, connectionStream{stream}); err != nil {
+		t.Fatal(err)
+	}
+	if len(*sent) != 45 {
+		t.Fatalf("expected 45 connections, got %v", len(*sent))
+	}
+	seen := map[string]struct{}{}
+	for _, msg := range *sent {
+		connection := msg.(*apipb.Connection)
+		if connection.GetAttributes().GetFields()["weight"].GetNumberValue() != 1 {
+			t.Fatalf("expected only connections that pass the expression, got %v", connection)
+		}
+		if _, ok := seen[connection.GetRef().GetGid()]; ok {
+			t.Fatalf("connection %s was sent twice", connection.GetRef().GetGid())
+		}
+		seen[connection.GetRef().GetGid()] = struct{}{}
+	}
+	stream, sent = newTestStream(t, g, ctx, "StreamConnections", 10)
+	if err := g.StreamConnections(&apipb.Filter{Gtype: "likes", Limit: 1000}, connectionStream{stream}); status.Code(err) != codes.Canceled {
+		t.Fatalf("expected Canceled, got %v", err)
+	}
+	if len(*sent) != 10 {
+		t.Fatalf("expected the stream to halt after 10 connections, got %v", len(*sent))
+	}
+}
+
+func TestStreamTraverse(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	var prev *apipb.Doc
+	for i := 0; i < 10; i++ {
+		doc := createTestDoc(t, g, ctx, "task", fmt.Sprint(i), map[string]interface{}{"even": i%2 == 0})
+		if prev != nil {
+			createTestConnection(t, g, ctx, "blocks", prev.GetRef(), doc.GetRef(), true)
+		}
+		prev = doc
+	}
+	filter := &apipb.TraverseFilter{
+		Root:                 &apipb.Ref{Gtype: "task", Gid: "0"},
+		DocExpression:        "this.attributes.even == true",
+		ConnectionExpression: "this.ref.gtype == 'blocks'",
+		Limit:                3,
+		MaxDepth:             100,
+		MaxHops:              100,
+	}
+	stream, sent := newTestStream(t, g, ctx, "StreamTraverse", 0)
+	if err := g.StreamTraverse(filter, traversalStream{stream}); err != nil {
+		t.Fatal(err)
+	}
+	var gids []string
+	for _, msg := range *sent {
+		gids = append(gids, msg.(*apipb.Traversal).GetDoc().GetRef().GetGid())
+	}
+	if fmt.Sprint(gids) != "[0 2 4]" {
+		t.Fatalf("expected [0 2 4], got %v", gids)
+	}
+	filter.Limit = 100
+	stream, sent = newTestStream(t, g, ctx, "StreamTraverse", 2)
+	if err := g.StreamTraverse(filter, traversalStream{stream}); status.Code(err) != codes.Canceled {
+		t.Fatalf("expected Canceled, got %v", err)
+	}
+	if len(*sent) != 2 {
+		t.Fatalf("expected the stream to halt after 2 traversals, got %v", len(*sent))
+	}
+}
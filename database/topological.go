@@ -0,0 +1,180 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/cel-go/cel"
+	"github.com/graphikDB/graphik/analytics"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// TopologicalSort orders the docs joined by directed connections of the filter's type so that every doc comes before the
+// docs it's connected to. Undirected connections are ignored. If the connections contain a cycle, no order is returned -
+// the cycles that prevent one are returned instead.
+func (g *Graph) TopologicalSort(ctx context.Context, filter *apipb.TopologicalSortFilter) (*apipb.TopologicalOrder, error) {
+	var (
+		program cel.Program
+		err     error
+	)
+	if filter.GetExpression() != "" {
+		program, err = g.vm.Connection().Program(filter.GetExpression())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	maxCycles := int(filter.GetMaxCycles())
+	if maxCycles == 0 {
+		maxCycles = 10
+	}
+	ctx, finish := g.startQuery(ctx, filter, filter.GetBudget(), false)
+	order, err := g.topologicalSort(ctx, filter, program, maxCycles)
+	if err := finish(len(order.GetRefs())+len(order.GetCycles()), "", err); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return order, nil
+}
+
+func (g *Graph) topologicalSort(ctx context.Context, filter *apipb.TopologicalSortFilter, program cel.Program, maxCycles int) (*apipb.TopologicalOrder, error) {
+	var (
+		stats = getQueryStats(ctx)
+		graph = analytics.NewGraph()
+		// edges are the first connection between each pair of docs - they're used to build the path of each cycle
+		edges = map[[2]int]*apipb.Connection{}
+	)
+	if _, err := g.rangeSeekConnections(ctx, filter.GetGtype(), filter.GetExpression(), "", "", false, func(connection *apipb.Connection) bool {
+		if !connection.GetDirected() {
+			return true
+		}
+		if program != nil {
+			pass, err := g.evalConnection(stats, connection, program)
+			stats.swallowed(err)
+			if err != nil || !pass {
+				return true
+			}
+		}
+		from, to := graph.AddNode(refString(connection.GetFrom())), graph.AddNode(refString(connection.GetTo()))
+		graph.AddEdge(refString(connection.GetFrom()), refString(connection.GetTo()))
+		if _, ok := edges[[2]int{from, to}]; !ok {
+			edges[[2]int{from, to}] = connection
+		}
+		return true
+	}); err != nil && err != ErrNotFound {
+		return nil, err
+	}
+	if err := stats.err(); err != nil {
+		return nil, err
+	}
+	order, cycles, err := analytics.TopologicalSort(ctx, graph, maxCycles)
+	if err != nil {
+		return nil, err
+	}
+	nodes := graph.Nodes()
+	if len(cycles) == 0 {
+		sorted := &apipb.TopologicalOrder{Acyclic: true}
+		for _, i := range order {
+			sorted.Refs = append(sorted.Refs, fromRefString(nodes[i]))
+		}
+		return sorted, nil
+	}
+	sorted := &apipb.TopologicalOrder{}
+	if err := g.db.View(func(tx *bbolt.Tx) error {
+		for _, loop := range cycles {
+			path := &apipb.Path{}
+			for i, node := range loop {
+				ref := fromRefString(nodes[node])
+				doc, err := g.getDoc(ctx, tx, ref)
+				if err != nil {
+					if err != ErrNotFound {
+						return err
+					}
+					doc = &apipb.Doc{Ref: ref}
+				}
+				stats.decodedDoc()
+				path.Docs = append(path.Docs, doc)
+				if i > 0 {
+					path.Connections = append(path.Connections, edges[[2]int{loop[i-1], node}])
+				}
+			}
+			path.Cost = float64(len(path.GetConnections()))
+			sorted.Cycles = append(sorted.Cycles, path)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return sorted, nil
+}
+
+// cycle is a list of docs that leads back to the doc it starts from
+type cycle []*apipb.Ref
+
+func (c cycle) String() string {
+	var refs []string
+	for _, ref := range c {
+		refs = append(refs, fmt.Sprintf("%s/%s", ref.GetGtype(), ref.GetGid()))
+	}
+	return strings.Join(refs, " -> ")
+}
+
+// findCycle returns the cycle the connection would create among the directed connections of it's type(the connection's
+// from doc, then the shortest path from it's to doc back to it's from doc) or nil if it wouldn't create one. The
+// connection itself is ignored if it already exists.
+func (g *Graph) findCycle(ctx context.Context, tx *bbolt.Tx, connection *apipb.Connection) (cycle, error) {
+	var (
+		from   = refString(connection.GetFrom())
+		to     = refString(connection.GetTo())
+		self   = refString(connection.GetRef())
+		gtypes = map[string]struct{}{connection.GetRef().GetGtype(): {}}
+	)
+	if from == to {
+		return cycle{connection.GetFrom(), connection.GetTo()}, nil
+	}
+	parents := map[string]*apipb.Ref{to: nil}
+	queue := []*apipb.Ref{connection.GetTo()}
+	for head := 0; head < len(queue); head++ {
+		var (
+			current = queue[head]
+			found   *apipb.Ref
+		)
+		if err := g.rangeTypes(ctx, tx, current, apipb.Direction_OUT, gtypes, func(e *apipb.Connection) bool {
+			// undirected connections are in the adjacency of both of their docs
+			if !e.GetDirected() || refString(e.GetRef()) == self || refString(e.GetFrom()) != refString(current) {
+				return true
+			}
+			next := refString(e.GetTo())
+			if _, ok := parents[next]; ok {
+				return true
+			}
+			parents[next] = current
+			if next == from {
+				found = e.GetTo()
+				return false
+			}
+			queue = append(queue, e.GetTo())
+			return true
+		}); err != nil {
+			return nil, err
+		}
+		if found == nil {
+			continue
+		}
+		var path cycle
+		for ref := found; ref != nil; ref = parents[refString(ref)] {
+			path = append(path, ref)
+		}
+		path = append(path, connection.GetFrom())
+		// the path was built backwards
+		for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+			path[i], path[j] = path[j], path[i]
+		}
+		return path, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,124 @@
+package database
+
+import (
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"testing"
+)
+
+func TestTopologicalSortOrder(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	docs := map[string]*apipb.Doc{}
+	for _, gid := range []string{"a", "b", "c", "d"} {
+		docs[gid] = createTestDoc(t, g, ctx, "task", gid, map[string]interface{}{})
+	}
+	for _, pair := range [][2]string{{"a", "b"}, {"a", "c"}, {"c", "d"}} {
+		createTestConnection(t, g, ctx, "depends_on", docs[pair[0]].GetRef(), docs[pair[1]].GetRef(), true)
+	}
+	// undirected connections aren't part of the order, so d - b doesn't create a cycle
+	createTestConnection(t, g, ctx, "depends_on", docs["d"].GetRef(), docs["b"].GetRef(), false)
+	createTestConnection(t, g, ctx, "depends_on", docs["b"].GetRef(), docs["d"].GetRef(), false)
+	order, err := g.TopologicalSort(withMethod(g, ctx, "TopologicalSort"), &apipb.TopologicalSortFilter{Gtype: "depends_on"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !order.GetAcyclic() || len(order.GetCycles()) != 0 {
+		t.Fatalf("expected an acyclic order, got %v", order)
+	}
+	positions := map[string]int{}
+	for i, ref := range order.GetRefs() {
+		positions[ref.GetGid()] = i
+	}
+	if len(positions) != 4 {
+		t.Fatalf("expected 4 docs in the order, got %v", order.GetRefs())
+	}
+	for _, pair := range [][2]string{{"a", "b"}, {"a", "c"}, {"c", "d"}} {
+		if positions[pair[0]] > positions[pair[1]] {
+			t.Fatalf("expected %s to precede %s, got %v", pair[0], pair[1], order.GetRefs())
+		}
+	}
+}
+
+func TestTopologicalSortCycle(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	docs := map[string]*apipb.Doc{}
+	for _, gid := range []string{"x", "y", "z"} {
+		docs[gid] = createTestDoc(t, g, ctx, "task", gid, map[string]interface{}{"name": gid})
+	}
+	for _, pair := range [][2]string{{"x", "y"}, {"y", "z"}, {"z", "x"}} {
+		createTestConnection(t, g, ctx, "depends_on", docs[pair[0]].GetRef(), docs[pair[1]].GetRef(), true)
+	}
+	order, err := g.TopologicalSort(withMethod(g, ctx, "TopologicalSort"), &apipb.TopologicalSortFilter{Gtype: "depends_on"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order.GetAcyclic() || len(order.GetCycles()) == 0 || len(order.GetRefs()) != 0 {
+		t.Fatalf("expected a cycle, got %v", order)
+	}
+	path := order.GetCycles()[0]
+	if len(path.GetDocs()) != 4 || len(path.GetConnections()) != 3 || path.GetCost() != 3 {
+		t.Fatalf("expected a path of 4 docs & 3 connections, got %v", path)
+	}
+	first, last := path.GetDocs()[0], path.GetDocs()[len(path.GetDocs())-1]
+	if first.GetRef().GetGid() != last.GetRef().GetGid() {
+		t.Fatalf("expected the cycle to lead back to %s, got %s", first.GetRef().GetGid(), last.GetRef().GetGid())
+	}
+	// the path's docs are fetched, not just referenced
+	if first.GetAttributes().GetFields()["name"].GetStringValue() != first.GetRef().GetGid() {
+		t.Fatalf("expected the cycle's docs to have their attributes, got %v", first)
+	}
+	for i, connection := range path.GetConnections() {
+		if connection.GetFrom().GetGid() != path.GetDocs()[i].GetRef().GetGid() || connection.GetTo().GetGid() != path.GetDocs()[i+1].GetRef().GetGid() {
+			t.Fatalf("expected connection %v to lead from doc %v to doc %v, got %v", i, i, i+1, connection)
+		}
+	}
+}
+
+func TestAcyclicValidator(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	if _, err := g.SetTypeValidators(withMethod(g, ctx, "SetTypeValidators"), &apipb.TypeValidators{
+		Validators: []*apipb.TypeValidator{{Name: "no_cycles", Gtype: "depends_on", Connections: true, Acyclic: true}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	docs := map[string]*apipb.Doc{}
+	for _, gid := range []string{"a", "b", "c"} {
+		docs[gid] = createTestDoc(t, g, ctx, "task", gid, map[string]interface{}{})
+	}
+	createTestConnection(t, g, ctx, "depends_on", docs["a"].GetRef(), docs["b"].GetRef(), true)
+	createTestConnection(t, g, ctx, "depends_on", docs["b"].GetRef(), docs["c"].GetRef(), true)
+	for _, test := range []struct {
+		name     string
+		from, to string
+		cycle    string
+	}{
+		{"cycle", "c", "a", "task/c -> task/a -> task/b -> task/c"},
+		{"self-loop", "a", "a", "task/a -> task/a"},
+	} {
+		_, err := g.CreateConnection(withMethod(g, ctx, "CreateConnection"), &apipb.ConnectionConstructor{
+			Ref:        &apipb.RefConstructor{Gtype: "depends_on"},
+			Attributes: apipb.NewStruct(map[string]interface{}{}),
+			Directed:   true,
+			From:       docs[test.from].GetRef(),
+			To:         docs[test.to].GetRef(),
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("%s: expected InvalidArgument, got %v", test.name, err)
+		}
+		if !strings.Contains(err.Error(), test.cycle) {
+			t.Fatalf("%s: expected the error to contain the cycle %s, got %v", test.name, test.cycle, err)
+		}
+	}
+	// undirected connections & other types of connections can't create a cycle
+	createTestConnection(t, g, ctx, "depends_on", docs["c"].GetRef(), docs["a"].GetRef(), false)
+	createTestConnection(t, g, ctx, "follows", docs["c"].GetRef(), docs["a"].GetRef(), true)
+	order, err := g.TopologicalSort(withMethod(g, ctx, "TopologicalSort"), &apipb.TopologicalSortFilter{Gtype: "depends_on"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !order.GetAcyclic() {
+		t.Fatalf("expected the rejected connections not to be written, got %v", order.GetCycles())
+	}
+}
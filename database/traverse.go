@@ -9,7 +9,6 @@ import (
 	"go.etcd.io/bbolt"
 	"go.uber.org/zap"
 	"strings"
-	"time"
 )
 
 // traversal implements stateful depth-first graph traversal.
@@ -18,22 +17,29 @@ type traversal struct {
 	stack             *generic.Stack
 	queue             *generic.Queue
 	visited           map[string]struct{}
-	traversals        *apipb.Traversals
 	filter            *apipb.TraverseFilter
 	traversalPath     []*apipb.Ref
 	connectionProgram *cel.Program
 	docProgram        *cel.Program
+	// emit is called with each traversal as it's found
+	emit func(t *apipb.Traversal) error
+	// count is the number of traversals emitted
+	count int
+	// err is the first error returned by emit - it halts the traversal
+	err error
+	// stats records the work done by the traversal if it's being explained
+	stats *queryStats
 }
 
-func (g *Graph) newTraversal(filter *apipb.TraverseFilter) (*traversal, error) {
+func (g *Graph) newTraversal(filter *apipb.TraverseFilter, emit func(t *apipb.Traversal) error) (*traversal, error) {
 	t := &traversal{
 		g:             g,
 		filter:        filter,
 		stack:         generic.NewStack(),
 		queue:         generic.NewQueue(),
 		visited:       map[string]struct{}{},
-		traversals:    &apipb.Traversals{},
 		traversalPath: []*apipb.Ref{},
+		emit:          emit,
 	}
 	if filter.GetConnectionExpression() != "" {
 		program, err := g.vm.Connection().Program(filter.GetConnectionExpression())
@@ -52,16 +58,52 @@ func (g *Graph) newTraversal(filter *apipb.TraverseFilter) (*traversal, error) {
 	return t, nil
 }
 
+// Walk executes the traversal. It's bound by the query budget of the context(see startQuery)
 func (d *traversal) Walk(ctx context.Context, tx *bbolt.Tx) error {
-	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
-	defer cancel()
+	d.stats = getQueryStats(ctx)
+	defer d.stats.phase("walk")()
+	var err error
 	switch d.filter.GetAlgorithm() {
 	case apipb.Algorithm_DFS:
-		return d.walkDFS(ctx, tx)
+		err = d.walkDFS(ctx, tx)
 	case apipb.Algorithm_BFS:
-		return d.walkBFS(ctx, tx)
+		err = d.walkBFS(ctx, tx)
+	default:
+		return ErrUnsupportedAlgorithm
 	}
-	return ErrUnsupportedAlgorithm
+	if err != nil {
+		return err
+	}
+	if err := d.stats.err(); err != nil {
+		return err
+	}
+	return d.err
+}
+
+// add emits a traversal. It returns false if the traversal should halt
+func (d *traversal) add(t *apipb.Traversal) bool {
+	if d.err != nil {
+		return false
+	}
+	if err := d.emit(t); err != nil {
+		d.err = err
+		return false
+	}
+	d.count++
+	return true
+}
+
+// direction returns the direction connections are followed in - reverse is a legacy alias of IN
+func (d *traversal) direction() apipb.Direction {
+	if d.filter.GetDirection() == apipb.Direction_OUT && d.filter.GetReverse() {
+		return apipb.Direction_IN
+	}
+	return d.filter.GetDirection()
+}
+
+// more returns true if the traversal hasn't reached its limit or been halted
+func (d *traversal) more() bool {
+	return d.err == nil && d.stats.err() == nil && d.count < int(d.filter.GetLimit())
 }
 
 func (d *traversal) walkDFS(ctx context.Context, tx *bbolt.Tx) error {
@@ -69,21 +111,22 @@ func (d *traversal) walkDFS(ctx context.Context, tx *bbolt.Tx) error {
 	if err != nil {
 		return err
 	}
+	d.stats.decodedDoc()
 	d.stack.Push(doc)
 	if d.docProgram == nil {
-		d.traversals.Traversals = append(d.traversals.Traversals, &apipb.Traversal{
+		d.add(&apipb.Traversal{
 			Doc:           doc,
 			TraversalPath: d.traversalPath,
 			Depth:         uint64(len(d.traversalPath)),
 			Hops:          uint64(len(d.visited)),
 		})
 	} else {
-		res, err := d.g.vm.Doc().Eval(doc, *d.docProgram)
+		res, err := d.g.evalDoc(d.stats, doc, *d.docProgram)
 		if err != nil {
 			return err
 		}
 		if res {
-			d.traversals.Traversals = append(d.traversals.Traversals, &apipb.Traversal{
+			d.add(&apipb.Traversal{
 				Doc:           doc,
 				TraversalPath: d.traversalPath,
 				Depth:         uint64(len(d.traversalPath)),
@@ -92,18 +135,20 @@ func (d *traversal) walkDFS(ctx context.Context, tx *bbolt.Tx) error {
 		}
 	}
 	d.visited[d.filter.Root.String()] = struct{}{}
-	for d.stack.Len() > 0 && len(d.traversals.GetTraversals()) < int(d.filter.Limit) && len(d.visited) <= int(d.filter.MaxHops) {
+	for d.stack.Len() > 0 && d.more() && len(d.visited) <= int(d.filter.MaxHops) {
 		if err := ctx.Err(); err != nil {
-			return nil
+			return err
 		}
 		popped := d.stack.Pop().(*apipb.Doc)
 		d.traversalPath = append(d.traversalPath, popped.GetRef())
-		if d.filter.GetReverse() {
-			if err := d.dfsTo(ctx, tx, popped, d.connectionProgram, d.docProgram); err != nil {
+		direction := d.direction()
+		if direction != apipb.Direction_IN {
+			if err := d.dfsFrom(ctx, tx, popped, d.connectionProgram, d.docProgram); err != nil {
 				return err
 			}
-		} else {
-			if err := d.dfsFrom(ctx, tx, popped, d.connectionProgram, d.docProgram); err != nil {
+		}
+		if direction != apipb.Direction_OUT && d.more() {
+			if err := d.dfsTo(ctx, tx, popped, d.connectionProgram, d.docProgram); err != nil {
 				return err
 			}
 		}
@@ -113,9 +158,14 @@ func (d *traversal) walkDFS(ctx context.Context, tx *bbolt.Tx) error {
 
 func (d *traversal) dfsFrom(ctx context.Context, tx *bbolt.Tx, popped *apipb.Doc, connectionProgram, docProgram *cel.Program) error {
 	if err := d.g.rangeFrom(ctx, tx, popped.GetRef(), func(e *apipb.Connection) bool {
+		if d.stats.scanned() != nil {
+			return false
+		}
+		d.stats.decodedConnection()
 		if connectionProgram != nil {
-			res, err := d.g.vm.Connection().Eval(e, *connectionProgram)
+			res, err := d.g.evalConnection(d.stats, e, *connectionProgram)
 			if err != nil {
+				d.stats.swallowed(err)
 				if !strings.Contains(err.Error(), "no such key") {
 					logger.Error("dfs failure", zap.Error(err))
 				}
@@ -128,6 +178,7 @@ func (d *traversal) dfsFrom(ctx context.Context, tx *bbolt.Tx, popped *apipb.Doc
 		if _, ok := d.visited[e.GetTo().String()]; !ok {
 			to, err := d.g.getDoc(ctx, tx, e.GetTo())
 			if err != nil {
+				d.stats.swallowed(err)
 				if err == ErrNotFound {
 					logger.Error("dfs getDoc failure(to)", zap.Error(err), zap.String("path", refString(e.GetTo())))
 					return true
@@ -135,9 +186,10 @@ func (d *traversal) dfsFrom(ctx context.Context, tx *bbolt.Tx, popped *apipb.Doc
 				logger.Error("dfs getDoc failure(to)", zap.Error(err))
 				return true
 			}
+			d.stats.decodedDoc()
 			if docProgram == nil {
 				if len(d.traversalPath) <= int(d.filter.MaxDepth) && len(d.visited) <= int(d.filter.MaxHops) {
-					d.traversals.Traversals = append(d.traversals.Traversals, &apipb.Traversal{
+					d.add(&apipb.Traversal{
 						Doc:           to,
 						TraversalPath: d.traversalPath,
 						Depth:         uint64(len(d.traversalPath)),
@@ -145,8 +197,9 @@ func (d *traversal) dfsFrom(ctx context.Context, tx *bbolt.Tx, popped *apipb.Doc
 					})
 				}
 			} else {
-				res, err := d.g.vm.Doc().Eval(to, *docProgram)
+				res, err := d.g.evalDoc(d.stats, to, *docProgram)
 				if err != nil {
+					d.stats.swallowed(err)
 					if !strings.Contains(err.Error(), "no such key") {
 						logger.Error("dfs failure", zap.Error(err))
 					}
@@ -154,7 +207,7 @@ func (d *traversal) dfsFrom(ctx context.Context, tx *bbolt.Tx, popped *apipb.Doc
 				}
 				if res {
 					if len(d.traversalPath) <= int(d.filter.MaxDepth) && len(d.visited) <= int(d.filter.MaxHops) {
-						d.traversals.Traversals = append(d.traversals.Traversals, &apipb.Traversal{
+						d.add(&apipb.Traversal{
 							Doc:           to,
 							TraversalPath: d.traversalPath,
 							Depth:         uint64(len(d.traversalPath)),
@@ -166,7 +219,7 @@ func (d *traversal) dfsFrom(ctx context.Context, tx *bbolt.Tx, popped *apipb.Doc
 			d.visited[to.Ref.String()] = struct{}{}
 			d.stack.Push(to)
 		}
-		return len(d.traversals.GetTraversals()) < int(d.filter.Limit)
+		return d.more()
 	}); err != nil {
 		return err
 	}
@@ -175,9 +228,14 @@ func (d *traversal) dfsFrom(ctx context.Context, tx *bbolt.Tx, popped *apipb.Doc
 
 func (d *traversal) dfsTo(ctx context.Context, tx *bbolt.Tx, popped *apipb.Doc, connectionProgram, docProgram *cel.Program) error {
 	if err := d.g.rangeTo(ctx, tx, popped.GetRef(), func(e *apipb.Connection) bool {
+		if d.stats.scanned() != nil {
+			return false
+		}
+		d.stats.decodedConnection()
 		if connectionProgram != nil {
-			res, err := d.g.vm.Connection().Eval(e, *connectionProgram)
+			res, err := d.g.evalConnection(d.stats, e, *connectionProgram)
 			if err != nil {
+				d.stats.swallowed(err)
 				if !strings.Contains(err.Error(), "no such key") {
 					logger.Error("dfs failure", zap.Error(err))
 				}
@@ -190,6 +248,7 @@ func (d *traversal) dfsTo(ctx context.Context, tx *bbolt.Tx, popped *apipb.Doc,
 		if _, ok := d.visited[e.GetFrom().String()]; !ok {
 			from, err := d.g.getDoc(ctx, tx, e.GetFrom())
 			if err != nil {
+				d.stats.swallowed(err)
 				if err == ErrNotFound {
 					logger.Error("dfs getDoc failure(from)", zap.Error(err), zap.String("path", refString(e.GetFrom())))
 					return true
@@ -197,9 +256,10 @@ func (d *traversal) dfsTo(ctx context.Context, tx *bbolt.Tx, popped *apipb.Doc,
 				logger.Error("dfs getDoc failure(from)", zap.Error(err))
 				return true
 			}
+			d.stats.decodedDoc()
 			if docProgram == nil {
 				if len(d.traversalPath) <= int(d.filter.MaxDepth) && len(d.visited) <= int(d.filter.MaxHops) {
-					d.traversals.Traversals = append(d.traversals.Traversals, &apipb.Traversal{
+					d.add(&apipb.Traversal{
 						Doc:           from,
 						TraversalPath: d.traversalPath,
 						Depth:         uint64(len(d.traversalPath)),
@@ -207,8 +267,9 @@ func (d *traversal) dfsTo(ctx context.Context, tx *bbolt.Tx, popped *apipb.Doc,
 					})
 				}
 			} else {
-				res, err := d.g.vm.Doc().Eval(from, *docProgram)
+				res, err := d.g.evalDoc(d.stats, from, *docProgram)
 				if err != nil {
+					d.stats.swallowed(err)
 					if !strings.Contains(err.Error(), "no such key") {
 						logger.Error("dfs failure", zap.Error(err))
 					}
@@ -216,7 +277,7 @@ func (d *traversal) dfsTo(ctx context.Context, tx *bbolt.Tx, popped *apipb.Doc,
 				}
 				if res {
 					if len(d.traversalPath) <= int(d.filter.MaxDepth) && len(d.visited) <= int(d.filter.MaxHops) {
-						d.traversals.Traversals = append(d.traversals.Traversals, &apipb.Traversal{
+						d.add(&apipb.Traversal{
 							Doc:           from,
 							TraversalPath: d.traversalPath,
 							Depth:         uint64(len(d.traversalPath)),
@@ -228,7 +289,7 @@ func (d *traversal) dfsTo(ctx context.Context, tx *bbolt.Tx, popped *apipb.Doc,
 			d.visited[from.Ref.String()] = struct{}{}
 			d.stack.Push(from)
 		}
-		return len(d.traversals.GetTraversals()) < int(d.filter.Limit)
+		return d.more()
 	}); err != nil {
 		return err
 	}
@@ -240,10 +301,11 @@ func (d *traversal) walkBFS(ctx context.Context, tx *bbolt.Tx) error {
 	if err != nil {
 		return err
 	}
+	d.stats.decodedDoc()
 	d.queue.Enqueue(doc)
 	if d.docProgram == nil {
 		if len(d.traversalPath) <= int(d.filter.MaxDepth) && len(d.visited) <= int(d.filter.MaxHops) {
-			d.traversals.Traversals = append(d.traversals.Traversals, &apipb.Traversal{
+			d.add(&apipb.Traversal{
 				Doc:           doc,
 				TraversalPath: d.traversalPath,
 				Depth:         uint64(len(d.traversalPath)),
@@ -251,13 +313,13 @@ func (d *traversal) walkBFS(ctx context.Context, tx *bbolt.Tx) error {
 			})
 		}
 	} else {
-		res, err := d.g.vm.Doc().Eval(doc, *d.docProgram)
+		res, err := d.g.evalDoc(d.stats, doc, *d.docProgram)
 		if err != nil {
 			return err
 		}
 		if res {
 			if len(d.traversalPath) <= int(d.filter.MaxDepth) && len(d.visited) <= int(d.filter.MaxHops) {
-				d.traversals.Traversals = append(d.traversals.Traversals, &apipb.Traversal{
+				d.add(&apipb.Traversal{
 					Doc:           doc,
 					TraversalPath: d.traversalPath,
 					Depth:         uint64(len(d.traversalPath)),
@@ -267,18 +329,20 @@ func (d *traversal) walkBFS(ctx context.Context, tx *bbolt.Tx) error {
 		}
 	}
 	d.visited[d.filter.Root.String()] = struct{}{}
-	for d.queue.Len() > 0 && len(d.traversals.GetTraversals()) < int(d.filter.Limit) && len(d.visited) <= int(d.filter.MaxHops) {
+	for d.queue.Len() > 0 && d.more() && len(d.visited) <= int(d.filter.MaxHops) {
 		if err := ctx.Err(); err != nil {
-			return nil
+			return err
 		}
 		dequeued := d.queue.Dequeue().(*apipb.Doc)
 		d.traversalPath = append(d.traversalPath, dequeued.GetRef())
-		if d.filter.GetReverse() {
-			if err := d.bfsTo(ctx, tx, dequeued, d.connectionProgram, d.docProgram); err != nil {
+		direction := d.direction()
+		if direction != apipb.Direction_IN {
+			if err := d.bfsFrom(ctx, tx, dequeued, d.connectionProgram, d.docProgram); err != nil {
 				return err
 			}
-		} else {
-			if err := d.bfsFrom(ctx, tx, dequeued, d.connectionProgram, d.docProgram); err != nil {
+		}
+		if direction != apipb.Direction_OUT && d.more() {
+			if err := d.bfsTo(ctx, tx, dequeued, d.connectionProgram, d.docProgram); err != nil {
 				return err
 			}
 		}
@@ -288,9 +352,14 @@ func (d *traversal) walkBFS(ctx context.Context, tx *bbolt.Tx) error {
 
 func (d *traversal) bfsTo(ctx context.Context, tx *bbolt.Tx, dequeued *apipb.Doc, connectionProgram, docProgram *cel.Program) error {
 	if err := d.g.rangeTo(ctx, tx, dequeued.GetRef(), func(e *apipb.Connection) bool {
+		if d.stats.scanned() != nil {
+			return false
+		}
+		d.stats.decodedConnection()
 		if connectionProgram != nil {
-			res, err := d.g.vm.Connection().Eval(e, *connectionProgram)
+			res, err := d.g.evalConnection(d.stats, e, *connectionProgram)
 			if err != nil {
+				d.stats.swallowed(err)
 				if !strings.Contains(err.Error(), "no such key") {
 					logger.Error("bfs connection failure(to)", zap.Error(err))
 				}
@@ -303,6 +372,7 @@ func (d *traversal) bfsTo(ctx context.Context, tx *bbolt.Tx, dequeued *apipb.Doc
 		if _, ok := d.visited[e.GetFrom().String()]; !ok {
 			from, err := d.g.getDoc(ctx, tx, e.GetFrom())
 			if err != nil {
+				d.stats.swallowed(err)
 				if err == ErrNotFound {
 					logger.Error("bfs getDoc failure(from)", zap.Error(err), zap.String("path", refString(e.GetFrom())))
 					return true
@@ -310,9 +380,10 @@ func (d *traversal) bfsTo(ctx context.Context, tx *bbolt.Tx, dequeued *apipb.Doc
 				logger.Error("bfs getDoc failure(from)", zap.Error(err))
 				return true
 			}
+			d.stats.decodedDoc()
 			if docProgram == nil {
 				if len(d.traversalPath) <= int(d.filter.MaxDepth) && len(d.visited) <= int(d.filter.MaxHops) {
-					d.traversals.Traversals = append(d.traversals.Traversals, &apipb.Traversal{
+					d.add(&apipb.Traversal{
 						Doc:           from,
 						TraversalPath: d.traversalPath,
 						Depth:         uint64(len(d.traversalPath)),
@@ -320,8 +391,9 @@ func (d *traversal) bfsTo(ctx context.Context, tx *bbolt.Tx, dequeued *apipb.Doc
 					})
 				}
 			} else {
-				res, err := d.g.vm.Doc().Eval(from, *docProgram)
+				res, err := d.g.evalDoc(d.stats, from, *docProgram)
 				if err != nil {
+					d.stats.swallowed(err)
 					if !strings.Contains(err.Error(), "no such key") {
 						logger.Error("bfs failure", zap.Error(err))
 					}
@@ -329,7 +401,7 @@ func (d *traversal) bfsTo(ctx context.Context, tx *bbolt.Tx, dequeued *apipb.Doc
 				}
 				if res {
 					if len(d.traversalPath) <= int(d.filter.MaxDepth) && len(d.visited) <= int(d.filter.MaxHops) {
-						d.traversals.Traversals = append(d.traversals.Traversals, &apipb.Traversal{
+						d.add(&apipb.Traversal{
 							Doc:           from,
 							TraversalPath: d.traversalPath,
 							Depth:         uint64(len(d.traversalPath)),
@@ -341,7 +413,7 @@ func (d *traversal) bfsTo(ctx context.Context, tx *bbolt.Tx, dequeued *apipb.Doc
 			d.visited[from.Ref.String()] = struct{}{}
 			d.queue.Enqueue(from)
 		}
-		return len(d.traversals.GetTraversals()) < int(d.filter.Limit)
+		return d.more()
 	}); err != nil {
 		return err
 	}
@@ -350,10 +422,14 @@ func (d *traversal) bfsTo(ctx context.Context, tx *bbolt.Tx, dequeued *apipb.Doc
 
 func (d *traversal) bfsFrom(ctx context.Context, tx *bbolt.Tx, dequeue *apipb.Doc, connectionProgram, docProgram *cel.Program) error {
 	if err := d.g.rangeFrom(ctx, tx, dequeue.GetRef(), func(e *apipb.Connection) bool {
-
+		if d.stats.scanned() != nil {
+			return false
+		}
+		d.stats.decodedConnection()
 		if connectionProgram != nil {
-			res, err := d.g.vm.Connection().Eval(e, *connectionProgram)
+			res, err := d.g.evalConnection(d.stats, e, *connectionProgram)
 			if err != nil {
+				d.stats.swallowed(err)
 				if !strings.Contains(err.Error(), "no such key") {
 					logger.Error("bfs failure", zap.Error(err))
 				}
@@ -366,6 +442,7 @@ func (d *traversal) bfsFrom(ctx context.Context, tx *bbolt.Tx, dequeue *apipb.Do
 		if _, ok := d.visited[e.To.String()]; !ok {
 			to, err := d.g.getDoc(ctx, tx, e.GetTo())
 			if err != nil {
+				d.stats.swallowed(err)
 				if err == ErrNotFound {
 					logger.Error("bfs getDoc failure(to)", zap.Error(err), zap.String("path", refString(e.GetTo())))
 					return true
@@ -373,9 +450,10 @@ func (d *traversal) bfsFrom(ctx context.Context, tx *bbolt.Tx, dequeue *apipb.Do
 				logger.Error("bfs getDoc failure(to)", zap.Error(err))
 				return true
 			}
+			d.stats.decodedDoc()
 			if docProgram == nil {
 				if len(d.traversalPath) <= int(d.filter.MaxDepth) && len(d.visited) <= int(d.filter.MaxHops) {
-					d.traversals.Traversals = append(d.traversals.Traversals, &apipb.Traversal{
+					d.add(&apipb.Traversal{
 						Doc:           to,
 						TraversalPath: d.traversalPath,
 						Depth:         uint64(len(d.traversalPath)),
@@ -383,8 +461,9 @@ func (d *traversal) bfsFrom(ctx context.Context, tx *bbolt.Tx, dequeue *apipb.Do
 					})
 				}
 			} else {
-				res, err := d.g.vm.Doc().Eval(to, *docProgram)
+				res, err := d.g.evalDoc(d.stats, to, *docProgram)
 				if err != nil {
+					d.stats.swallowed(err)
 					if !strings.Contains(err.Error(), "no such key") {
 						logger.Error("bfs failure", zap.Error(err))
 					}
@@ -392,7 +471,7 @@ func (d *traversal) bfsFrom(ctx context.Context, tx *bbolt.Tx, dequeue *apipb.Do
 				}
 				if res {
 					if len(d.traversalPath) <= int(d.filter.MaxDepth) && len(d.visited) <= int(d.filter.MaxHops) {
-						d.traversals.Traversals = append(d.traversals.Traversals, &apipb.Traversal{
+						d.add(&apipb.Traversal{
 							Doc:           to,
 							TraversalPath: d.traversalPath,
 							Depth:         uint64(len(d.traversalPath)),
@@ -404,7 +483,7 @@ func (d *traversal) bfsFrom(ctx context.Context, tx *bbolt.Tx, dequeue *apipb.Do
 			d.visited[to.Ref.String()] = struct{}{}
 			d.queue.Enqueue(to)
 		}
-		return len(d.traversals.GetTraversals()) < int(d.filter.Limit)
+		return d.more()
 	}); err != nil {
 		return err
 	}
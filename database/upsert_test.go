@@ -0,0 +1,38 @@
+package database
+
+import (
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestUpsertDocExpression(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	ref := &apipb.Ref{Gtype: "account", Gid: "1"}
+	createTestDoc(t, g, ctx, ref.GetGtype(), ref.GetGid(), map[string]interface{}{"version": 1})
+	ctx = withMethod(g, ctx, "UpsertDocs")
+	upsert := func(expression string, version float64) (*apipb.Docs, error) {
+		return g.UpsertDocs(ctx, &apipb.DocUpserts{Docs: []*apipb.DocUpsert{
+			{Ref: &apipb.Ref{Gtype: "account", Gid: "2"}, Attributes: apipb.NewStruct(map[string]interface{}{"version": version})},
+			{Ref: ref, Attributes: apipb.NewStruct(map[string]interface{}{"version": version}), Expression: expression},
+		}})
+	}
+	for _, expression := range []string{"this.attributes.version > 1.0", "this.attributes.missing == 1.0"} {
+		if _, err := upsert(expression, 2); status.Code(err) != codes.FailedPrecondition {
+			t.Fatalf("expected %s to fail with FailedPrecondition, got %v", expression, err)
+		}
+	}
+	if has, _ := g.HasDoc(ctx, &apipb.Ref{Gtype: "account", Gid: "2"}); has.GetValue() {
+		t.Fatal("expected a failed upsert to roll back the batch")
+	}
+	docs, err := upsert("this.attributes.version == 1.0", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, doc := range docs.GetDocs() {
+		if doc.GetAttributes().GetFields()["version"].GetNumberValue() != 2 {
+			t.Fatalf("expected version 2, got %v", doc.GetAttributes())
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"github.com/graphikDB/graphik/aggregate"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"go.etcd.io/bbolt"
+	"google.golang.org/protobuf/types/known/structpb"
+	"math"
+)
+
+const (
+	valueNumber byte = 'n'
+	valueString byte = 's'
+	valueBool   byte = 'b'
+)
+
+var (
+	// valueKeys maps encoded value + \x00 + gid -> nothing(keys are sorted by value)
+	valueKeys = []byte("values")
+	// valueDocs maps gid -> the doc's key in the values bucket(so it can be removed when the doc changes)
+	valueDocs = []byte("docs")
+)
+
+// valueRange is a scan of the values of a VALUE index between two bounds
+type valueRange struct {
+	index string
+	// kind is the type of value scanned(valueNumber, valueString or valueBool)
+	kind         byte
+	lower, upper []byte
+	// lowerInclusive & upperInclusive are true if values equal to the bound are in range
+	lowerInclusive, upperInclusive bool
+}
+
+// equality returns true if the range matches a single value
+func (r *valueRange) equality() bool {
+	return r.lower != nil && r.upper != nil && r.lowerInclusive && r.upperInclusive && bytes.Equal(r.lower, r.upper)
+}
+
+// narrow narrows the range with a comparison ex: >= 21
+func (r *valueRange) narrow(operator string, value []byte) {
+	if value[0] != r.kind {
+		return
+	}
+	if operator == "==" || operator == ">" || operator == ">=" {
+		if cmp := bytes.Compare(value, r.lower); r.lower == nil || cmp > 0 || (cmp == 0 && operator == ">") {
+			r.lower, r.lowerInclusive = value, operator != ">"
+		}
+	}
+	if operator == "==" || operator == "<" || operator == "<=" {
+		if cmp := bytes.Compare(value, r.upper); r.upper == nil || cmp < 0 || (cmp == 0 && operator == "<") {
+			r.upper, r.upperInclusive = value, operator != "<"
+		}
+	}
+}
+
+// scan executes fn against the gid of each doc in range until fn returns false
+func (r *valueRange) scan(bucket *bbolt.Bucket, fn func(gid []byte) bool) {
+	c := bucket.Bucket(valueKeys).Cursor()
+	start := r.lower
+	if start == nil {
+		start = []byte{r.kind}
+	}
+	for k, _ := c.Seek(start); k != nil && k[0] == r.kind; k, _ = c.Next() {
+		sep := bytes.LastIndexByte(k, 0)
+		if sep < 0 {
+			continue
+		}
+		value := k[:sep]
+		if r.lower != nil {
+			if cmp := bytes.Compare(value, r.lower); cmp < 0 || (cmp == 0 && !r.lowerInclusive) {
+				continue
+			}
+		}
+		if r.upper != nil {
+			if cmp := bytes.Compare(value, r.upper); cmp > 0 || (cmp == 0 && !r.upperInclusive) {
+				return
+			}
+		}
+		if !fn(k[sep+1:]) {
+			return
+		}
+	}
+}
+
+func (g *Graph) valueIndexBucket(ctx context.Context, tx *bbolt.Tx, name string) *bbolt.Bucket {
+	return g.bucket(ctx, tx, dbValueIndexes).Bucket([]byte(name))
+}
+
+// reindexValue rebuilds a VALUE index from every doc of the index's type
+func (g *Graph) reindexValue(ctx context.Context, tx *bbolt.Tx, i *apipb.Index) error {
+	parent := g.bucket(ctx, tx, dbValueIndexes)
+	if parent.Bucket([]byte(i.GetName())) != nil {
+		if err := parent.DeleteBucket([]byte(i.GetName())); err != nil {
+			return err
+		}
+	}
+	bucket, err := parent.CreateBucket([]byte(i.GetName()))
+	if err != nil {
+		return err
+	}
+	for _, name := range [][]byte{valueKeys, valueDocs} {
+		if _, err := bucket.CreateBucket(name); err != nil {
+			return err
+		}
+	}
+	ind, err := g.compileIndex(ctx, i)
+	if err != nil {
+		return err
+	}
+	docs := g.bucket(ctx, tx, dbDocs).Bucket([]byte(i.GetGtype()))
+	if docs == nil {
+		return nil
+	}
+	return docs.ForEach(func(k, v []byte) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var doc apipb.Doc
+		if err := g.unmarshal(v, &doc); err != nil {
+			return err
+		}
+		return g.setValueIndexedDoc(ctx, tx, ind, &doc)
+	})
+}
+
+// setValueIndexedDoc replaces the doc's value in a VALUE index. Docs without a string, number or boolean value aren't indexed.
+func (g *Graph) setValueIndexedDoc(ctx context.Context, tx *bbolt.Tx, i *index, doc *apipb.Doc) error {
+	if err := g.delValueIndexedDoc(ctx, tx, i.index.GetName(), doc.GetRef().GetGid()); err != nil {
+		return err
+	}
+	if i.program != nil {
+		pass, err := g.vm.Doc().Eval(doc, i.program)
+		if err != nil || !pass {
+			return nil
+		}
+	}
+	if len(i.index.GetFields()) != 1 {
+		return nil
+	}
+	value, ok := encodeValue(aggregate.DocPath(doc, i.index.GetFields()[0]))
+	if !ok {
+		return nil
+	}
+	bucket := g.valueIndexBucket(ctx, tx, i.index.GetName())
+	if bucket == nil {
+		return ErrNotFound
+	}
+	key := append(value, 0)
+	key = append(key, doc.GetRef().GetGid()...)
+	if err := bucket.Bucket(valueKeys).Put(key, nil); err != nil {
+		return err
+	}
+	return bucket.Bucket(valueDocs).Put([]byte(doc.GetRef().GetGid()), key)
+}
+
+// delValueIndexedDoc removes the doc's value from a VALUE index
+func (g *Graph) delValueIndexedDoc(ctx context.Context, tx *bbolt.Tx, index string, gid string) error {
+	bucket := g.valueIndexBucket(ctx, tx, index)
+	if bucket == nil {
+		return ErrNotFound
+	}
+	key := bucket.Bucket(valueDocs).Get([]byte(gid))
+	if key == nil {
+		return nil
+	}
+	// values are only valid until the bucket is modified
+	key = append([]byte{}, key...)
+	if err := bucket.Bucket(valueKeys).Delete(key); err != nil {
+		return err
+	}
+	return bucket.Bucket(valueDocs).Delete([]byte(gid))
+}
+
+// encodeValue encodes a string, number or boolean so that encoded values of the same type sort in the same order as the values
+func encodeValue(val *structpb.Value) ([]byte, bool) {
+	switch kind := val.GetKind().(type) {
+	case *structpb.Value_NumberValue:
+		return encodeConstant(kind.NumberValue)
+	case *structpb.Value_StringValue:
+		return encodeConstant(kind.StringValue)
+	case *structpb.Value_BoolValue:
+		return encodeConstant(kind.BoolValue)
+	}
+	return nil, false
+}
+
+// encodeConstant encodes a string, float64 or bool the same way as encodeValue
+func encodeConstant(val interface{}) ([]byte, bool) {
+	switch val := val.(type) {
+	case float64:
+		if math.IsNaN(val) {
+			return nil, false
+		}
+		if val == 0 {
+			// -0 & 0 are equal
+			val = 0
+		}
+		bits := math.Float64bits(val)
+		// flip the sign bit of positive numbers & every bit of negative numbers so they sort as unsigned integers
+		if val >= 0 {
+			bits ^= 1 << 63
+		} else {
+			bits = ^bits
+		}
+		encoded := make([]byte, 9)
+		encoded[0] = valueNumber
+		binary.BigEndian.PutUint64(encoded[1:], bits)
+		return encoded, true
+	case string:
+		// a \x00 would be confused with the gid separator
+		if bytes.IndexByte([]byte(val), 0) >= 0 {
+			return nil, false
+		}
+		return append([]byte{valueString}, val...), true
+	case bool:
+		if val {
+			return []byte{valueBool, 1}, true
+		}
+		return []byte{valueBool, 0}, true
+	}
+	return nil, false
+}
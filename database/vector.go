@@ -0,0 +1,427 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"github.com/google/cel-go/cel"
+	"github.com/graphikDB/graphik/aggregate"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"github.com/graphikDB/graphik/vector"
+	"go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// exactVectorThreshold is the number of indexed vectors below which searches are exact(brute force) instead of using the HNSW graph
+	exactVectorThreshold = 5000
+	// defaultVectorEf is the default size of the HNSW candidate list
+	defaultVectorEf = 64
+)
+
+var (
+	// vectorNodes maps gid -> the node's HNSW level followed by it's vector
+	vectorNodes = []byte("nodes")
+	// vectorLinks maps gid + \x00 + level -> the gids of the node's neighbors at the level(separated by \x00)
+	vectorLinks = []byte("links")
+	// vectorMeta holds the HNSW entry point & the number of indexed vectors
+	vectorMeta      = []byte("meta")
+	vectorMetaEntry = []byte("entry")
+	vectorMetaCount = []byte("count")
+)
+
+// SearchSimilar searches a VECTOR index for the docs nearest to the filter's vector. Only docs that pass the filter's
+// expression & were found by the filter's traversal are considered.
+func (g *Graph) SearchSimilar(ctx context.Context, filter *apipb.SimilarFilter) (*apipb.SimilarDocs, error) {
+	i := g.getIndex(ctx, filter.GetIndex())
+	if i == nil || i.index.GetKind() != apipb.IndexKind_VECTOR {
+		return nil, status.Errorf(codes.NotFound, "vector index %s not found", filter.GetIndex())
+	}
+	var (
+		program cel.Program
+		err     error
+	)
+	if filter.GetExpression() != "" {
+		program, err = g.vm.Doc().Program(filter.GetExpression())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	var walker *traversal
+	traversed := map[string]struct{}{}
+	if filter.GetTraverse() != nil {
+		walker, err = g.newTraversal(filter.GetTraverse(), func(t *apipb.Traversal) error {
+			if t.GetDoc().GetRef().GetGtype() == i.index.GetGtype() {
+				traversed[t.GetDoc().GetRef().GetGid()] = struct{}{}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	results := &apipb.SimilarDocs{}
+	if err := g.db.View(func(tx *bbolt.Tx) error {
+		bucket := g.vectorIndexBucket(ctx, tx, i.index.GetName())
+		if bucket == nil {
+			return ErrNotFound
+		}
+		store := newVectorStore(bucket)
+		var (
+			query   []float32
+			exclude string
+		)
+		for _, v := range filter.GetVector() {
+			query = append(query, float32(v))
+		}
+		if len(query) == 0 && filter.GetLike() != nil {
+			vec, _, ok, err := store.Node(filter.GetLike().GetGid())
+			if err != nil {
+				return err
+			}
+			if !ok || filter.GetLike().GetGtype() != i.index.GetGtype() {
+				return status.Errorf(codes.NotFound, "%s has no vector in index %s", refString(filter.GetLike()), i.index.GetName())
+			}
+			query, exclude = vec, filter.GetLike().GetGid()
+		}
+		if len(query) == 0 {
+			return status.Error(codes.InvalidArgument, "a vector or like ref is required")
+		}
+		if len(query) != int(i.index.GetDimensions()) {
+			return status.Errorf(codes.InvalidArgument, "index %s expects vectors with %v dimensions", i.index.GetName(), i.index.GetDimensions())
+		}
+		if walker != nil {
+			walkCtx, finish := g.startQuery(ctx, filter.GetTraverse(), filter.GetTraverse().GetBudget(), false)
+			if err := finish(len(traversed), "", walker.Walk(walkCtx, tx)); err != nil {
+				return err
+			}
+		}
+		var filterErr error
+		docs := map[string]*apipb.Doc{}
+		pass := func(gid string) bool {
+			if gid == exclude || filterErr != nil {
+				return false
+			}
+			if walker != nil {
+				if _, ok := traversed[gid]; !ok {
+					return false
+				}
+			}
+			doc, err := g.getDoc(ctx, tx, &apipb.Ref{Gtype: i.index.GetGtype(), Gid: gid})
+			if err != nil {
+				if err != ErrNotFound {
+					filterErr = err
+				}
+				return false
+			}
+			if program != nil {
+				pass, err := g.vm.Doc().Eval(doc, program)
+				if err != nil || !pass {
+					return false
+				}
+			}
+			docs[gid] = doc
+			return true
+		}
+		metric := vector.Metric(i.index.GetMetric())
+		limit := int(filter.GetLimit())
+		var found []vector.Result
+		count := getUvarint(bucket.Bucket(vectorMeta).Get(vectorMetaCount))
+		switch {
+		case walker != nil:
+			// the traversal already narrowed down the candidates
+			top := vector.NewTopK(limit)
+			for gid := range traversed {
+				vec, _, ok, err := store.Node(gid)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					continue
+				}
+				if distance := vector.Distance(metric, query, vec); top.Accepts(distance) && pass(gid) {
+					top.Add(vector.Result{ID: gid, Distance: distance})
+				}
+			}
+			found = top.Results()
+		case filter.GetExact() || count < exactVectorThreshold:
+			top := vector.NewTopK(limit)
+			c := bucket.Bucket(vectorNodes).Cursor()
+			for k, v := c.First(); k != nil; k, v = c.Next() {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				if distance := vector.Distance(metric, query, decodeVectorNode(v)); top.Accepts(distance) && pass(string(k)) {
+					top.Add(vector.Result{ID: string(k), Distance: distance})
+				}
+			}
+			found = top.Results()
+		default:
+			ef := int(filter.GetEf())
+			if ef == 0 {
+				ef = defaultVectorEf
+			}
+			// the expression is applied while searching - the candidate list grows until enough docs pass it
+			for {
+				found, err = vector.NewHNSW(metric, store).Search(query, limit, ef, pass)
+				if err != nil {
+					return err
+				}
+				if len(found) >= limit || uint64(ef) >= count {
+					break
+				}
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				ef *= 4
+			}
+		}
+		if filterErr != nil {
+			return filterErr
+		}
+		for _, result := range found {
+			results.Docs = append(results.Docs, &apipb.SimilarDoc{
+				Doc:      docs[result.ID],
+				Distance: result.Distance,
+			})
+		}
+		return nil
+	}); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		if err == ErrNotFound {
+			return nil, status.Errorf(codes.NotFound, "vector index %s not found", filter.GetIndex())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return results, nil
+}
+
+func (g *Graph) vectorIndexBucket(ctx context.Context, tx *bbolt.Tx, name string) *bbolt.Bucket {
+	return g.bucket(ctx, tx, dbVectorIndexes).Bucket([]byte(name))
+}
+
+// reindexVector rebuilds a VECTOR index from every doc of the index's type
+func (g *Graph) reindexVector(ctx context.Context, tx *bbolt.Tx, i *apipb.Index) error {
+	parent := g.bucket(ctx, tx, dbVectorIndexes)
+	if parent.Bucket([]byte(i.GetName())) != nil {
+		if err := parent.DeleteBucket([]byte(i.GetName())); err != nil {
+			return err
+		}
+	}
+	bucket, err := parent.CreateBucket([]byte(i.GetName()))
+	if err != nil {
+		return err
+	}
+	for _, name := range [][]byte{vectorNodes, vectorLinks, vectorMeta} {
+		if _, err := bucket.CreateBucket(name); err != nil {
+			return err
+		}
+	}
+	ind, err := g.compileIndex(ctx, i)
+	if err != nil {
+		return err
+	}
+	docs := g.bucket(ctx, tx, dbDocs).Bucket([]byte(i.GetGtype()))
+	if docs == nil {
+		return nil
+	}
+	return docs.ForEach(func(k, v []byte) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var doc apipb.Doc
+		if err := g.unmarshal(v, &doc); err != nil {
+			return err
+		}
+		return g.setVectorIndexedDoc(ctx, tx, ind, &doc)
+	})
+}
+
+// setVectorIndexedDoc replaces the doc's vector in a VECTOR index. Docs without a vector of the index's dimensions aren't indexed.
+func (g *Graph) setVectorIndexedDoc(ctx context.Context, tx *bbolt.Tx, i *index, doc *apipb.Doc) error {
+	if err := g.delVectorIndexedDoc(ctx, tx, i.index.GetName(), doc.GetRef().GetGid()); err != nil {
+		return err
+	}
+	if i.program != nil {
+		pass, err := g.vm.Doc().Eval(doc, i.program)
+		if err != nil || !pass {
+			return nil
+		}
+	}
+	if len(i.index.GetFields()) != 1 {
+		return nil
+	}
+	vec, ok := vectorAt(doc, i.index.GetFields()[0])
+	if !ok || len(vec) != int(i.index.GetDimensions()) {
+		return nil
+	}
+	bucket := g.vectorIndexBucket(ctx, tx, i.index.GetName())
+	if bucket == nil {
+		return ErrNotFound
+	}
+	if err := vector.NewHNSW(vector.Metric(i.index.GetMetric()), newVectorStore(bucket)).Insert(doc.GetRef().GetGid(), vec); err != nil {
+		return err
+	}
+	return addVectorCount(bucket, 1)
+}
+
+// delVectorIndexedDoc removes the doc's vector from a VECTOR index
+func (g *Graph) delVectorIndexedDoc(ctx context.Context, tx *bbolt.Tx, index string, gid string) error {
+	bucket := g.vectorIndexBucket(ctx, tx, index)
+	if bucket == nil {
+		return ErrNotFound
+	}
+	if bucket.Bucket(vectorNodes).Get([]byte(gid)) == nil {
+		return nil
+	}
+	// the metric only affects which neighbors are kept when the graph is relinked
+	i := g.getIndex(ctx, index)
+	metric := vector.Cosine
+	if i != nil {
+		metric = vector.Metric(i.index.GetMetric())
+	}
+	if err := vector.NewHNSW(metric, newVectorStore(bucket)).Delete(gid); err != nil {
+		return err
+	}
+	return addVectorCount(bucket, -1)
+}
+
+func addVectorCount(bucket *bbolt.Bucket, delta int64) error {
+	meta := bucket.Bucket(vectorMeta)
+	current := int64(getUvarint(meta.Get(vectorMetaCount))) + delta
+	if current < 0 {
+		current = 0
+	}
+	return meta.Put(vectorMetaCount, uvarint(uint64(current)))
+}
+
+// vectorAt returns the numeric list at the path
+func vectorAt(doc *apipb.Doc, path string) ([]float32, bool) {
+	list, ok := aggregate.DocPath(doc, path).GetKind().(*structpb.Value_ListValue)
+	if !ok || len(list.ListValue.GetValues()) == 0 {
+		return nil, false
+	}
+	vec := make([]float32, 0, len(list.ListValue.GetValues()))
+	for _, v := range list.ListValue.GetValues() {
+		num, ok := v.GetKind().(*structpb.Value_NumberValue)
+		if !ok {
+			return nil, false
+		}
+		vec = append(vec, float32(num.NumberValue))
+	}
+	return vec, true
+}
+
+func decodeVectorNode(bits []byte) []float32 {
+	if len(bits) == 0 {
+		return nil
+	}
+	return vector.Decode(bits[1:])
+}
+
+// vectorStore persists an HNSW graph in a VECTOR index bucket
+type vectorStore struct {
+	nodes *bbolt.Bucket
+	links *bbolt.Bucket
+	meta  *bbolt.Bucket
+	// cache holds decoded vectors - a single insert/search reads the same nodes many times
+	cache map[string]vectorNode
+}
+
+type vectorNode struct {
+	vec   []float32
+	level int
+}
+
+func newVectorStore(bucket *bbolt.Bucket) *vectorStore {
+	return &vectorStore{
+		nodes: bucket.Bucket(vectorNodes),
+		links: bucket.Bucket(vectorLinks),
+		meta:  bucket.Bucket(vectorMeta),
+		cache: map[string]vectorNode{},
+	}
+}
+
+func (s *vectorStore) Node(id string) ([]float32, int, bool, error) {
+	if node, ok := s.cache[id]; ok {
+		return node.vec, node.level, true, nil
+	}
+	bits := s.nodes.Get([]byte(id))
+	if len(bits) == 0 {
+		return nil, 0, false, nil
+	}
+	node := vectorNode{vec: decodeVectorNode(bits), level: int(bits[0])}
+	s.cache[id] = node
+	return node.vec, node.level, true, nil
+}
+
+func (s *vectorStore) SetNode(id string, vec []float32, level int) error {
+	s.cache[id] = vectorNode{vec: vec, level: level}
+	return s.nodes.Put([]byte(id), append([]byte{byte(level)}, vector.Encode(vec)...))
+}
+
+func (s *vectorStore) DelNode(id string) error {
+	delete(s.cache, id)
+	return s.nodes.Delete([]byte(id))
+}
+
+func (s *vectorStore) Neighbors(id string, level int) ([]string, error) {
+	bits := s.links.Get(vectorLinkKey(id, level))
+	if len(bits) == 0 {
+		return nil, nil
+	}
+	var neighbors []string
+	for _, neighbor := range bytes.Split(bits, []byte{0}) {
+		neighbors = append(neighbors, string(neighbor))
+	}
+	return neighbors, nil
+}
+
+func (s *vectorStore) SetNeighbors(id string, level int, neighbors []string) error {
+	if len(neighbors) == 0 {
+		return s.links.Delete(vectorLinkKey(id, level))
+	}
+	var bits []byte
+	for x, neighbor := range neighbors {
+		if x > 0 {
+			bits = append(bits, 0)
+		}
+		bits = append(bits, neighbor...)
+	}
+	return s.links.Put(vectorLinkKey(id, level), bits)
+}
+
+func (s *vectorStore) Entry() (string, int, bool, error) {
+	bits := s.meta.Get(vectorMetaEntry)
+	if len(bits) == 0 {
+		return "", 0, false, nil
+	}
+	return string(bits[1:]), int(bits[0]), true, nil
+}
+
+func (s *vectorStore) SetEntry(id string, level int) error {
+	if id == "" {
+		return s.meta.Delete(vectorMetaEntry)
+	}
+	return s.meta.Put(vectorMetaEntry, append([]byte{byte(level)}, id...))
+}
+
+func (s *vectorStore) Any(exclude string) (string, int, bool, error) {
+	c := s.nodes.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if string(k) != exclude && len(v) > 0 {
+			return string(k), int(v[0]), true, nil
+		}
+	}
+	return "", 0, false, nil
+}
+
+func vectorLinkKey(id string, level int) []byte {
+	key := make([]byte, 0, len(id)+2)
+	key = append(key, id...)
+	key = append(key, 0, byte(level))
+	return key
+}
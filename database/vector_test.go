@@ -0,0 +1,105 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"github.com/graphikDB/graphik/gen/grpc/go"
+	"go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math"
+	"testing"
+)
+
+// similarDistances returns the gid & distance of each similar doc in order
+func similarDistances(docs *apipb.SimilarDocs) string {
+	var results []string
+	for _, doc := range docs.GetDocs() {
+		results = append(results, fmt.Sprintf("%s:%.2f", doc.GetDoc().GetRef().GetGid(), doc.GetDistance()))
+	}
+	return fmt.Sprint(results)
+}
+
+// assertVectorIndexed checks the number of docs in a VECTOR index
+func assertVectorIndexed(t *testing.T, g *Graph, ctx context.Context, index string, expected uint64) {
+	t.Helper()
+	if err := g.db.View(func(tx *bbolt.Tx) error {
+		bucket := g.vectorIndexBucket(ctx, tx, index)
+		if count := getUvarint(bucket.Bucket(vectorMeta).Get(vectorMetaCount)); count != expected {
+			t.Fatalf("expected a count of %v indexed docs, got %v", expected, count)
+		}
+		if count := bucket.Bucket(vectorNodes).Stats().KeyN; count != int(expected) {
+			t.Fatalf("expected %v indexed vectors, got %v", expected, count)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSearchSimilar(t *testing.T) {
+	g, ctx := openTestGraph(t, &apipb.Flags{})
+	if _, err := g.SetIndexes(withMethod(g, ctx, "SetIndexes"), &apipb.Indexes{Indexes: []*apipb.Index{
+		{Name: "embeddings", Gtype: "item", Docs: true, Kind: apipb.IndexKind_VECTOR, Fields: []string{"attributes.embedding"}, Metric: apipb.VectorMetric_L2, Dimensions: 3},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	for gid, embedding := range map[string][]interface{}{
+		"a": {1.0, 0.0, 0.0},
+		"b": {0.0, 1.0, 0.0},
+		"c": {0.0, 0.0, 1.0},
+		"d": {1.0, 1.0, 0.0},
+	} {
+		createTestDoc(t, g, ctx, "item", gid, map[string]interface{}{"embedding": embedding})
+	}
+	// docs of other types aren't indexed even if they share a gid with an indexed doc
+	createTestDoc(t, g, ctx, "thing", "a", map[string]interface{}{"embedding": []interface{}{0.0, 0.0, 0.0}})
+	search := func(filter *apipb.SimilarFilter, expected string) {
+		t.Helper()
+		filter.Index = "embeddings"
+		docs, err := g.SearchSimilar(withMethod(g, ctx, "SearchSimilar"), filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := similarDistances(docs); got != expected {
+			t.Fatalf("%v: expected %s, got %s", filter, expected, got)
+		}
+	}
+	search(&apipb.SimilarFilter{Vector: []float64{1, 0, 0}, Limit: 2}, "[a:0.00 d:1.00]")
+	// the liked doc is excluded
+	search(&apipb.SimilarFilter{Like: &apipb.Ref{Gtype: "item", Gid: "d"}, Limit: 2}, "[a:1.00 b:1.00]")
+	search(&apipb.SimilarFilter{Vector: []float64{1, 0, 0}, Expression: "this.ref.gid != 'a'", Limit: 1}, "[d:1.00]")
+	if _, err := g.SearchSimilar(withMethod(g, ctx, "SearchSimilar"), &apipb.SimilarFilter{Index: "embeddings", Vector: []float64{1, 0}, Limit: 1}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a vector with the wrong number of dimensions to be rejected, got %v", err)
+	}
+
+	// editing a doc's embedding moves it
+	if _, err := g.EditDoc(withMethod(g, ctx, "EditDoc"), &apipb.Edit{
+		Ref:        &apipb.Ref{Gtype: "item", Gid: "c"},
+		Attributes: apipb.NewStruct(map[string]interface{}{"embedding": []interface{}{1.0, 0.0, 0.1}}),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	search(&apipb.SimilarFilter{Vector: []float64{1, 0, 0}, Limit: 2}, "[a:0.00 c:0.10]")
+	// & an embedding with the wrong number of dimensions removes it from the index
+	if _, err := g.EditDoc(withMethod(g, ctx, "EditDoc"), &apipb.Edit{
+		Ref:        &apipb.Ref{Gtype: "item", Gid: "b"},
+		Attributes: apipb.NewStruct(map[string]interface{}{"embedding": []interface{}{0.0, 1.0}}),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	search(&apipb.SimilarFilter{Vector: []float64{0, 1, 0}, Limit: 10}, fmt.Sprintf("[d:1.00 a:%.2f c:%.2f]", math.Sqrt(2), math.Sqrt(2.01)))
+	assertVectorIndexed(t, g, ctx, "embeddings", 3)
+
+	// a deleted doc's embedding is removed, so it doesn't match a new doc with the same gid
+	if _, err := g.DelDoc(withMethod(g, ctx, "DelDoc"), &apipb.Ref{Gtype: "item", Gid: "a"}); err != nil {
+		t.Fatal(err)
+	}
+	assertVectorIndexed(t, g, ctx, "embeddings", 2)
+	createTestDoc(t, g, ctx, "item", "a", map[string]interface{}{"name": "a"})
+	search(&apipb.SimilarFilter{Vector: []float64{1, 0, 0}, Limit: 10}, "[c:0.10 d:1.00]")
+	if _, err := g.SearchSimilar(withMethod(g, ctx, "SearchSimilar"), &apipb.SimilarFilter{Index: "embeddings", Like: &apipb.Ref{Gtype: "item", Gid: "a"}, Limit: 1}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected a doc without an embedding to be NotFound, got %v", err)
+	}
+	assertVectorIndexed(t, g, ctx, "embeddings", 2)
+}
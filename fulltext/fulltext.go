@@ -0,0 +1,65 @@
+// Package fulltext contains the tokenizer, english stemmer & BM25 scoring used by full-text indexes
+package fulltext
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+const (
+	// BM25 term frequency saturation
+	k1 = 1.2
+	// BM25 document length normalization
+	b = 0.75
+)
+
+var stopWords = map[string]struct{}{}
+
+func init() {
+	for _, word := range strings.Fields(`a an and are as at be but by for if in into is it no not of on or such that the their then there these they this to was will with`) {
+		stopWords[word] = struct{}{}
+	}
+}
+
+// Tokenize splits text into lower case, stemmed terms. Stop words(ex: the, and) are dropped.
+func Tokenize(text string) []string {
+	var terms []string
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}) {
+		if _, ok := stopWords[word]; ok {
+			continue
+		}
+		terms = append(terms, Stem(word))
+	}
+	return terms
+}
+
+// TermFrequencies tokenizes text & returns the number of times each term occurs along with the total number of terms
+func TermFrequencies(text string) (map[string]uint64, uint64) {
+	var (
+		total uint64
+		freqs = map[string]uint64{}
+	)
+	for _, term := range Tokenize(text) {
+		freqs[term]++
+		total++
+	}
+	return freqs, total
+}
+
+// BM25 scores a single query term against a document.
+// tf is the term frequency in the document, df is the number of documents containing the term, docLen is the number of terms
+// in the document, avgDocLen is the average number of terms per document & docs is the total number of documents.
+func BM25(tf, df, docLen uint64, avgDocLen float64, docs uint64) float64 {
+	if tf == 0 || docs == 0 {
+		return 0
+	}
+	idf := math.Log(1 + (float64(docs)-float64(df)+0.5)/(float64(df)+0.5))
+	norm := 1 - b
+	if avgDocLen > 0 {
+		norm += b * float64(docLen) / avgDocLen
+	}
+	return idf * float64(tf) * (k1 + 1) / (float64(tf) + k1*norm)
+}
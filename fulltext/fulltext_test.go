@@ -0,0 +1,51 @@
+package fulltext_test
+
+import (
+	"github.com/graphikDB/graphik/fulltext"
+	"testing"
+)
+
+func TestStem(t *testing.T) {
+	for word, stem := range map[string]string{
+		"caresses":       "caress",
+		"ponies":         "poni",
+		"cats":           "cat",
+		"agreed":         "agre",
+		"hopping":        "hop",
+		"filing":         "file",
+		"happy":          "happi",
+		"relational":     "relat",
+		"connections":    "connect",
+		"connected":      "connect",
+		"generalization": "gener",
+		"electrical":     "electr",
+		"adjustment":     "adjust",
+		"controlling":    "control",
+	} {
+		if got := fulltext.Stem(word); got != stem {
+			t.Fatalf("expected %s to stem to %s, got %s", word, stem, got)
+		}
+	}
+}
+
+func TestTokenize(t *testing.T) {
+	terms := fulltext.Tokenize("The Quick brown-fox is JUMPING over the lazy dogs!")
+	expected := []string{"quick", "brown", "fox", "jump", "over", "lazi", "dog"}
+	if len(terms) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, terms)
+	}
+	for i := range terms {
+		if terms[i] != expected[i] {
+			t.Fatalf("expected %v, got %v", expected, terms)
+		}
+	}
+}
+
+func TestBM25(t *testing.T) {
+	if fulltext.BM25(3, 1, 10, 10, 100) <= fulltext.BM25(1, 1, 10, 10, 100) {
+		t.Fatal("expected a higher term frequency to score higher")
+	}
+	if fulltext.BM25(1, 50, 10, 10, 100) >= fulltext.BM25(1, 1, 10, 10, 100) {
+		t.Fatal("expected a rarer term to score higher")
+	}
+}
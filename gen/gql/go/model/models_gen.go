@@ -9,10 +9,114 @@ import (
 	"time"
 )
 
+type AggField struct {
+	Aggregate   Aggregate `json:"aggregate"`
+	Field       *string   `json:"field"`
+	Percentile  *float64  `json:"percentile"`
+	Approximate *bool     `json:"approximate"`
+	BucketSize  *float64  `json:"bucket_size"`
+}
+
 type AggFilter struct {
-	Filter    *Filter   `json:"filter"`
-	Aggregate Aggregate `json:"aggregate"`
-	Field     *string   `json:"field"`
+	Filter      *Filter   `json:"filter"`
+	Aggregate   Aggregate `json:"aggregate"`
+	Field       *string   `json:"field"`
+	Percentile  *float64  `json:"percentile"`
+	Approximate *bool     `json:"approximate"`
+}
+
+type AggGroup struct {
+	Key    map[string]interface{} `json:"key"`
+	Count  int                    `json:"count"`
+	Values []*AggValue            `json:"values"`
+}
+
+type AggGroups struct {
+	Groups []*AggGroup `json:"groups"`
+}
+
+type AggValue struct {
+	Aggregate Aggregate          `json:"aggregate"`
+	Field     string             `json:"field"`
+	Value     float64            `json:"value"`
+	Buckets   []*HistogramBucket `json:"buckets"`
+}
+
+type AllPathsFilter struct {
+	Source               *RefInput `json:"source"`
+	Target               *RefInput `json:"target"`
+	DocExpression        *string   `json:"doc_expression"`
+	ConnectionExpression *string   `json:"connection_expression"`
+	MaxDepth             int       `json:"max_depth"`
+	MaxPaths             int       `json:"max_paths"`
+	Reverse              *bool     `json:"reverse"`
+	Budget               *Budget   `json:"budget"`
+}
+
+type AnalyticsJob struct {
+	Algorithm     AnalyticsAlgorithm `json:"algorithm"`
+	Subgraph      *Subgraph          `json:"subgraph"`
+	Direction     Direction          `json:"direction"`
+	Attribute     *string            `json:"attribute"`
+	Damping       float64            `json:"damping"`
+	MaxIterations int                `json:"max_iterations"`
+	Tolerance     float64            `json:"tolerance"`
+}
+
+type AnalyticsJobInput struct {
+	Algorithm     AnalyticsAlgorithm `json:"algorithm"`
+	Subgraph      *SubgraphInput     `json:"subgraph"`
+	Direction     *Direction         `json:"direction"`
+	Attribute     *string            `json:"attribute"`
+	Damping       *float64           `json:"damping"`
+	MaxIterations *int               `json:"max_iterations"`
+	Tolerance     *float64           `json:"tolerance"`
+}
+
+type AnalyticsRefInput struct {
+	ID string `json:"id"`
+}
+
+type AnalyticsResult struct {
+	Ref   *Ref    `json:"ref"`
+	Value float64 `json:"value"`
+}
+
+type AnalyticsResults struct {
+	Results []*AnalyticsResult `json:"results"`
+}
+
+type AnalyticsStatus struct {
+	ID             string        `json:"id"`
+	Job            *AnalyticsJob `json:"job"`
+	State          JobState      `json:"state"`
+	Phase          string        `json:"phase"`
+	Progress       float64       `json:"progress"`
+	Docs           int           `json:"docs"`
+	Connections    int           `json:"connections"`
+	Iterations     int           `json:"iterations"`
+	Written        int           `json:"written"`
+	Error          *string       `json:"error"`
+	User           *Ref          `json:"user"`
+	StartedAt      *time.Time    `json:"started_at"`
+	CompletedAt    *time.Time    `json:"completed_at"`
+	Components     []*Component  `json:"components"`
+	ComponentCount int           `json:"component_count"`
+	Modularity     float64       `json:"modularity"`
+}
+
+type AnalyticsStatuses struct {
+	Jobs []*AnalyticsStatus `json:"jobs"`
+}
+
+type AttributeTransform struct {
+	Gtype      string `json:"gtype"`
+	Expression string `json:"expression"`
+}
+
+type AttributeTransformInput struct {
+	Gtype      string `json:"gtype"`
+	Expression string `json:"expression"`
 }
 
 type Authorizer struct {
@@ -33,14 +137,26 @@ type AuthorizersInput struct {
 	Authorizers []*AuthorizerInput `json:"authorizers"`
 }
 
+type Budget struct {
+	MaxScan        *int `json:"max_scan"`
+	MaxEvaluations *int `json:"max_evaluations"`
+	TimeoutMs      *int `json:"timeout_ms"`
+}
+
+type Component struct {
+	ID   int `json:"id"`
+	Size int `json:"size"`
+}
+
 type ConnectFilter struct {
-	DocRef     *RefInput `json:"doc_ref"`
-	Gtype      string    `json:"gtype"`
-	Expression *string   `json:"expression"`
-	Limit      int       `json:"limit"`
-	Sort       *string   `json:"sort"`
-	Seek       *string   `json:"seek"`
-	Reverse    *bool     `json:"reverse"`
+	DocRef     *RefInput  `json:"doc_ref"`
+	Gtype      string     `json:"gtype"`
+	Expression *string    `json:"expression"`
+	Limit      int        `json:"limit"`
+	Sort       *string    `json:"sort"`
+	Seek       *string    `json:"seek"`
+	Reverse    *bool      `json:"reverse"`
+	Direction  *Direction `json:"direction"`
 }
 
 type Connection struct {
@@ -51,16 +167,35 @@ type Connection struct {
 	To         *Ref                   `json:"to"`
 }
 
+type ConnectionBinding struct {
+	Name        string        `json:"name"`
+	Connections []*Connection `json:"connections"`
+}
+
 type ConnectionConstructor struct {
-	Ref        *RefConstructor        `json:"ref"`
-	Directed   bool                   `json:"directed"`
+	Ref            *RefConstructor        `json:"ref"`
+	Directed       bool                   `json:"directed"`
+	Attributes     map[string]interface{} `json:"attributes"`
+	From           *RefInput              `json:"from"`
+	To             *RefInput              `json:"to"`
+	IdempotencyKey *string                `json:"idempotency_key"`
+}
+
+type ConnectionConstructors struct {
+	Connections []*ConnectionConstructor `json:"connections"`
+}
+
+type ConnectionUpsert struct {
+	Ref        *RefInput              `json:"ref"`
 	Attributes map[string]interface{} `json:"attributes"`
+	Directed   bool                   `json:"directed"`
 	From       *RefInput              `json:"from"`
 	To         *RefInput              `json:"to"`
+	Expression *string                `json:"expression"`
 }
 
-type ConnectionConstructors struct {
-	Connections []*ConnectionConstructor `json:"connections"`
+type ConnectionUpserts struct {
+	Connections []*ConnectionUpsert `json:"connections"`
 }
 
 type Connections struct {
@@ -68,25 +203,62 @@ type Connections struct {
 	SeekNext    *string       `json:"seek_next"`
 }
 
+type Degree struct {
+	Doc       *Ref           `json:"doc"`
+	InDegree  int            `json:"in_degree"`
+	OutDegree int            `json:"out_degree"`
+	Gtypes    []*GtypeDegree `json:"gtypes"`
+}
+
+type DegreeFilter struct {
+	Doc   *RefInput `json:"doc"`
+	Gtype *string   `json:"gtype"`
+}
+
 type Doc struct {
 	Ref        *Ref                   `json:"ref"`
 	Attributes map[string]interface{} `json:"attributes"`
 }
 
+type DocBinding struct {
+	Name string `json:"name"`
+	Doc  *Doc   `json:"doc"`
+}
+
 type DocConstructor struct {
-	Ref        *RefConstructor        `json:"ref"`
-	Attributes map[string]interface{} `json:"attributes"`
+	Ref            *RefConstructor        `json:"ref"`
+	Attributes     map[string]interface{} `json:"attributes"`
+	IdempotencyKey *string                `json:"idempotency_key"`
 }
 
 type DocConstructors struct {
 	Docs []*DocConstructor `json:"docs"`
 }
 
+type DocUpsert struct {
+	Ref        *RefInput              `json:"ref"`
+	Attributes map[string]interface{} `json:"attributes"`
+	Expression *string                `json:"expression"`
+}
+
+type DocUpserts struct {
+	Docs []*DocUpsert `json:"docs"`
+}
+
 type Docs struct {
 	Docs     []*Doc  `json:"docs"`
 	SeekNext *string `json:"seek_next"`
 }
 
+type EdgePattern struct {
+	Name       *string    `json:"name"`
+	Gtype      *string    `json:"gtype"`
+	Expression *string    `json:"expression"`
+	Direction  *Direction `json:"direction"`
+	MinHops    *int       `json:"min_hops"`
+	MaxHops    *int       `json:"max_hops"`
+}
+
 type Edit struct {
 	Ref        *RefInput              `json:"ref"`
 	Attributes map[string]interface{} `json:"attributes"`
@@ -105,10 +277,55 @@ type ExistsFilter struct {
 	Index      *string `json:"index"`
 }
 
+type ExplainCandidate struct {
+	AccessPath string `json:"access_path"`
+	Estimate   int    `json:"estimate"`
+}
+
+type ExplainError struct {
+	Error string `json:"error"`
+	Count int    `json:"count"`
+}
+
+type ExplainFilter struct {
+	SearchDocs        *Filter         `json:"search_docs"`
+	SearchConnections *Filter         `json:"search_connections"`
+	Traverse          *TraverseFilter `json:"traverse"`
+}
+
+type ExplainPhase struct {
+	Name     string `json:"name"`
+	Duration string `json:"duration"`
+}
+
+type Explanation struct {
+	AccessPath         string              `json:"access_path"`
+	Estimate           int                 `json:"estimate"`
+	Candidates         []*ExplainCandidate `json:"candidates"`
+	KeysScanned        int                 `json:"keys_scanned"`
+	DocsDecoded        int                 `json:"docs_decoded"`
+	ConnectionsDecoded int                 `json:"connections_decoded"`
+	Evaluations        int                 `json:"evaluations"`
+	Errors             []*ExplainError     `json:"errors"`
+	Results            int                 `json:"results"`
+	Phases             []*ExplainPhase     `json:"phases"`
+	Duration           string              `json:"duration"`
+}
+
 type ExprFilter struct {
 	Expression *string `json:"expression"`
 }
 
+type ExtractFilter struct {
+	Roots                []*RefInput `json:"roots"`
+	DocExpression        *string     `json:"doc_expression"`
+	ConnectionExpression *string     `json:"connection_expression"`
+	MaxDepth             int         `json:"max_depth"`
+	Limit                int         `json:"limit"`
+	Direction            *Direction  `json:"direction"`
+	Budget               *Budget     `json:"budget"`
+}
+
 type Filter struct {
 	Gtype      string  `json:"gtype"`
 	Expression *string `json:"expression"`
@@ -117,22 +334,89 @@ type Filter struct {
 	Seek       *string `json:"seek"`
 	Reverse    *bool   `json:"reverse"`
 	Index      *string `json:"index"`
+	DryRun     *bool   `json:"dry_run"`
+	Budget     *Budget `json:"budget"`
+}
+
+type GeoBoxInput struct {
+	Min *GeoPointInput `json:"min"`
+	Max *GeoPointInput `json:"max"`
+}
+
+type GeoDoc struct {
+	Doc    *Doc    `json:"doc"`
+	Meters float64 `json:"meters"`
+}
+
+type GeoDocs struct {
+	Docs []*GeoDoc `json:"docs"`
+}
+
+type GeoFilter struct {
+	Index      string          `json:"index"`
+	Radius     *GeoRadiusInput `json:"radius"`
+	Box        *GeoBoxInput    `json:"box"`
+	Nearest    *GeoPointInput  `json:"nearest"`
+	Limit      int             `json:"limit"`
+	Expression *string         `json:"expression"`
+	Traverse   *TraverseFilter `json:"traverse"`
+}
+
+type GeoPointInput struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+type GeoRadiusInput struct {
+	Center *GeoPointInput `json:"center"`
+	Meters float64        `json:"meters"`
+}
+
+type Graph struct {
+	Docs        *Docs        `json:"docs"`
+	Connections *Connections `json:"connections"`
+}
+
+type GroupAggFilter struct {
+	Filter     *Filter     `json:"filter"`
+	GroupBy    []string    `json:"group_by"`
+	Aggregates []*AggField `json:"aggregates"`
+}
+
+type GtypeDegree struct {
+	Gtype     string `json:"gtype"`
+	InDegree  int    `json:"in_degree"`
+	OutDegree int    `json:"out_degree"`
+}
+
+type HistogramBucket struct {
+	Lower float64 `json:"lower"`
+	Upper float64 `json:"upper"`
+	Count int     `json:"count"`
 }
 
 type Index struct {
-	Name        string `json:"name"`
-	Gtype       string `json:"gtype"`
-	Expression  string `json:"expression"`
-	Docs        bool   `json:"docs"`
-	Connections bool   `json:"connections"`
+	Name        string       `json:"name"`
+	Gtype       string       `json:"gtype"`
+	Expression  string       `json:"expression"`
+	Docs        bool         `json:"docs"`
+	Connections bool         `json:"connections"`
+	Kind        IndexKind    `json:"kind"`
+	Fields      []string     `json:"fields"`
+	Metric      VectorMetric `json:"metric"`
+	Dimensions  int          `json:"dimensions"`
 }
 
 type IndexInput struct {
-	Name        string `json:"name"`
-	Gtype       string `json:"gtype"`
-	Expression  string `json:"expression"`
-	Docs        bool   `json:"docs"`
-	Connections bool   `json:"connections"`
+	Name        string        `json:"name"`
+	Gtype       string        `json:"gtype"`
+	Expression  *string       `json:"expression"`
+	Docs        bool          `json:"docs"`
+	Connections bool          `json:"connections"`
+	Kind        *IndexKind    `json:"kind"`
+	Fields      []string      `json:"fields"`
+	Metric      *VectorMetric `json:"metric"`
+	Dimensions  *int          `json:"dimensions"`
 }
 
 type Indexes struct {
@@ -143,6 +427,22 @@ type IndexesInput struct {
 	Indexes []*IndexInput `json:"indexes"`
 }
 
+type Match struct {
+	Docs        []*DocBinding        `json:"docs"`
+	Connections []*ConnectionBinding `json:"connections"`
+}
+
+type MatchFilter struct {
+	Nodes  []*NodePattern `json:"nodes"`
+	Edges  []*EdgePattern `json:"edges"`
+	Limit  int            `json:"limit"`
+	Budget *Budget        `json:"budget"`
+}
+
+type Matches struct {
+	Matches []*Match `json:"matches"`
+}
+
 type Message struct {
 	Channel   string                 `json:"channel"`
 	Data      map[string]interface{} `json:"data"`
@@ -151,11 +451,100 @@ type Message struct {
 	Method    string                 `json:"method"`
 }
 
+type Migration struct {
+	Version              int                   `json:"version"`
+	Description          *string               `json:"description"`
+	RenameDocs           []*TypeRename         `json:"rename_docs"`
+	RenameConnections    []*TypeRename         `json:"rename_connections"`
+	TransformDocs        []*AttributeTransform `json:"transform_docs"`
+	TransformConnections []*AttributeTransform `json:"transform_connections"`
+}
+
+type MigrationInput struct {
+	Version              int                        `json:"version"`
+	Description          *string                    `json:"description"`
+	RenameDocs           []*TypeRenameInput         `json:"rename_docs"`
+	RenameConnections    []*TypeRenameInput         `json:"rename_connections"`
+	TransformDocs        []*AttributeTransformInput `json:"transform_docs"`
+	TransformConnections []*AttributeTransformInput `json:"transform_connections"`
+}
+
+type MigrationStatus struct {
+	Migration   *Migration `json:"migration"`
+	State       JobState   `json:"state"`
+	Affected    int        `json:"affected"`
+	Error       *string    `json:"error"`
+	User        *Ref       `json:"user"`
+	StartedAt   *time.Time `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+}
+
+type MigrationStatuses struct {
+	Migrations []*MigrationStatus `json:"migrations"`
+}
+
+type MutationResult struct {
+	Affected    int          `json:"affected"`
+	Refs        []*Ref       `json:"refs"`
+	Cascaded    []*Ref       `json:"cascaded"`
+	DryRun      bool         `json:"dry_run"`
+	Docs        *Docs        `json:"docs"`
+	Connections *Connections `json:"connections"`
+}
+
+type NeighborDoc struct {
+	Doc    *Doc    `json:"doc"`
+	Score  float64 `json:"score"`
+	Common int     `json:"common"`
+}
+
+type NeighborDocs struct {
+	Docs []*NeighborDoc `json:"docs"`
+}
+
+type NeighborFilter struct {
+	Doc              *RefInput       `json:"doc"`
+	Gtype            string          `json:"gtype"`
+	Direction        *Direction      `json:"direction"`
+	Metric           *NeighborMetric `json:"metric"`
+	Expression       *string         `json:"expression"`
+	ExcludeNeighbors *bool           `json:"exclude_neighbors"`
+	Limit            int             `json:"limit"`
+	Budget           *Budget         `json:"budget"`
+}
+
+type NodePattern struct {
+	Name       *string   `json:"name"`
+	Gtype      *string   `json:"gtype"`
+	Expression *string   `json:"expression"`
+	Ref        *RefInput `json:"ref"`
+}
+
 type OutboundMessage struct {
 	Channel string                 `json:"channel"`
 	Data    map[string]interface{} `json:"data"`
 }
 
+type Path struct {
+	Docs        []*Doc        `json:"docs"`
+	Connections []*Connection `json:"connections"`
+	Cost        float64       `json:"cost"`
+}
+
+type PathFilter struct {
+	Source               *RefInput `json:"source"`
+	Target               *RefInput `json:"target"`
+	ConnectionExpression *string   `json:"connection_expression"`
+	Weight               *string   `json:"weight"`
+	Reverse              *bool     `json:"reverse"`
+	K                    *int      `json:"k"`
+	Budget               *Budget   `json:"budget"`
+}
+
+type Paths struct {
+	Paths []*Path `json:"paths"`
+}
+
 type Pong struct {
 	Message string `json:"message"`
 }
@@ -199,6 +588,15 @@ type Schema struct {
 	Indexes         *Indexes        `json:"indexes"`
 }
 
+type ScoredDoc struct {
+	Doc   *Doc    `json:"doc"`
+	Score float64 `json:"score"`
+}
+
+type ScoredDocs struct {
+	Docs []*ScoredDoc `json:"docs"`
+}
+
 type SearchConnectFilter struct {
 	Filter     *Filter                `json:"filter"`
 	Gtype      string                 `json:"gtype"`
@@ -214,11 +612,65 @@ type SearchConnectMeFilter struct {
 	Directed   bool                   `json:"directed"`
 }
 
+type SimilarDoc struct {
+	Doc      *Doc    `json:"doc"`
+	Distance float64 `json:"distance"`
+}
+
+type SimilarDocs struct {
+	Docs []*SimilarDoc `json:"docs"`
+}
+
+type SimilarFilter struct {
+	Index      string          `json:"index"`
+	Vector     []float64       `json:"vector"`
+	Like       *RefInput       `json:"like"`
+	Limit      int             `json:"limit"`
+	Expression *string         `json:"expression"`
+	Traverse   *TraverseFilter `json:"traverse"`
+	Exact      *bool           `json:"exact"`
+	Ef         *int            `json:"ef"`
+}
+
 type StreamFilter struct {
 	Channel    string  `json:"channel"`
 	Expression *string `json:"expression"`
 }
 
+type Subgraph struct {
+	DocGtypes            []string `json:"doc_gtypes"`
+	DocExpression        *string  `json:"doc_expression"`
+	ConnectionGtypes     []string `json:"connection_gtypes"`
+	ConnectionExpression *string  `json:"connection_expression"`
+}
+
+type SubgraphInput struct {
+	DocGtypes            []string `json:"doc_gtypes"`
+	DocExpression        *string  `json:"doc_expression"`
+	ConnectionGtypes     []string `json:"connection_gtypes"`
+	ConnectionExpression *string  `json:"connection_expression"`
+}
+
+type TextSearchFilter struct {
+	Index      string  `json:"index"`
+	Query      string  `json:"query"`
+	Limit      int     `json:"limit"`
+	Expression *string `json:"expression"`
+}
+
+type TopologicalOrder struct {
+	Acyclic bool    `json:"acyclic"`
+	Refs    []*Ref  `json:"refs"`
+	Cycles  []*Path `json:"cycles"`
+}
+
+type TopologicalSortFilter struct {
+	Gtype      string  `json:"gtype"`
+	Expression *string `json:"expression"`
+	MaxCycles  *int    `json:"max_cycles"`
+	Budget     *Budget `json:"budget"`
+}
+
 type Traversal struct {
 	Doc           *Doc   `json:"doc"`
 	TraversalPath []*Ref `json:"traversal_path"`
@@ -240,6 +692,8 @@ type TraverseFilter struct {
 	Algorithm            *Algorithm `json:"algorithm"`
 	MaxDepth             int        `json:"max_depth"`
 	MaxHops              int        `json:"max_hops"`
+	Budget               *Budget    `json:"budget"`
+	Direction            *Direction `json:"direction"`
 }
 
 type TraverseMeFilter struct {
@@ -251,6 +705,18 @@ type TraverseMeFilter struct {
 	Algorithm            *Algorithm `json:"algorithm"`
 	MaxDepth             int        `json:"max_depth"`
 	MaxHops              int        `json:"max_hops"`
+	Budget               *Budget    `json:"budget"`
+	Direction            *Direction `json:"direction"`
+}
+
+type TypeRename struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type TypeRenameInput struct {
+	From string `json:"from"`
+	To   string `json:"to"`
 }
 
 type TypeValidator struct {
@@ -259,14 +725,16 @@ type TypeValidator struct {
 	Expression  string `json:"expression"`
 	Docs        bool   `json:"docs"`
 	Connections bool   `json:"connections"`
+	Acyclic     bool   `json:"acyclic"`
 }
 
 type TypeValidatorInput struct {
-	Name        string `json:"name"`
-	Gtype       string `json:"gtype"`
-	Expression  string `json:"expression"`
-	Docs        bool   `json:"docs"`
-	Connections bool   `json:"connections"`
+	Name        string  `json:"name"`
+	Gtype       string  `json:"gtype"`
+	Expression  *string `json:"expression"`
+	Docs        bool    `json:"docs"`
+	Connections bool    `json:"connections"`
+	Acyclic     *bool   `json:"acyclic"`
 }
 
 type TypeValidators struct {
@@ -280,12 +748,16 @@ type TypeValidatorsInput struct {
 type Aggregate string
 
 const (
-	AggregateCount Aggregate = "COUNT"
-	AggregateSum   Aggregate = "SUM"
-	AggregateAvg   Aggregate = "AVG"
-	AggregateMax   Aggregate = "MAX"
-	AggregateMin   Aggregate = "MIN"
-	AggregateProd  Aggregate = "PROD"
+	AggregateCount         Aggregate = "COUNT"
+	AggregateSum           Aggregate = "SUM"
+	AggregateAvg           Aggregate = "AVG"
+	AggregateMax           Aggregate = "MAX"
+	AggregateMin           Aggregate = "MIN"
+	AggregateProd          Aggregate = "PROD"
+	AggregateCountDistinct Aggregate = "COUNT_DISTINCT"
+	AggregatePercentile    Aggregate = "PERCENTILE"
+	AggregateStddev        Aggregate = "STDDEV"
+	AggregateHistogram     Aggregate = "HISTOGRAM"
 )
 
 var AllAggregate = []Aggregate{
@@ -295,11 +767,15 @@ var AllAggregate = []Aggregate{
 	AggregateMax,
 	AggregateMin,
 	AggregateProd,
+	AggregateCountDistinct,
+	AggregatePercentile,
+	AggregateStddev,
+	AggregateHistogram,
 }
 
 func (e Aggregate) IsValid() bool {
 	switch e {
-	case AggregateCount, AggregateSum, AggregateAvg, AggregateMax, AggregateMin, AggregateProd:
+	case AggregateCount, AggregateSum, AggregateAvg, AggregateMax, AggregateMin, AggregateProd, AggregateCountDistinct, AggregatePercentile, AggregateStddev, AggregateHistogram:
 		return true
 	}
 	return false
@@ -366,3 +842,273 @@ func (e *Algorithm) UnmarshalGQL(v interface{}) error {
 func (e Algorithm) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type AnalyticsAlgorithm string
+
+const (
+	AnalyticsAlgorithmPagerank         AnalyticsAlgorithm = "PAGERANK"
+	AnalyticsAlgorithmDegree           AnalyticsAlgorithm = "DEGREE"
+	AnalyticsAlgorithmBetweenness      AnalyticsAlgorithm = "BETWEENNESS"
+	AnalyticsAlgorithmWeakComponents   AnalyticsAlgorithm = "WEAK_COMPONENTS"
+	AnalyticsAlgorithmStrongComponents AnalyticsAlgorithm = "STRONG_COMPONENTS"
+	AnalyticsAlgorithmLouvain          AnalyticsAlgorithm = "LOUVAIN"
+)
+
+var AllAnalyticsAlgorithm = []AnalyticsAlgorithm{
+	AnalyticsAlgorithmPagerank,
+	AnalyticsAlgorithmDegree,
+	AnalyticsAlgorithmBetweenness,
+	AnalyticsAlgorithmWeakComponents,
+	AnalyticsAlgorithmStrongComponents,
+	AnalyticsAlgorithmLouvain,
+}
+
+func (e AnalyticsAlgorithm) IsValid() bool {
+	switch e {
+	case AnalyticsAlgorithmPagerank, AnalyticsAlgorithmDegree, AnalyticsAlgorithmBetweenness, AnalyticsAlgorithmWeakComponents, AnalyticsAlgorithmStrongComponents, AnalyticsAlgorithmLouvain:
+		return true
+	}
+	return false
+}
+
+func (e AnalyticsAlgorithm) String() string {
+	return string(e)
+}
+
+func (e *AnalyticsAlgorithm) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AnalyticsAlgorithm(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AnalyticsAlgorithm", str)
+	}
+	return nil
+}
+
+func (e AnalyticsAlgorithm) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type Direction string
+
+const (
+	DirectionOut  Direction = "OUT"
+	DirectionIn   Direction = "IN"
+	DirectionBoth Direction = "BOTH"
+)
+
+var AllDirection = []Direction{
+	DirectionOut,
+	DirectionIn,
+	DirectionBoth,
+}
+
+func (e Direction) IsValid() bool {
+	switch e {
+	case DirectionOut, DirectionIn, DirectionBoth:
+		return true
+	}
+	return false
+}
+
+func (e Direction) String() string {
+	return string(e)
+}
+
+func (e *Direction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Direction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Direction", str)
+	}
+	return nil
+}
+
+func (e Direction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type IndexKind string
+
+const (
+	IndexKindExpression IndexKind = "EXPRESSION"
+	IndexKindFullText   IndexKind = "FULL_TEXT"
+	IndexKindGeo        IndexKind = "GEO"
+	IndexKindVector     IndexKind = "VECTOR"
+	IndexKindValue      IndexKind = "VALUE"
+)
+
+var AllIndexKind = []IndexKind{
+	IndexKindExpression,
+	IndexKindFullText,
+	IndexKindGeo,
+	IndexKindVector,
+	IndexKindValue,
+}
+
+func (e IndexKind) IsValid() bool {
+	switch e {
+	case IndexKindExpression, IndexKindFullText, IndexKindGeo, IndexKindVector, IndexKindValue:
+		return true
+	}
+	return false
+}
+
+func (e IndexKind) String() string {
+	return string(e)
+}
+
+func (e *IndexKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = IndexKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid IndexKind", str)
+	}
+	return nil
+}
+
+func (e IndexKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type JobState string
+
+const (
+	JobStateRunning   JobState = "RUNNING"
+	JobStateComplete  JobState = "COMPLETE"
+	JobStateFailed    JobState = "FAILED"
+	JobStateCancelled JobState = "CANCELLED"
+)
+
+var AllJobState = []JobState{
+	JobStateRunning,
+	JobStateComplete,
+	JobStateFailed,
+	JobStateCancelled,
+}
+
+func (e JobState) IsValid() bool {
+	switch e {
+	case JobStateRunning, JobStateComplete, JobStateFailed, JobStateCancelled:
+		return true
+	}
+	return false
+}
+
+func (e JobState) String() string {
+	return string(e)
+}
+
+func (e *JobState) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = JobState(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid JobState", str)
+	}
+	return nil
+}
+
+func (e JobState) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type NeighborMetric string
+
+const (
+	NeighborMetricCommonNeighbors NeighborMetric = "COMMON_NEIGHBORS"
+	NeighborMetricJaccard         NeighborMetric = "JACCARD"
+	NeighborMetricAdamicAdar      NeighborMetric = "ADAMIC_ADAR"
+)
+
+var AllNeighborMetric = []NeighborMetric{
+	NeighborMetricCommonNeighbors,
+	NeighborMetricJaccard,
+	NeighborMetricAdamicAdar,
+}
+
+func (e NeighborMetric) IsValid() bool {
+	switch e {
+	case NeighborMetricCommonNeighbors, NeighborMetricJaccard, NeighborMetricAdamicAdar:
+		return true
+	}
+	return false
+}
+
+func (e NeighborMetric) String() string {
+	return string(e)
+}
+
+func (e *NeighborMetric) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NeighborMetric(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NeighborMetric", str)
+	}
+	return nil
+}
+
+func (e NeighborMetric) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type VectorMetric string
+
+const (
+	VectorMetricCosine VectorMetric = "COSINE"
+	VectorMetricDot    VectorMetric = "DOT"
+	VectorMetricL2     VectorMetric = "L2"
+)
+
+var AllVectorMetric = []VectorMetric{
+	VectorMetricCosine,
+	VectorMetricDot,
+	VectorMetricL2,
+}
+
+func (e VectorMetric) IsValid() bool {
+	switch e {
+	case VectorMetricCosine, VectorMetricDot, VectorMetricL2:
+		return true
+	}
+	return false
+}
+
+func (e VectorMetric) String() string {
+	return string(e)
+}
+
+func (e *VectorMetric) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = VectorMetric(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid VectorMetric", str)
+	}
+	return nil
+}
+
+func (e VectorMetric) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
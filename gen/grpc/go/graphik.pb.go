@@ -9,6 +9,7 @@ package apipb
 import (
 	context "context"
 	_ "github.com/golang/protobuf/ptypes/any"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	empty "github.com/golang/protobuf/ptypes/empty"
 	_struct "github.com/golang/protobuf/ptypes/struct"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
@@ -77,6 +78,230 @@ func (Algorithm) EnumDescriptor() ([]byte, []int) {
 	return file_graphik_proto_rawDescGZIP(), []int{0}
 }
 
+// Direction is the direction connections are followed in
+type Direction int32
+
+const (
+	// OUT follows connections from their from doc to their to doc
+	Direction_OUT Direction = 0
+	// IN follows connections from their to doc to their from doc
+	Direction_IN Direction = 1
+	// BOTH follows connections in either direction
+	Direction_BOTH Direction = 2
+)
+
+// Enum value maps for Direction.
+var (
+	Direction_name = map[int32]string{
+		0: "OUT",
+		1: "IN",
+		2: "BOTH",
+	}
+	Direction_value = map[string]int32{
+		"OUT":  0,
+		"IN":   1,
+		"BOTH": 2,
+	}
+)
+
+func (x Direction) Enum() *Direction {
+	p := new(Direction)
+	*p = x
+	return p
+}
+
+func (x Direction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Direction) Descriptor() protoreflect.EnumDescriptor {
+	return file_graphik_proto_enumTypes[1].Descriptor()
+}
+
+func (Direction) Type() protoreflect.EnumType {
+	return &file_graphik_proto_enumTypes[1]
+}
+
+func (x Direction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Direction.Descriptor instead.
+func (Direction) EnumDescriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{1}
+}
+
+// JobState is the state of a background job
+type JobState int32
+
+const (
+	JobState_RUNNING   JobState = 0
+	JobState_COMPLETE  JobState = 1
+	JobState_FAILED    JobState = 2
+	JobState_CANCELLED JobState = 3
+)
+
+// Enum value maps for JobState.
+var (
+	JobState_name = map[int32]string{
+		0: "RUNNING",
+		1: "COMPLETE",
+		2: "FAILED",
+		3: "CANCELLED",
+	}
+	JobState_value = map[string]int32{
+		"RUNNING":   0,
+		"COMPLETE":  1,
+		"FAILED":    2,
+		"CANCELLED": 3,
+	}
+)
+
+func (x JobState) Enum() *JobState {
+	p := new(JobState)
+	*p = x
+	return p
+}
+
+func (x JobState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobState) Descriptor() protoreflect.EnumDescriptor {
+	return file_graphik_proto_enumTypes[2].Descriptor()
+}
+
+func (JobState) Type() protoreflect.EnumType {
+	return &file_graphik_proto_enumTypes[2]
+}
+
+func (x JobState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobState.Descriptor instead.
+func (JobState) EnumDescriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{2}
+}
+
+// NeighborMetric is the metric used to score the similarity of two docs' neighborhoods
+type NeighborMetric int32
+
+const (
+	// COMMON_NEIGHBORS is the number of neighbors the docs share
+	NeighborMetric_COMMON_NEIGHBORS NeighborMetric = 0
+	// JACCARD is the number of neighbors the docs share divided by the number of neighbors either doc has
+	NeighborMetric_JACCARD NeighborMetric = 1
+	// ADAMIC_ADAR is the sum of 1 / log(degree) of each neighbor the docs share - neighbors with fewer connections count for more
+	NeighborMetric_ADAMIC_ADAR NeighborMetric = 2
+)
+
+// Enum value maps for NeighborMetric.
+var (
+	NeighborMetric_name = map[int32]string{
+		0: "COMMON_NEIGHBORS",
+		1: "JACCARD",
+		2: "ADAMIC_ADAR",
+	}
+	NeighborMetric_value = map[string]int32{
+		"COMMON_NEIGHBORS": 0,
+		"JACCARD":          1,
+		"ADAMIC_ADAR":      2,
+	}
+)
+
+func (x NeighborMetric) Enum() *NeighborMetric {
+	p := new(NeighborMetric)
+	*p = x
+	return p
+}
+
+func (x NeighborMetric) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NeighborMetric) Descriptor() protoreflect.EnumDescriptor {
+	return file_graphik_proto_enumTypes[3].Descriptor()
+}
+
+func (NeighborMetric) Type() protoreflect.EnumType {
+	return &file_graphik_proto_enumTypes[3]
+}
+
+func (x NeighborMetric) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NeighborMetric.Descriptor instead.
+func (NeighborMetric) EnumDescriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{3}
+}
+
+// AnalyticsAlgorithm is a graph analytics algorithm
+type AnalyticsAlgorithm int32
+
+const (
+	// PAGERANK scores docs by the rank that flows to them along connections
+	AnalyticsAlgorithm_PAGERANK AnalyticsAlgorithm = 0
+	// DEGREE scores docs by their number of connections divided by the number of other docs
+	AnalyticsAlgorithm_DEGREE AnalyticsAlgorithm = 1
+	// BETWEENNESS scores docs by the fraction of shortest paths between other docs that pass through them
+	AnalyticsAlgorithm_BETWEENNESS AnalyticsAlgorithm = 2
+	// WEAK_COMPONENTS labels docs with the id of their weakly connected component(direction is ignored)
+	AnalyticsAlgorithm_WEAK_COMPONENTS AnalyticsAlgorithm = 3
+	// STRONG_COMPONENTS labels docs with the id of their strongly connected component - every doc in it can reach every other doc in it
+	AnalyticsAlgorithm_STRONG_COMPONENTS AnalyticsAlgorithm = 4
+	// LOUVAIN labels docs with the id of their community(direction is ignored) - communities are densely connected internally & sparsely connected to each other
+	AnalyticsAlgorithm_LOUVAIN AnalyticsAlgorithm = 5
+)
+
+// Enum value maps for AnalyticsAlgorithm.
+var (
+	AnalyticsAlgorithm_name = map[int32]string{
+		0: "PAGERANK",
+		1: "DEGREE",
+		2: "BETWEENNESS",
+		3: "WEAK_COMPONENTS",
+		4: "STRONG_COMPONENTS",
+		5: "LOUVAIN",
+	}
+	AnalyticsAlgorithm_value = map[string]int32{
+		"PAGERANK":          0,
+		"DEGREE":            1,
+		"BETWEENNESS":       2,
+		"WEAK_COMPONENTS":   3,
+		"STRONG_COMPONENTS": 4,
+		"LOUVAIN":           5,
+	}
+)
+
+func (x AnalyticsAlgorithm) Enum() *AnalyticsAlgorithm {
+	p := new(AnalyticsAlgorithm)
+	*p = x
+	return p
+}
+
+func (x AnalyticsAlgorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnalyticsAlgorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_graphik_proto_enumTypes[4].Descriptor()
+}
+
+func (AnalyticsAlgorithm) Type() protoreflect.EnumType {
+	return &file_graphik_proto_enumTypes[4]
+}
+
+func (x AnalyticsAlgorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnalyticsAlgorithm.Descriptor instead.
+func (AnalyticsAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{4}
+}
+
 type Aggregate int32
 
 const (
@@ -86,6 +311,14 @@ const (
 	Aggregate_MAX   Aggregate = 3
 	Aggregate_MIN   Aggregate = 4
 	Aggregate_PROD  Aggregate = 5
+	// COUNT_DISTINCT counts the number of distinct values of a field
+	Aggregate_COUNT_DISTINCT Aggregate = 6
+	// PERCENTILE finds the value at a percentile(ex: p50, p90, p99) of a field
+	Aggregate_PERCENTILE Aggregate = 7
+	// STDDEV calculates the (population) standard deviation of a field
+	Aggregate_STDDEV Aggregate = 8
+	// HISTOGRAM counts the values of a field in fixed size buckets(grouped aggregations only)
+	Aggregate_HISTOGRAM Aggregate = 9
 )
 
 // Enum value maps for Aggregate.
@@ -97,14 +330,22 @@ var (
 		3: "MAX",
 		4: "MIN",
 		5: "PROD",
+		6: "COUNT_DISTINCT",
+		7: "PERCENTILE",
+		8: "STDDEV",
+		9: "HISTOGRAM",
 	}
 	Aggregate_value = map[string]int32{
-		"COUNT": 0,
-		"SUM":   1,
-		"AVG":   2,
-		"MAX":   3,
-		"MIN":   4,
-		"PROD":  5,
+		"COUNT":          0,
+		"SUM":            1,
+		"AVG":            2,
+		"MAX":            3,
+		"MIN":            4,
+		"PROD":           5,
+		"COUNT_DISTINCT": 6,
+		"PERCENTILE":     7,
+		"STDDEV":         8,
+		"HISTOGRAM":      9,
 	}
 )
 
@@ -119,11 +360,11 @@ func (x Aggregate) String() string {
 }
 
 func (Aggregate) Descriptor() protoreflect.EnumDescriptor {
-	return file_graphik_proto_enumTypes[1].Descriptor()
+	return file_graphik_proto_enumTypes[5].Descriptor()
 }
 
 func (Aggregate) Type() protoreflect.EnumType {
-	return &file_graphik_proto_enumTypes[1]
+	return &file_graphik_proto_enumTypes[5]
 }
 
 func (x Aggregate) Number() protoreflect.EnumNumber {
@@ -132,7 +373,119 @@ func (x Aggregate) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Aggregate.Descriptor instead.
 func (Aggregate) EnumDescriptor() ([]byte, []int) {
-	return file_graphik_proto_rawDescGZIP(), []int{1}
+	return file_graphik_proto_rawDescGZIP(), []int{5}
+}
+
+// IndexKind is the kind of data structure an index maintains
+type IndexKind int32
+
+const (
+	// EXPRESSION indexes docs/connections that pass a CEL expression
+	IndexKind_EXPRESSION IndexKind = 0
+	// FULL_TEXT maintains an inverted index of the terms in one or more doc attributes. It's refused while encryption is enabled.
+	IndexKind_FULL_TEXT IndexKind = 1
+	// GEO maintains a geohash index of the coordinates in two doc attributes(latitude & longitude). It's refused while encryption is enabled.
+	IndexKind_GEO IndexKind = 2
+	// VECTOR maintains a nearest neighbor index of the embeddings(numeric lists) in a doc attribute. It's refused while encryption is enabled.
+	IndexKind_VECTOR IndexKind = 3
+	// VALUE maintains a sorted index of the values(strings, numbers & booleans) of a doc attribute. It's used by the query planner
+	// to search docs by equality & range comparisons ex: this.attributes.age >= 21. It's refused while encryption is enabled.
+	IndexKind_VALUE IndexKind = 4
+)
+
+// Enum value maps for IndexKind.
+var (
+	IndexKind_name = map[int32]string{
+		0: "EXPRESSION",
+		1: "FULL_TEXT",
+		2: "GEO",
+		3: "VECTOR",
+		4: "VALUE",
+	}
+	IndexKind_value = map[string]int32{
+		"EXPRESSION": 0,
+		"FULL_TEXT":  1,
+		"GEO":        2,
+		"VECTOR":     3,
+		"VALUE":      4,
+	}
+)
+
+func (x IndexKind) Enum() *IndexKind {
+	p := new(IndexKind)
+	*p = x
+	return p
+}
+
+func (x IndexKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IndexKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_graphik_proto_enumTypes[6].Descriptor()
+}
+
+func (IndexKind) Type() protoreflect.EnumType {
+	return &file_graphik_proto_enumTypes[6]
+}
+
+func (x IndexKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IndexKind.Descriptor instead.
+func (IndexKind) EnumDescriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{6}
+}
+
+// VectorMetric is the distance metric used by a VECTOR index
+type VectorMetric int32
+
+const (
+	VectorMetric_COSINE VectorMetric = 0
+	VectorMetric_DOT    VectorMetric = 1
+	VectorMetric_L2     VectorMetric = 2
+)
+
+// Enum value maps for VectorMetric.
+var (
+	VectorMetric_name = map[int32]string{
+		0: "COSINE",
+		1: "DOT",
+		2: "L2",
+	}
+	VectorMetric_value = map[string]int32{
+		"COSINE": 0,
+		"DOT":    1,
+		"L2":     2,
+	}
+)
+
+func (x VectorMetric) Enum() *VectorMetric {
+	p := new(VectorMetric)
+	*p = x
+	return p
+}
+
+func (x VectorMetric) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VectorMetric) Descriptor() protoreflect.EnumDescriptor {
+	return file_graphik_proto_enumTypes[7].Descriptor()
+}
+
+func (VectorMetric) Type() protoreflect.EnumType {
+	return &file_graphik_proto_enumTypes[7]
+}
+
+func (x VectorMetric) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VectorMetric.Descriptor instead.
+func (VectorMetric) EnumDescriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{7}
 }
 
 // Ref describes a doc/connection type & id
@@ -367,6 +720,9 @@ type DocConstructor struct {
 	Ref *RefConstructor `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
 	// arbitrary k/v pairs
 	Attributes *_struct.Struct `protobuf:"bytes,2,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// idempotency_key is an optional client generated key. Repeated requests with the same key return the originally created doc
+	// instead of creating a new one. It may also be set via the x-graphik-idempotency-key request metadata.
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
 func (x *DocConstructor) Reset() {
@@ -415,6 +771,13 @@ func (x *DocConstructor) GetAttributes() *_struct.Struct {
 	return nil
 }
 
+func (x *DocConstructor) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 // DocConstructor is used to create a batch of docs
 type DocConstructors struct {
 	state         protoimpl.MessageState
@@ -582,19 +945,30 @@ func (x *Traversals) GetTraversals() []*Traversal {
 	return nil
 }
 
-// Docs is an array of docs
-type Docs struct {
+// PathFilter is used to find the shortest path(s) between two docs
+type PathFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// docs is an array of docs
-	Docs     []*Doc `protobuf:"bytes,1,rep,name=docs,proto3" json:"docs,omitempty"`
-	SeekNext string `protobuf:"bytes,2,opt,name=seek_next,json=seekNext,proto3" json:"seek_next,omitempty"`
-}
-
-func (x *Docs) Reset() {
-	*x = Docs{}
+	// source is the doc the paths start from
+	Source *Ref `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// target is the doc the paths end at
+	Target *Ref `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// connection_expression is a boolean CEL expression used to determine which connections may be traversed
+	ConnectionExpression string `protobuf:"bytes,3,opt,name=connection_expression,json=connectionExpression,proto3" json:"connection_expression,omitempty"`
+	// weight is a CEL expression that returns the non-negative weight of a connection ex: this.attributes.distance. If empty, every connection has a weight of 1.
+	Weight string `protobuf:"bytes,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	// reverse the direction of the connection traversal
+	Reverse bool `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	// k is the number of shortest paths(at most 100) to return in order of cost(Yen's algorithm) - defaults to 1
+	K uint32 `protobuf:"varint,6,opt,name=k,proto3" json:"k,omitempty"`
+	// budget limits the work done by the search
+	Budget *Budget `protobuf:"bytes,7,opt,name=budget,proto3" json:"budget,omitempty"`
+}
+
+func (x *PathFilter) Reset() {
+	*x = PathFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_graphik_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -602,13 +976,13 @@ func (x *Docs) Reset() {
 	}
 }
 
-func (x *Docs) String() string {
+func (x *PathFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Docs) ProtoMessage() {}
+func (*PathFilter) ProtoMessage() {}
 
-func (x *Docs) ProtoReflect() protoreflect.Message {
+func (x *PathFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_graphik_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -620,45 +994,86 @@ func (x *Docs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Docs.ProtoReflect.Descriptor instead.
-func (*Docs) Descriptor() ([]byte, []int) {
+// Deprecated: Use PathFilter.ProtoReflect.Descriptor instead.
+func (*PathFilter) Descriptor() ([]byte, []int) {
 	return file_graphik_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Docs) GetDocs() []*Doc {
+func (x *PathFilter) GetSource() *Ref {
 	if x != nil {
-		return x.Docs
+		return x.Source
 	}
 	return nil
 }
 
-func (x *Docs) GetSeekNext() string {
+func (x *PathFilter) GetTarget() *Ref {
 	if x != nil {
-		return x.SeekNext
+		return x.Target
+	}
+	return nil
+}
+
+func (x *PathFilter) GetConnectionExpression() string {
+	if x != nil {
+		return x.ConnectionExpression
 	}
 	return ""
 }
 
-// Connection is a graph primitive that represents a relationship between two docs
-type Connection struct {
+func (x *PathFilter) GetWeight() string {
+	if x != nil {
+		return x.Weight
+	}
+	return ""
+}
+
+func (x *PathFilter) GetReverse() bool {
+	if x != nil {
+		return x.Reverse
+	}
+	return false
+}
+
+func (x *PathFilter) GetK() uint32 {
+	if x != nil {
+		return x.K
+	}
+	return 0
+}
+
+func (x *PathFilter) GetBudget() *Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+// AllPathsFilter is used to find every path between two docs
+type AllPathsFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ref is the ref to the connection
-	Ref *Ref `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
-	// attributes are k/v pairs
-	Attributes *_struct.Struct `protobuf:"bytes,2,opt,name=attributes,proto3" json:"attributes,omitempty"`
-	// directed is false if the connection is bi-directional
-	Directed bool `protobuf:"varint,3,opt,name=directed,proto3" json:"directed,omitempty"`
-	// from is the doc ref that is the source of the connection
-	From *Ref `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
-	// to is the doc ref that is the destination of the connection
-	To *Ref `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	// source is the doc the paths start from
+	Source *Ref `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// target is the doc the paths end at
+	Target *Ref `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// doc_expression is a boolean CEL expression used to determine which docs a path may pass through(it's not applied to the source & target)
+	DocExpression string `protobuf:"bytes,3,opt,name=doc_expression,json=docExpression,proto3" json:"doc_expression,omitempty"`
+	// connection_expression is a boolean CEL expression used to determine which connections may be traversed
+	ConnectionExpression string `protobuf:"bytes,4,opt,name=connection_expression,json=connectionExpression,proto3" json:"connection_expression,omitempty"`
+	// max_depth is the maximum number of connections in a path
+	MaxDepth uint64 `protobuf:"varint,5,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	// max_paths is the maximum number of paths to return
+	MaxPaths uint64 `protobuf:"varint,6,opt,name=max_paths,json=maxPaths,proto3" json:"max_paths,omitempty"`
+	// reverse the direction of the connection traversal
+	Reverse bool `protobuf:"varint,7,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	// budget limits the work done by the search
+	Budget *Budget `protobuf:"bytes,8,opt,name=budget,proto3" json:"budget,omitempty"`
 }
 
-func (x *Connection) Reset() {
-	*x = Connection{}
+func (x *AllPathsFilter) Reset() {
+	*x = AllPathsFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_graphik_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -666,13 +1081,13 @@ func (x *Connection) Reset() {
 	}
 }
 
-func (x *Connection) String() string {
+func (x *AllPathsFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Connection) ProtoMessage() {}
+func (*AllPathsFilter) ProtoMessage() {}
 
-func (x *Connection) ProtoReflect() protoreflect.Message {
+func (x *AllPathsFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_graphik_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -684,66 +1099,83 @@ func (x *Connection) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Connection.ProtoReflect.Descriptor instead.
-func (*Connection) Descriptor() ([]byte, []int) {
+// Deprecated: Use AllPathsFilter.ProtoReflect.Descriptor instead.
+func (*AllPathsFilter) Descriptor() ([]byte, []int) {
 	return file_graphik_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Connection) GetRef() *Ref {
+func (x *AllPathsFilter) GetSource() *Ref {
 	if x != nil {
-		return x.Ref
+		return x.Source
 	}
 	return nil
 }
 
-func (x *Connection) GetAttributes() *_struct.Struct {
+func (x *AllPathsFilter) GetTarget() *Ref {
 	if x != nil {
-		return x.Attributes
+		return x.Target
 	}
 	return nil
 }
 
-func (x *Connection) GetDirected() bool {
+func (x *AllPathsFilter) GetDocExpression() string {
 	if x != nil {
-		return x.Directed
+		return x.DocExpression
 	}
-	return false
+	return ""
 }
 
-func (x *Connection) GetFrom() *Ref {
+func (x *AllPathsFilter) GetConnectionExpression() string {
 	if x != nil {
-		return x.From
+		return x.ConnectionExpression
 	}
-	return nil
+	return ""
 }
 
-func (x *Connection) GetTo() *Ref {
+func (x *AllPathsFilter) GetMaxDepth() uint64 {
 	if x != nil {
-		return x.To
+		return x.MaxDepth
 	}
-	return nil
+	return 0
 }
 
-// ConnectionConstructor is used to create an Connection
-type ConnectionConstructor struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// ref is the ref to the new Connection. If an id isn't present, one will be generated.
-	Ref *RefConstructor `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
-	// attributes are k/v pairs
-	Attributes *_struct.Struct `protobuf:"bytes,3,opt,name=attributes,proto3" json:"attributes,omitempty"`
-	// directed is false if the connection is bi-directional
-	Directed bool `protobuf:"varint,4,opt,name=directed,proto3" json:"directed,omitempty"`
-	// from is the doc ref that is the root of the connection
-	From *Ref `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
-	// to is the doc ref that is the destination of the connection
-	To *Ref `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+func (x *AllPathsFilter) GetMaxPaths() uint64 {
+	if x != nil {
+		return x.MaxPaths
+	}
+	return 0
 }
 
-func (x *ConnectionConstructor) Reset() {
-	*x = ConnectionConstructor{}
+func (x *AllPathsFilter) GetReverse() bool {
+	if x != nil {
+		return x.Reverse
+	}
+	return false
+}
+
+func (x *AllPathsFilter) GetBudget() *Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+// Path is an ordered list of docs & the connections between them(docs[0] -> connections[0] -> docs[1] ...)
+type Path struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// docs are the docs of the path in order, starting with the source & ending with the target
+	Docs []*Doc `protobuf:"bytes,1,rep,name=docs,proto3" json:"docs,omitempty"`
+	// connections are the connections between the docs in order
+	Connections []*Connection `protobuf:"bytes,2,rep,name=connections,proto3" json:"connections,omitempty"`
+	// cost is the sum of the weights of the connections
+	Cost float64 `protobuf:"fixed64,3,opt,name=cost,proto3" json:"cost,omitempty"`
+}
+
+func (x *Path) Reset() {
+	*x = Path{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_graphik_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -751,13 +1183,13 @@ func (x *ConnectionConstructor) Reset() {
 	}
 }
 
-func (x *ConnectionConstructor) String() string {
+func (x *Path) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectionConstructor) ProtoMessage() {}
+func (*Path) ProtoMessage() {}
 
-func (x *ConnectionConstructor) ProtoReflect() protoreflect.Message {
+func (x *Path) ProtoReflect() protoreflect.Message {
 	mi := &file_graphik_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -769,63 +1201,43 @@ func (x *ConnectionConstructor) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectionConstructor.ProtoReflect.Descriptor instead.
-func (*ConnectionConstructor) Descriptor() ([]byte, []int) {
+// Deprecated: Use Path.ProtoReflect.Descriptor instead.
+func (*Path) Descriptor() ([]byte, []int) {
 	return file_graphik_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ConnectionConstructor) GetRef() *RefConstructor {
-	if x != nil {
-		return x.Ref
-	}
-	return nil
-}
-
-func (x *ConnectionConstructor) GetAttributes() *_struct.Struct {
+func (x *Path) GetDocs() []*Doc {
 	if x != nil {
-		return x.Attributes
+		return x.Docs
 	}
 	return nil
 }
 
-func (x *ConnectionConstructor) GetDirected() bool {
-	if x != nil {
-		return x.Directed
-	}
-	return false
-}
-
-func (x *ConnectionConstructor) GetFrom() *Ref {
+func (x *Path) GetConnections() []*Connection {
 	if x != nil {
-		return x.From
+		return x.Connections
 	}
 	return nil
 }
 
-func (x *ConnectionConstructor) GetTo() *Ref {
+func (x *Path) GetCost() float64 {
 	if x != nil {
-		return x.To
+		return x.Cost
 	}
-	return nil
+	return 0
 }
 
-type SearchConnectFilter struct {
+// Paths is an array of Path in order of cost
+type Paths struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Filter *Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-	Gtype  string  `protobuf:"bytes,2,opt,name=gtype,proto3" json:"gtype,omitempty"`
-	// attributes are k/v pairs
-	Attributes *_struct.Struct `protobuf:"bytes,3,opt,name=attributes,proto3" json:"attributes,omitempty"`
-	// directed is false if the connection is bi-directional
-	Directed bool `protobuf:"varint,4,opt,name=directed,proto3" json:"directed,omitempty"`
-	// from is the doc ref that is the root of the connection
-	From *Ref `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	Paths []*Path `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
 }
 
-func (x *SearchConnectFilter) Reset() {
-	*x = SearchConnectFilter{}
+func (x *Paths) Reset() {
+	*x = Paths{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_graphik_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -833,13 +1245,13 @@ func (x *SearchConnectFilter) Reset() {
 	}
 }
 
-func (x *SearchConnectFilter) String() string {
+func (x *Paths) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchConnectFilter) ProtoMessage() {}
+func (*Paths) ProtoMessage() {}
 
-func (x *SearchConnectFilter) ProtoReflect() protoreflect.Message {
+func (x *Paths) ProtoReflect() protoreflect.Message {
 	mi := &file_graphik_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -851,61 +1263,36 @@ func (x *SearchConnectFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchConnectFilter.ProtoReflect.Descriptor instead.
-func (*SearchConnectFilter) Descriptor() ([]byte, []int) {
+// Deprecated: Use Paths.ProtoReflect.Descriptor instead.
+func (*Paths) Descriptor() ([]byte, []int) {
 	return file_graphik_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SearchConnectFilter) GetFilter() *Filter {
-	if x != nil {
-		return x.Filter
-	}
-	return nil
-}
-
-func (x *SearchConnectFilter) GetGtype() string {
-	if x != nil {
-		return x.Gtype
-	}
-	return ""
-}
-
-func (x *SearchConnectFilter) GetAttributes() *_struct.Struct {
-	if x != nil {
-		return x.Attributes
-	}
-	return nil
-}
-
-func (x *SearchConnectFilter) GetDirected() bool {
-	if x != nil {
-		return x.Directed
-	}
-	return false
-}
-
-func (x *SearchConnectFilter) GetFrom() *Ref {
+func (x *Paths) GetPaths() []*Path {
 	if x != nil {
-		return x.From
+		return x.Paths
 	}
 	return nil
 }
 
-type SearchConnectMeFilter struct {
+// NodePattern matches a doc in a pattern
+type NodePattern struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Filter *Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-	Gtype  string  `protobuf:"bytes,2,opt,name=gtype,proto3" json:"gtype,omitempty"`
-	// attributes are k/v pairs
-	Attributes *_struct.Struct `protobuf:"bytes,3,opt,name=attributes,proto3" json:"attributes,omitempty"`
-	// directed is false if the connection is bi-directional
-	Directed bool `protobuf:"varint,4,opt,name=directed,proto3" json:"directed,omitempty"`
+	// name is the variable the doc is bound to in each match(optional). Nodes with the same name must match the same doc.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// gtype is the type of the doc - empty or * matches any type(the first node requires a gtype or ref)
+	Gtype string `protobuf:"bytes,2,opt,name=gtype,proto3" json:"gtype,omitempty"`
+	// expression is a boolean CEL expression the doc must pass
+	Expression string `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
+	// ref anchors the node to a single doc
+	Ref *Ref `protobuf:"bytes,4,opt,name=ref,proto3" json:"ref,omitempty"`
 }
 
-func (x *SearchConnectMeFilter) Reset() {
-	*x = SearchConnectMeFilter{}
+func (x *NodePattern) Reset() {
+	*x = NodePattern{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_graphik_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -913,13 +1300,13 @@ func (x *SearchConnectMeFilter) Reset() {
 	}
 }
 
-func (x *SearchConnectMeFilter) String() string {
+func (x *NodePattern) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchConnectMeFilter) ProtoMessage() {}
+func (*NodePattern) ProtoMessage() {}
 
-func (x *SearchConnectMeFilter) ProtoReflect() protoreflect.Message {
+func (x *NodePattern) ProtoReflect() protoreflect.Message {
 	mi := &file_graphik_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -931,50 +1318,61 @@ func (x *SearchConnectMeFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchConnectMeFilter.ProtoReflect.Descriptor instead.
-func (*SearchConnectMeFilter) Descriptor() ([]byte, []int) {
+// Deprecated: Use NodePattern.ProtoReflect.Descriptor instead.
+func (*NodePattern) Descriptor() ([]byte, []int) {
 	return file_graphik_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *SearchConnectMeFilter) GetFilter() *Filter {
+func (x *NodePattern) GetName() string {
 	if x != nil {
-		return x.Filter
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *SearchConnectMeFilter) GetGtype() string {
+func (x *NodePattern) GetGtype() string {
 	if x != nil {
 		return x.Gtype
 	}
 	return ""
 }
 
-func (x *SearchConnectMeFilter) GetAttributes() *_struct.Struct {
+func (x *NodePattern) GetExpression() string {
 	if x != nil {
-		return x.Attributes
+		return x.Expression
 	}
-	return nil
+	return ""
 }
 
-func (x *SearchConnectMeFilter) GetDirected() bool {
+func (x *NodePattern) GetRef() *Ref {
 	if x != nil {
-		return x.Directed
+		return x.Ref
 	}
-	return false
+	return nil
 }
 
-// ConnectionConstructors is an array of ConnectionConstructor
-type ConnectionConstructors struct {
+// EdgePattern matches a chain of connections between two docs in a pattern
+type EdgePattern struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Connections []*ConnectionConstructor `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections,omitempty"`
+	// name is the variable the chain of connections is bound to in each match(optional)
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// gtype is the type of the connections - empty or * matches any type
+	Gtype string `protobuf:"bytes,2,opt,name=gtype,proto3" json:"gtype,omitempty"`
+	// expression is a boolean CEL expression each connection must pass
+	Expression string `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
+	// direction is the direction the connections are followed in
+	Direction Direction `protobuf:"varint,4,opt,name=direction,proto3,enum=api.Direction" json:"direction,omitempty"`
+	// min_hops is the minimum number of connections in the chain - defaults to 1
+	MinHops uint32 `protobuf:"varint,5,opt,name=min_hops,json=minHops,proto3" json:"min_hops,omitempty"`
+	// max_hops is the maximum number of connections(at most 10) in the chain - defaults to min_hops
+	MaxHops uint32 `protobuf:"varint,6,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty"`
 }
 
-func (x *ConnectionConstructors) Reset() {
-	*x = ConnectionConstructors{}
+func (x *EdgePattern) Reset() {
+	*x = EdgePattern{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_graphik_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -982,13 +1380,13 @@ func (x *ConnectionConstructors) Reset() {
 	}
 }
 
-func (x *ConnectionConstructors) String() string {
+func (x *EdgePattern) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectionConstructors) ProtoMessage() {}
+func (*EdgePattern) ProtoMessage() {}
 
-func (x *ConnectionConstructors) ProtoReflect() protoreflect.Message {
+func (x *EdgePattern) ProtoReflect() protoreflect.Message {
 	mi := &file_graphik_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1000,30 +1398,71 @@ func (x *ConnectionConstructors) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectionConstructors.ProtoReflect.Descriptor instead.
-func (*ConnectionConstructors) Descriptor() ([]byte, []int) {
+// Deprecated: Use EdgePattern.ProtoReflect.Descriptor instead.
+func (*EdgePattern) Descriptor() ([]byte, []int) {
 	return file_graphik_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ConnectionConstructors) GetConnections() []*ConnectionConstructor {
+func (x *EdgePattern) GetName() string {
 	if x != nil {
-		return x.Connections
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-// Connections is an array of Connection
-type Connections struct {
+func (x *EdgePattern) GetGtype() string {
+	if x != nil {
+		return x.Gtype
+	}
+	return ""
+}
+
+func (x *EdgePattern) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *EdgePattern) GetDirection() Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return Direction_OUT
+}
+
+func (x *EdgePattern) GetMinHops() uint32 {
+	if x != nil {
+		return x.MinHops
+	}
+	return 0
+}
+
+func (x *EdgePattern) GetMaxHops() uint32 {
+	if x != nil {
+		return x.MaxHops
+	}
+	return 0
+}
+
+// MatchFilter is a pattern of nodes joined by edges ex: (me)-[friend]->(friend)-[friend]->(fof)-[liked]->(post)
+type MatchFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Connections []*Connection `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections,omitempty"`
-	SeekNext    string        `protobuf:"bytes,2,opt,name=seek_next,json=seekNext,proto3" json:"seek_next,omitempty"`
+	// nodes are the node patterns in order
+	Nodes []*NodePattern `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// edges join the nodes - edges[i] joins nodes[i] to nodes[i+1]
+	Edges []*EdgePattern `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	// limit is the maximum number of matches to return
+	Limit uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// budget limits the work done by the match
+	Budget *Budget `protobuf:"bytes,4,opt,name=budget,proto3" json:"budget,omitempty"`
 }
 
-func (x *Connections) Reset() {
-	*x = Connections{}
+func (x *MatchFilter) Reset() {
+	*x = MatchFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_graphik_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1031,13 +1470,13 @@ func (x *Connections) Reset() {
 	}
 }
 
-func (x *Connections) String() string {
+func (x *MatchFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Connections) ProtoMessage() {}
+func (*MatchFilter) ProtoMessage() {}
 
-func (x *Connections) ProtoReflect() protoreflect.Message {
+func (x *MatchFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_graphik_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1049,49 +1488,53 @@ func (x *Connections) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Connections.ProtoReflect.Descriptor instead.
-func (*Connections) Descriptor() ([]byte, []int) {
+// Deprecated: Use MatchFilter.ProtoReflect.Descriptor instead.
+func (*MatchFilter) Descriptor() ([]byte, []int) {
 	return file_graphik_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Connections) GetConnections() []*Connection {
+func (x *MatchFilter) GetNodes() []*NodePattern {
 	if x != nil {
-		return x.Connections
+		return x.Nodes
 	}
 	return nil
 }
 
-func (x *Connections) GetSeekNext() string {
+func (x *MatchFilter) GetEdges() []*EdgePattern {
 	if x != nil {
-		return x.SeekNext
+		return x.Edges
 	}
-	return ""
+	return nil
 }
 
-// ConnectFilter is used to fetch connections related to a single noted
-type ConnectFilter struct {
+func (x *MatchFilter) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *MatchFilter) GetBudget() *Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+// Match binds the pattern's named variables to the docs & connections that matched them
+type Match struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// doc_ref is the ref to the target doc. (validator.field) = {msg_exists : true}]
-	DocRef *Ref `protobuf:"bytes,1,opt,name=doc_ref,json=docRef,proto3" json:"doc_ref,omitempty"`
-	// gtype is the type of connections to return. (validator.field) = {regex : "^.{1,225}$"}
-	Gtype string `protobuf:"bytes,2,opt,name=gtype,proto3" json:"gtype,omitempty"`
-	// expression is a CEL expression used to filter connections/modes
-	Expression string `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
-	// limit is the maximum number of items to return. (validator.field) = {int_gt : 0}
-	Limit uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	// custom sorting of the results. (validator.field) = {regex : "((^|, )(|ref.gid|ref.gtype|^attributes.(.*)))+$"}
-	Sort string `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`
-	// seek to a specific key for pagination
-	Seek string `protobuf:"bytes,6,opt,name=seek,proto3" json:"seek,omitempty"`
-	// reverse the results
-	Reverse bool `protobuf:"varint,7,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	// docs are the docs bound to named nodes
+	Docs map[string]*Doc `protobuf:"bytes,1,rep,name=docs,proto3" json:"docs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// connections are the chains of connections bound to named edges
+	Connections map[string]*Connections `protobuf:"bytes,2,rep,name=connections,proto3" json:"connections,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *ConnectFilter) Reset() {
-	*x = ConnectFilter{}
+func (x *Match) Reset() {
+	*x = Match{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_graphik_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1099,13 +1542,13 @@ func (x *ConnectFilter) Reset() {
 	}
 }
 
-func (x *ConnectFilter) String() string {
+func (x *Match) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectFilter) ProtoMessage() {}
+func (*Match) ProtoMessage() {}
 
-func (x *ConnectFilter) ProtoReflect() protoreflect.Message {
+func (x *Match) ProtoReflect() protoreflect.Message {
 	mi := &file_graphik_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1117,99 +1560,112 @@ func (x *ConnectFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectFilter.ProtoReflect.Descriptor instead.
-func (*ConnectFilter) Descriptor() ([]byte, []int) {
+// Deprecated: Use Match.ProtoReflect.Descriptor instead.
+func (*Match) Descriptor() ([]byte, []int) {
 	return file_graphik_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ConnectFilter) GetDocRef() *Ref {
+func (x *Match) GetDocs() map[string]*Doc {
 	if x != nil {
-		return x.DocRef
+		return x.Docs
 	}
 	return nil
 }
 
-func (x *ConnectFilter) GetGtype() string {
+func (x *Match) GetConnections() map[string]*Connections {
 	if x != nil {
-		return x.Gtype
+		return x.Connections
 	}
-	return ""
+	return nil
 }
 
-func (x *ConnectFilter) GetExpression() string {
-	if x != nil {
-		return x.Expression
-	}
-	return ""
+// Matches is an array of Match
+type Matches struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Matches []*Match `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
 }
 
-func (x *ConnectFilter) GetLimit() uint64 {
-	if x != nil {
-		return x.Limit
+func (x *Matches) Reset() {
+	*x = Matches{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *ConnectFilter) GetSort() string {
-	if x != nil {
-		return x.Sort
-	}
-	return ""
+func (x *Matches) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ConnectFilter) GetSeek() string {
-	if x != nil {
-		return x.Seek
+func (*Matches) ProtoMessage() {}
+
+func (x *Matches) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ConnectFilter) GetReverse() bool {
+// Deprecated: Use Matches.ProtoReflect.Descriptor instead.
+func (*Matches) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Matches) GetMatches() []*Match {
 	if x != nil {
-		return x.Reverse
+		return x.Matches
 	}
-	return false
+	return nil
 }
 
-// Filter is a generic filter using Common Expression Language
-type Filter struct {
+// ExtractFilter selects the subgraph around one or more root docs
+type ExtractFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// gtype is the doc/connection type to be filtered
-	Gtype string `protobuf:"bytes,1,opt,name=gtype,proto3" json:"gtype,omitempty"`
-	// expression is a CEL expression used to filter connections/modes
-	Expression string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
-	// limit is the maximum number of items to return
-	Limit uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	// custom sorting of the results.
-	Sort string `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
-	// seek to a specific key for pagination
-	Seek string `protobuf:"bytes,5,opt,name=seek,proto3" json:"seek,omitempty"`
-	// reverse the results
-	Reverse bool `protobuf:"varint,6,opt,name=reverse,proto3" json:"reverse,omitempty"`
-	// search in a specific index
-	Index string `protobuf:"bytes,7,opt,name=index,proto3" json:"index,omitempty"`
-}
-
-func (x *Filter) Reset() {
-	*x = Filter{}
+	// roots are the docs the subgraph is extracted around
+	Roots []*Ref `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+	// doc_expression is a boolean CEL expression used to determine which docs(other than the roots) are visited
+	DocExpression string `protobuf:"bytes,2,opt,name=doc_expression,json=docExpression,proto3" json:"doc_expression,omitempty"`
+	// connection_expression is a boolean CEL expression used to determine which connections are traversed
+	ConnectionExpression string `protobuf:"bytes,3,opt,name=connection_expression,json=connectionExpression,proto3" json:"connection_expression,omitempty"`
+	// max_depth is the maximum number of connections between a root & any doc in the subgraph
+	MaxDepth uint64 `protobuf:"varint,4,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	// limit is the maximum number of docs in the subgraph
+	Limit uint64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// direction is the direction connections are followed in - OUT(the default), IN or BOTH
+	Direction Direction `protobuf:"varint,6,opt,name=direction,proto3,enum=api.Direction" json:"direction,omitempty"`
+	// budget limits the work done by the extraction
+	Budget *Budget `protobuf:"bytes,7,opt,name=budget,proto3" json:"budget,omitempty"`
+}
+
+func (x *ExtractFilter) Reset() {
+	*x = ExtractFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphik_proto_msgTypes[16]
+		mi := &file_graphik_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Filter) String() string {
+func (x *ExtractFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Filter) ProtoMessage() {}
+func (*ExtractFilter) ProtoMessage() {}
 
-func (x *Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_graphik_proto_msgTypes[16]
+func (x *ExtractFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1220,87 +1676,93 @@ func (x *Filter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Filter.ProtoReflect.Descriptor instead.
-func (*Filter) Descriptor() ([]byte, []int) {
-	return file_graphik_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ExtractFilter.ProtoReflect.Descriptor instead.
+func (*ExtractFilter) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Filter) GetGtype() string {
+func (x *ExtractFilter) GetRoots() []*Ref {
 	if x != nil {
-		return x.Gtype
+		return x.Roots
 	}
-	return ""
+	return nil
 }
 
-func (x *Filter) GetExpression() string {
+func (x *ExtractFilter) GetDocExpression() string {
 	if x != nil {
-		return x.Expression
+		return x.DocExpression
 	}
 	return ""
 }
 
-func (x *Filter) GetLimit() uint64 {
+func (x *ExtractFilter) GetConnectionExpression() string {
 	if x != nil {
-		return x.Limit
+		return x.ConnectionExpression
 	}
-	return 0
+	return ""
 }
 
-func (x *Filter) GetSort() string {
+func (x *ExtractFilter) GetMaxDepth() uint64 {
 	if x != nil {
-		return x.Sort
+		return x.MaxDepth
 	}
-	return ""
+	return 0
 }
 
-func (x *Filter) GetSeek() string {
+func (x *ExtractFilter) GetLimit() uint64 {
 	if x != nil {
-		return x.Seek
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *Filter) GetReverse() bool {
+func (x *ExtractFilter) GetDirection() Direction {
 	if x != nil {
-		return x.Reverse
+		return x.Direction
 	}
-	return false
+	return Direction_OUT
 }
 
-func (x *Filter) GetIndex() string {
+func (x *ExtractFilter) GetBudget() *Budget {
 	if x != nil {
-		return x.Index
+		return x.Budget
 	}
-	return ""
+	return nil
 }
 
-type AggFilter struct {
+// TopologicalSortFilter selects the directed connections docs are ordered by
+type TopologicalSortFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Filter    *Filter   `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-	Aggregate Aggregate `protobuf:"varint,2,opt,name=aggregate,proto3,enum=api.Aggregate" json:"aggregate,omitempty"`
-	Field     string    `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	// gtype is the type of connections docs are ordered by ex: depends_on
+	Gtype string `protobuf:"bytes,1,opt,name=gtype,proto3" json:"gtype,omitempty"`
+	// expression is a boolean CEL expression used to filter connections
+	Expression string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	// max_cycles is the maximum number of cycles(at most 100) to return - defaults to 10
+	MaxCycles uint32 `protobuf:"varint,3,opt,name=max_cycles,json=maxCycles,proto3" json:"max_cycles,omitempty"`
+	// budget limits the work done by the sort
+	Budget *Budget `protobuf:"bytes,4,opt,name=budget,proto3" json:"budget,omitempty"`
 }
 
-func (x *AggFilter) Reset() {
-	*x = AggFilter{}
+func (x *TopologicalSortFilter) Reset() {
+	*x = TopologicalSortFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphik_proto_msgTypes[17]
+		mi := &file_graphik_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AggFilter) String() string {
+func (x *TopologicalSortFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AggFilter) ProtoMessage() {}
+func (*TopologicalSortFilter) ProtoMessage() {}
 
-func (x *AggFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_graphik_proto_msgTypes[17]
+func (x *TopologicalSortFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1311,68 +1773,70 @@ func (x *AggFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AggFilter.ProtoReflect.Descriptor instead.
-func (*AggFilter) Descriptor() ([]byte, []int) {
-	return file_graphik_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use TopologicalSortFilter.ProtoReflect.Descriptor instead.
+func (*TopologicalSortFilter) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *AggFilter) GetFilter() *Filter {
+func (x *TopologicalSortFilter) GetGtype() string {
 	if x != nil {
-		return x.Filter
+		return x.Gtype
 	}
-	return nil
+	return ""
 }
 
-func (x *AggFilter) GetAggregate() Aggregate {
+func (x *TopologicalSortFilter) GetExpression() string {
 	if x != nil {
-		return x.Aggregate
+		return x.Expression
 	}
-	return Aggregate_COUNT
+	return ""
 }
 
-func (x *AggFilter) GetField() string {
+func (x *TopologicalSortFilter) GetMaxCycles() uint32 {
 	if x != nil {
-		return x.Field
+		return x.MaxCycles
 	}
-	return ""
+	return 0
 }
 
-type TraverseFilter struct {
+func (x *TopologicalSortFilter) GetBudget() *Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+// TopologicalOrder is the result of a topological sort
+type TopologicalOrder struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Root                 *Ref   `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
-	DocExpression        string `protobuf:"bytes,2,opt,name=doc_expression,json=docExpression,proto3" json:"doc_expression,omitempty"`
-	ConnectionExpression string `protobuf:"bytes,3,opt,name=connection_expression,json=connectionExpression,proto3" json:"connection_expression,omitempty"`
-	Limit                uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	// custom sorting of the results. (validator.field) = {regex : "((^|, )(|ref.gid|ref.gtype|^attributes.(.*)))+$"}
-	Sort      string    `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`
-	Reverse   bool      `protobuf:"varint,6,opt,name=reverse,proto3" json:"reverse,omitempty"`
-	Algorithm Algorithm `protobuf:"varint,7,opt,name=algorithm,proto3,enum=api.Algorithm" json:"algorithm,omitempty"`
-	// maximum degree/depth of nodes to be visited during traversal
-	MaxDepth uint64 `protobuf:"varint,8,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
-	// maximum number of nodes to be visited during traversal
-	MaxHops uint64 `protobuf:"varint,9,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty"`
+	// acyclic is true if the connections don't contain a cycle
+	Acyclic bool `protobuf:"varint,1,opt,name=acyclic,proto3" json:"acyclic,omitempty"`
+	// refs are the refs of the docs joined by the connections in topological order(empty if there's a cycle)
+	Refs []*Ref `protobuf:"bytes,2,rep,name=refs,proto3" json:"refs,omitempty"`
+	// cycles are paths that lead back to the doc they start from(one per strongly connected component)
+	Cycles []*Path `protobuf:"bytes,3,rep,name=cycles,proto3" json:"cycles,omitempty"`
 }
 
-func (x *TraverseFilter) Reset() {
-	*x = TraverseFilter{}
+func (x *TopologicalOrder) Reset() {
+	*x = TopologicalOrder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphik_proto_msgTypes[18]
+		mi := &file_graphik_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TraverseFilter) String() string {
+func (x *TopologicalOrder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TraverseFilter) ProtoMessage() {}
+func (*TopologicalOrder) ProtoMessage() {}
 
-func (x *TraverseFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_graphik_proto_msgTypes[18]
+func (x *TopologicalOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1383,109 +1847,60 @@ func (x *TraverseFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TraverseFilter.ProtoReflect.Descriptor instead.
-func (*TraverseFilter) Descriptor() ([]byte, []int) {
-	return file_graphik_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *TraverseFilter) GetRoot() *Ref {
-	if x != nil {
-		return x.Root
-	}
-	return nil
-}
-
-func (x *TraverseFilter) GetDocExpression() string {
-	if x != nil {
-		return x.DocExpression
-	}
-	return ""
-}
-
-func (x *TraverseFilter) GetConnectionExpression() string {
-	if x != nil {
-		return x.ConnectionExpression
-	}
-	return ""
-}
-
-func (x *TraverseFilter) GetLimit() uint64 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *TraverseFilter) GetSort() string {
-	if x != nil {
-		return x.Sort
-	}
-	return ""
+// Deprecated: Use TopologicalOrder.ProtoReflect.Descriptor instead.
+func (*TopologicalOrder) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *TraverseFilter) GetReverse() bool {
+func (x *TopologicalOrder) GetAcyclic() bool {
 	if x != nil {
-		return x.Reverse
+		return x.Acyclic
 	}
 	return false
 }
 
-func (x *TraverseFilter) GetAlgorithm() Algorithm {
-	if x != nil {
-		return x.Algorithm
-	}
-	return Algorithm_BFS
-}
-
-func (x *TraverseFilter) GetMaxDepth() uint64 {
+func (x *TopologicalOrder) GetRefs() []*Ref {
 	if x != nil {
-		return x.MaxDepth
+		return x.Refs
 	}
-	return 0
+	return nil
 }
 
-func (x *TraverseFilter) GetMaxHops() uint64 {
+func (x *TopologicalOrder) GetCycles() []*Path {
 	if x != nil {
-		return x.MaxHops
+		return x.Cycles
 	}
-	return 0
+	return nil
 }
 
-type TraverseMeFilter struct {
+// Docs is an array of docs
+type Docs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DocExpression        string `protobuf:"bytes,1,opt,name=doc_expression,json=docExpression,proto3" json:"doc_expression,omitempty"`
-	ConnectionExpression string `protobuf:"bytes,2,opt,name=connection_expression,json=connectionExpression,proto3" json:"connection_expression,omitempty"`
-	Limit                uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	// custom sorting of the results. (validator.field) = {regex : "((^|, )(|ref.gid|ref.gtype|^attributes.(.*)))+$"}
-	Sort      string    `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
-	Reverse   bool      `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
-	Algorithm Algorithm `protobuf:"varint,6,opt,name=algorithm,proto3,enum=api.Algorithm" json:"algorithm,omitempty"`
-	// maximum degree/depth of nodes to be visited during traversal
-	MaxDepth uint64 `protobuf:"varint,7,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
-	// maximum number of nodes to be visited during traversal
-	MaxHops uint64 `protobuf:"varint,8,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty"`
+	// docs is an array of docs
+	Docs     []*Doc `protobuf:"bytes,1,rep,name=docs,proto3" json:"docs,omitempty"`
+	SeekNext string `protobuf:"bytes,2,opt,name=seek_next,json=seekNext,proto3" json:"seek_next,omitempty"`
 }
 
-func (x *TraverseMeFilter) Reset() {
-	*x = TraverseMeFilter{}
+func (x *Docs) Reset() {
+	*x = Docs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphik_proto_msgTypes[19]
+		mi := &file_graphik_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TraverseMeFilter) String() string {
+func (x *Docs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TraverseMeFilter) ProtoMessage() {}
+func (*Docs) ProtoMessage() {}
 
-func (x *TraverseMeFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_graphik_proto_msgTypes[19]
+func (x *Docs) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1496,100 +1911,60 @@ func (x *TraverseMeFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TraverseMeFilter.ProtoReflect.Descriptor instead.
-func (*TraverseMeFilter) Descriptor() ([]byte, []int) {
-	return file_graphik_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use Docs.ProtoReflect.Descriptor instead.
+func (*Docs) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *TraverseMeFilter) GetDocExpression() string {
+func (x *Docs) GetDocs() []*Doc {
 	if x != nil {
-		return x.DocExpression
+		return x.Docs
 	}
-	return ""
+	return nil
 }
 
-func (x *TraverseMeFilter) GetConnectionExpression() string {
+func (x *Docs) GetSeekNext() string {
 	if x != nil {
-		return x.ConnectionExpression
+		return x.SeekNext
 	}
 	return ""
 }
 
-func (x *TraverseMeFilter) GetLimit() uint64 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *TraverseMeFilter) GetSort() string {
-	if x != nil {
-		return x.Sort
-	}
-	return ""
-}
-
-func (x *TraverseMeFilter) GetReverse() bool {
-	if x != nil {
-		return x.Reverse
-	}
-	return false
-}
-
-func (x *TraverseMeFilter) GetAlgorithm() Algorithm {
-	if x != nil {
-		return x.Algorithm
-	}
-	return Algorithm_BFS
-}
-
-func (x *TraverseMeFilter) GetMaxDepth() uint64 {
-	if x != nil {
-		return x.MaxDepth
-	}
-	return 0
-}
-
-func (x *TraverseMeFilter) GetMaxHops() uint64 {
-	if x != nil {
-		return x.MaxHops
-	}
-	return 0
-}
-
-type IndexConstructor struct {
+// Connection is a graph primitive that represents a relationship between two docs
+type Connection struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// gtype is the doc/connection type to be filtered
-	Gtype string `protobuf:"bytes,3,opt,name=gtype,proto3" json:"gtype,omitempty"`
-	// expression is a CEL expression used to filter connections/modes
-	Expression string `protobuf:"bytes,4,opt,name=expression,proto3" json:"expression,omitempty"`
-	// if docs is true, this index will be applied to documents. Either docs or connections may be true, but not both.
-	Docs bool `protobuf:"varint,6,opt,name=docs,proto3" json:"docs,omitempty"`
-	// if docs is true, this index will be applied to connections. Either docs or connections may be true, but not both.
-	Connections bool `protobuf:"varint,7,opt,name=connections,proto3" json:"connections,omitempty"`
+	// ref is the ref to the connection
+	Ref *Ref `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	// attributes are k/v pairs
+	Attributes *_struct.Struct `protobuf:"bytes,2,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// directed is false if the connection is bi-directional
+	Directed bool `protobuf:"varint,3,opt,name=directed,proto3" json:"directed,omitempty"`
+	// from is the doc ref that is the source of the connection
+	From *Ref `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the doc ref that is the destination of the connection
+	To *Ref `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
 }
 
-func (x *IndexConstructor) Reset() {
-	*x = IndexConstructor{}
+func (x *Connection) Reset() {
+	*x = Connection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphik_proto_msgTypes[20]
+		mi := &file_graphik_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IndexConstructor) String() string {
+func (x *Connection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IndexConstructor) ProtoMessage() {}
+func (*Connection) ProtoMessage() {}
 
-func (x *IndexConstructor) ProtoReflect() protoreflect.Message {
-	mi := &file_graphik_proto_msgTypes[20]
+func (x *Connection) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1600,72 +1975,84 @@ func (x *IndexConstructor) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IndexConstructor.ProtoReflect.Descriptor instead.
-func (*IndexConstructor) Descriptor() ([]byte, []int) {
-	return file_graphik_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use Connection.ProtoReflect.Descriptor instead.
+func (*Connection) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *IndexConstructor) GetName() string {
+func (x *Connection) GetRef() *Ref {
 	if x != nil {
-		return x.Name
+		return x.Ref
 	}
-	return ""
+	return nil
 }
 
-func (x *IndexConstructor) GetGtype() string {
+func (x *Connection) GetAttributes() *_struct.Struct {
 	if x != nil {
-		return x.Gtype
+		return x.Attributes
 	}
-	return ""
+	return nil
 }
 
-func (x *IndexConstructor) GetExpression() string {
+func (x *Connection) GetDirected() bool {
 	if x != nil {
-		return x.Expression
+		return x.Directed
 	}
-	return ""
+	return false
 }
 
-func (x *IndexConstructor) GetDocs() bool {
+func (x *Connection) GetFrom() *Ref {
 	if x != nil {
-		return x.Docs
+		return x.From
 	}
-	return false
+	return nil
 }
 
-func (x *IndexConstructor) GetConnections() bool {
+func (x *Connection) GetTo() *Ref {
 	if x != nil {
-		return x.Connections
+		return x.To
 	}
-	return false
+	return nil
 }
 
-type Authorizer struct {
+// ConnectionConstructor is used to create an Connection
+type ConnectionConstructor struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Expression string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	// ref is the ref to the new Connection. If an id isn't present, one will be generated.
+	Ref *RefConstructor `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	// attributes are k/v pairs
+	Attributes *_struct.Struct `protobuf:"bytes,3,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// directed is false if the connection is bi-directional
+	Directed bool `protobuf:"varint,4,opt,name=directed,proto3" json:"directed,omitempty"`
+	// from is the doc ref that is the root of the connection
+	From *Ref `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the doc ref that is the destination of the connection
+	To *Ref `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	// idempotency_key is an optional client generated key. Repeated requests with the same key return the originally created connection
+	// instead of creating a new one. It may also be set via the x-graphik-idempotency-key request metadata.
+	IdempotencyKey string `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
-func (x *Authorizer) Reset() {
-	*x = Authorizer{}
+func (x *ConnectionConstructor) Reset() {
+	*x = ConnectionConstructor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphik_proto_msgTypes[21]
+		mi := &file_graphik_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Authorizer) String() string {
+func (x *ConnectionConstructor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Authorizer) ProtoMessage() {}
+func (*ConnectionConstructor) ProtoMessage() {}
 
-func (x *Authorizer) ProtoReflect() protoreflect.Message {
-	mi := &file_graphik_proto_msgTypes[21]
+func (x *ConnectionConstructor) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1676,88 +2063,70 @@ func (x *Authorizer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Authorizer.ProtoReflect.Descriptor instead.
-func (*Authorizer) Descriptor() ([]byte, []int) {
-	return file_graphik_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ConnectionConstructor.ProtoReflect.Descriptor instead.
+func (*ConnectionConstructor) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *Authorizer) GetName() string {
+func (x *ConnectionConstructor) GetRef() *RefConstructor {
 	if x != nil {
-		return x.Name
+		return x.Ref
 	}
-	return ""
+	return nil
 }
 
-func (x *Authorizer) GetExpression() string {
+func (x *ConnectionConstructor) GetAttributes() *_struct.Struct {
 	if x != nil {
-		return x.Expression
+		return x.Attributes
 	}
-	return ""
-}
-
-type Authorizers struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Authorizers []*Authorizer `protobuf:"bytes,1,rep,name=authorizers,proto3" json:"authorizers,omitempty"`
+	return nil
 }
 
-func (x *Authorizers) Reset() {
-	*x = Authorizers{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_graphik_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ConnectionConstructor) GetDirected() bool {
+	if x != nil {
+		return x.Directed
 	}
+	return false
 }
 
-func (x *Authorizers) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Authorizers) ProtoMessage() {}
-
-func (x *Authorizers) ProtoReflect() protoreflect.Message {
-	mi := &file_graphik_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ConnectionConstructor) GetFrom() *Ref {
+	if x != nil {
+		return x.From
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Authorizers.ProtoReflect.Descriptor instead.
-func (*Authorizers) Descriptor() ([]byte, []int) {
-	return file_graphik_proto_rawDescGZIP(), []int{22}
+func (x *ConnectionConstructor) GetTo() *Ref {
+	if x != nil {
+		return x.To
+	}
+	return nil
 }
 
-func (x *Authorizers) GetAuthorizers() []*Authorizer {
+func (x *ConnectionConstructor) GetIdempotencyKey() string {
 	if x != nil {
-		return x.Authorizers
+		return x.IdempotencyKey
 	}
-	return nil
+	return ""
 }
 
-type TypeValidator struct {
+type SearchConnectFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Gtype      string `protobuf:"bytes,2,opt,name=gtype,proto3" json:"gtype,omitempty"`
-	Expression string `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
-	// if docs is true, this validator will be applied to documents. Either docs or connections may be true, but not both.
-	Docs bool `protobuf:"varint,4,opt,name=docs,proto3" json:"docs,omitempty"`
-	// if docs is true, this validator will be applied to connections. Either docs or connections may be true, but not both.
-	Connections bool `protobuf:"varint,5,opt,name=connections,proto3" json:"connections,omitempty"`
+	Filter *Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Gtype  string  `protobuf:"bytes,2,opt,name=gtype,proto3" json:"gtype,omitempty"`
+	// attributes are k/v pairs
+	Attributes *_struct.Struct `protobuf:"bytes,3,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// directed is false if the connection is bi-directional
+	Directed bool `protobuf:"varint,4,opt,name=directed,proto3" json:"directed,omitempty"`
+	// from is the doc ref that is the root of the connection
+	From *Ref `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
 }
 
-func (x *TypeValidator) Reset() {
-	*x = TypeValidator{}
+func (x *SearchConnectFilter) Reset() {
+	*x = SearchConnectFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_graphik_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1765,13 +2134,13 @@ func (x *TypeValidator) Reset() {
 	}
 }
 
-func (x *TypeValidator) String() string {
+func (x *SearchConnectFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TypeValidator) ProtoMessage() {}
+func (*SearchConnectFilter) ProtoMessage() {}
 
-func (x *TypeValidator) ProtoReflect() protoreflect.Message {
+func (x *SearchConnectFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_graphik_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1783,56 +2152,61 @@ func (x *TypeValidator) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TypeValidator.ProtoReflect.Descriptor instead.
-func (*TypeValidator) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchConnectFilter.ProtoReflect.Descriptor instead.
+func (*SearchConnectFilter) Descriptor() ([]byte, []int) {
 	return file_graphik_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *TypeValidator) GetName() string {
+func (x *SearchConnectFilter) GetFilter() *Filter {
 	if x != nil {
-		return x.Name
+		return x.Filter
 	}
-	return ""
+	return nil
 }
 
-func (x *TypeValidator) GetGtype() string {
+func (x *SearchConnectFilter) GetGtype() string {
 	if x != nil {
 		return x.Gtype
 	}
 	return ""
 }
 
-func (x *TypeValidator) GetExpression() string {
+func (x *SearchConnectFilter) GetAttributes() *_struct.Struct {
 	if x != nil {
-		return x.Expression
+		return x.Attributes
 	}
-	return ""
+	return nil
 }
 
-func (x *TypeValidator) GetDocs() bool {
+func (x *SearchConnectFilter) GetDirected() bool {
 	if x != nil {
-		return x.Docs
+		return x.Directed
 	}
 	return false
 }
 
-func (x *TypeValidator) GetConnections() bool {
+func (x *SearchConnectFilter) GetFrom() *Ref {
 	if x != nil {
-		return x.Connections
+		return x.From
 	}
-	return false
+	return nil
 }
 
-type TypeValidators struct {
+type SearchConnectMeFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Validators []*TypeValidator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	Filter *Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Gtype  string  `protobuf:"bytes,2,opt,name=gtype,proto3" json:"gtype,omitempty"`
+	// attributes are k/v pairs
+	Attributes *_struct.Struct `protobuf:"bytes,3,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// directed is false if the connection is bi-directional
+	Directed bool `protobuf:"varint,4,opt,name=directed,proto3" json:"directed,omitempty"`
 }
 
-func (x *TypeValidators) Reset() {
-	*x = TypeValidators{}
+func (x *SearchConnectMeFilter) Reset() {
+	*x = SearchConnectMeFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_graphik_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1840,13 +2214,13 @@ func (x *TypeValidators) Reset() {
 	}
 }
 
-func (x *TypeValidators) String() string {
+func (x *SearchConnectMeFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TypeValidators) ProtoMessage() {}
+func (*SearchConnectMeFilter) ProtoMessage() {}
 
-func (x *TypeValidators) ProtoReflect() protoreflect.Message {
+func (x *SearchConnectMeFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_graphik_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1858,36 +2232,50 @@ func (x *TypeValidators) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TypeValidators.ProtoReflect.Descriptor instead.
-func (*TypeValidators) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchConnectMeFilter.ProtoReflect.Descriptor instead.
+func (*SearchConnectMeFilter) Descriptor() ([]byte, []int) {
 	return file_graphik_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *TypeValidators) GetValidators() []*TypeValidator {
+func (x *SearchConnectMeFilter) GetFilter() *Filter {
 	if x != nil {
-		return x.Validators
+		return x.Filter
 	}
 	return nil
 }
 
-type Index struct {
+func (x *SearchConnectMeFilter) GetGtype() string {
+	if x != nil {
+		return x.Gtype
+	}
+	return ""
+}
+
+func (x *SearchConnectMeFilter) GetAttributes() *_struct.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *SearchConnectMeFilter) GetDirected() bool {
+	if x != nil {
+		return x.Directed
+	}
+	return false
+}
+
+// ConnectionConstructors is an array of ConnectionConstructor
+type ConnectionConstructors struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// gtype is the doc/connection type to be filtered
-	Gtype string `protobuf:"bytes,3,opt,name=gtype,proto3" json:"gtype,omitempty"`
-	// expression is a CEL expression used to filter connections/modes
-	Expression string `protobuf:"bytes,4,opt,name=expression,proto3" json:"expression,omitempty"`
-	// if docs is true, this index will be applied to documents. Either docs or connections may be true, but not both.
-	Docs bool `protobuf:"varint,6,opt,name=docs,proto3" json:"docs,omitempty"`
-	// if docs is true, this index will be applied to connections. Either docs or connections may be true, but not both.
-	Connections bool `protobuf:"varint,7,opt,name=connections,proto3" json:"connections,omitempty"`
+	Connections []*ConnectionConstructor `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections,omitempty"`
 }
 
-func (x *Index) Reset() {
-	*x = Index{}
+func (x *ConnectionConstructors) Reset() {
+	*x = ConnectionConstructors{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_graphik_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1895,13 +2283,13 @@ func (x *Index) Reset() {
 	}
 }
 
-func (x *Index) String() string {
+func (x *ConnectionConstructors) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Index) ProtoMessage() {}
+func (*ConnectionConstructors) ProtoMessage() {}
 
-func (x *Index) ProtoReflect() protoreflect.Message {
+func (x *ConnectionConstructors) ProtoReflect() protoreflect.Message {
 	mi := &file_graphik_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1913,56 +2301,30 @@ func (x *Index) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Index.ProtoReflect.Descriptor instead.
-func (*Index) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConnectionConstructors.ProtoReflect.Descriptor instead.
+func (*ConnectionConstructors) Descriptor() ([]byte, []int) {
 	return file_graphik_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *Index) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Index) GetGtype() string {
-	if x != nil {
-		return x.Gtype
-	}
-	return ""
-}
-
-func (x *Index) GetExpression() string {
-	if x != nil {
-		return x.Expression
-	}
-	return ""
-}
-
-func (x *Index) GetDocs() bool {
-	if x != nil {
-		return x.Docs
-	}
-	return false
-}
-
-func (x *Index) GetConnections() bool {
+func (x *ConnectionConstructors) GetConnections() []*ConnectionConstructor {
 	if x != nil {
 		return x.Connections
 	}
-	return false
+	return nil
 }
 
-type Indexes struct {
+// Connections is an array of Connection
+type Connections struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Indexes []*Index `protobuf:"bytes,1,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	Connections []*Connection `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections,omitempty"`
+	SeekNext    string        `protobuf:"bytes,2,opt,name=seek_next,json=seekNext,proto3" json:"seek_next,omitempty"`
 }
 
-func (x *Indexes) Reset() {
-	*x = Indexes{}
+func (x *Connections) Reset() {
+	*x = Connections{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_graphik_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1970,13 +2332,13 @@ func (x *Indexes) Reset() {
 	}
 }
 
-func (x *Indexes) String() string {
+func (x *Connections) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Indexes) ProtoMessage() {}
+func (*Connections) ProtoMessage() {}
 
-func (x *Indexes) ProtoReflect() protoreflect.Message {
+func (x *Connections) ProtoReflect() protoreflect.Message {
 	mi := &file_graphik_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1988,32 +2350,51 @@ func (x *Indexes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Indexes.ProtoReflect.Descriptor instead.
-func (*Indexes) Descriptor() ([]byte, []int) {
+// Deprecated: Use Connections.ProtoReflect.Descriptor instead.
+func (*Connections) Descriptor() ([]byte, []int) {
 	return file_graphik_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *Indexes) GetIndexes() []*Index {
+func (x *Connections) GetConnections() []*Connection {
 	if x != nil {
-		return x.Indexes
+		return x.Connections
 	}
 	return nil
 }
 
-// StreamFilter is used to filter messages in a pubsub channel
-type StreamFilter struct {
+func (x *Connections) GetSeekNext() string {
+	if x != nil {
+		return x.SeekNext
+	}
+	return ""
+}
+
+// ConnectFilter is used to fetch connections related to a single noted
+type ConnectFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// channel is the target channel to filter from
-	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	// expression is CEL expression used to filter messages
-	Expression string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	// doc_ref is the ref to the target doc. (validator.field) = {msg_exists : true}]
+	DocRef *Ref `protobuf:"bytes,1,opt,name=doc_ref,json=docRef,proto3" json:"doc_ref,omitempty"`
+	// gtype is the type of connections to return. (validator.field) = {regex : "^.{1,225}$"}
+	Gtype string `protobuf:"bytes,2,opt,name=gtype,proto3" json:"gtype,omitempty"`
+	// expression is a CEL expression used to filter connections/modes
+	Expression string `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
+	// limit is the maximum number of items to return. (validator.field) = {int_gt : 0}
+	Limit uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// custom sorting of the results. (validator.field) = {regex : "((^|, )(|ref.gid|ref.gtype|^attributes.(.*)))+$"}
+	Sort string `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`
+	// seek to a specific key for pagination
+	Seek string `protobuf:"bytes,6,opt,name=seek,proto3" json:"seek,omitempty"`
+	// reverse the results
+	Reverse bool `protobuf:"varint,7,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	// direction BOTH returns connections to & from the doc - otherwise the direction is implied by the method(ConnectionsFrom/ConnectionsTo)
+	Direction Direction `protobuf:"varint,8,opt,name=direction,proto3,enum=api.Direction" json:"direction,omitempty"`
 }
 
-func (x *StreamFilter) Reset() {
-	*x = StreamFilter{}
+func (x *ConnectFilter) Reset() {
+	*x = ConnectFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_graphik_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2021,13 +2402,13 @@ func (x *StreamFilter) Reset() {
 	}
 }
 
-func (x *StreamFilter) String() string {
+func (x *ConnectFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamFilter) ProtoMessage() {}
+func (*ConnectFilter) ProtoMessage() {}
 
-func (x *StreamFilter) ProtoReflect() protoreflect.Message {
+func (x *ConnectFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_graphik_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2039,39 +2420,81 @@ func (x *StreamFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamFilter.ProtoReflect.Descriptor instead.
-func (*StreamFilter) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConnectFilter.ProtoReflect.Descriptor instead.
+func (*ConnectFilter) Descriptor() ([]byte, []int) {
 	return file_graphik_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *StreamFilter) GetChannel() string {
+func (x *ConnectFilter) GetDocRef() *Ref {
 	if x != nil {
-		return x.Channel
+		return x.DocRef
+	}
+	return nil
+}
+
+func (x *ConnectFilter) GetGtype() string {
+	if x != nil {
+		return x.Gtype
 	}
 	return ""
 }
 
-func (x *StreamFilter) GetExpression() string {
+func (x *ConnectFilter) GetExpression() string {
 	if x != nil {
 		return x.Expression
 	}
 	return ""
 }
 
-// Graph is an array of docs and connections
-type Graph struct {
+func (x *ConnectFilter) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ConnectFilter) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ConnectFilter) GetSeek() string {
+	if x != nil {
+		return x.Seek
+	}
+	return ""
+}
+
+func (x *ConnectFilter) GetReverse() bool {
+	if x != nil {
+		return x.Reverse
+	}
+	return false
+}
+
+func (x *ConnectFilter) GetDirection() Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return Direction_OUT
+}
+
+// DegreeFilter is used to get the number of connections to & from a doc
+type DegreeFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// docs are docs present in the graph
-	Docs *Docs `protobuf:"bytes,1,opt,name=docs,proto3" json:"docs,omitempty"`
-	// connections are connections present in the graph
-	Connections *Connections `protobuf:"bytes,2,opt,name=connections,proto3" json:"connections,omitempty"`
+	// doc is the doc whose connections are counted
+	Doc *Ref `protobuf:"bytes,1,opt,name=doc,proto3" json:"doc,omitempty"`
+	// gtype limits the count to a single type of connection - if empty, every type is counted
+	Gtype string `protobuf:"bytes,2,opt,name=gtype,proto3" json:"gtype,omitempty"`
 }
 
-func (x *Graph) Reset() {
-	*x = Graph{}
+func (x *DegreeFilter) Reset() {
+	*x = DegreeFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_graphik_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2079,13 +2502,13 @@ func (x *Graph) Reset() {
 	}
 }
 
-func (x *Graph) String() string {
+func (x *DegreeFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Graph) ProtoMessage() {}
+func (*DegreeFilter) ProtoMessage() {}
 
-func (x *Graph) ProtoReflect() protoreflect.Message {
+func (x *DegreeFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_graphik_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2097,53 +2520,41 @@ func (x *Graph) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Graph.ProtoReflect.Descriptor instead.
-func (*Graph) Descriptor() ([]byte, []int) {
+// Deprecated: Use DegreeFilter.ProtoReflect.Descriptor instead.
+func (*DegreeFilter) Descriptor() ([]byte, []int) {
 	return file_graphik_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *Graph) GetDocs() *Docs {
+func (x *DegreeFilter) GetDoc() *Ref {
 	if x != nil {
-		return x.Docs
+		return x.Doc
 	}
 	return nil
 }
 
-func (x *Graph) GetConnections() *Connections {
+func (x *DegreeFilter) GetGtype() string {
 	if x != nil {
-		return x.Connections
+		return x.Gtype
 	}
-	return nil
+	return ""
 }
 
-type Flags struct {
+// GtypeDegree is the number of connections of a single type to & from a doc
+type GtypeDegree struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// open id connect discovery uri ex: https://accounts.google.com/.well-known/openid-configuration (env: GRAPHIK_OPEN_ID)
-	OpenIdDiscovery string `protobuf:"bytes,1,opt,name=open_id_discovery,json=openIdDiscovery,proto3" json:"open_id_discovery,omitempty"`
-	// persistant storage ref (env: GRAPHIK_STORAGE_PATH)
-	StoragePath string `protobuf:"bytes,2,opt,name=storage_path,json=storagePath,proto3" json:"storage_path,omitempty"`
-	// enable prometheus & pprof metrics (emv: GRAPHIK_METRICS = true)
-	Metrics bool `protobuf:"varint,3,opt,name=metrics,proto3" json:"metrics,omitempty"`
-	// cors allow headers (env: GRAPHIK_ALLOW_HEADERS)
-	AllowHeaders []string `protobuf:"bytes,5,rep,name=allow_headers,json=allowHeaders,proto3" json:"allow_headers,omitempty"`
-	// cors allow methods (env: GRAPHIK_ALLOW_METHODS)
-	AllowMethods []string `protobuf:"bytes,6,rep,name=allow_methods,json=allowMethods,proto3" json:"allow_methods,omitempty"`
-	// cors allow origins (env: GRAPHIK_ALLOW_ORIGINS)
-	AllowOrigins []string `protobuf:"bytes,7,rep,name=allow_origins,json=allowOrigins,proto3" json:"allow_origins,omitempty"`
-	// root user is a list of email addresses that bypass authorizers. (env: GRAPHIK_ROOT_USERS)
-	RootUsers              []string `protobuf:"bytes,8,rep,name=root_users,json=rootUsers,proto3" json:"root_users,omitempty"`
-	TlsCert                string   `protobuf:"bytes,9,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert,omitempty"`
-	TlsKey                 string   `protobuf:"bytes,10,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
-	PlaygroundClientId     string   `protobuf:"bytes,11,opt,name=playground_client_id,json=playgroundClientId,proto3" json:"playground_client_id,omitempty"`
-	PlaygroundClientSecret string   `protobuf:"bytes,12,opt,name=playground_client_secret,json=playgroundClientSecret,proto3" json:"playground_client_secret,omitempty"`
-	PlaygroundRedirect     string   `protobuf:"bytes,13,opt,name=playground_redirect,json=playgroundRedirect,proto3" json:"playground_redirect,omitempty"`
+	// gtype is the type of the connections
+	Gtype string `protobuf:"bytes,1,opt,name=gtype,proto3" json:"gtype,omitempty"`
+	// in_degree is the number of connections to the doc
+	InDegree uint64 `protobuf:"varint,2,opt,name=in_degree,json=inDegree,proto3" json:"in_degree,omitempty"`
+	// out_degree is the number of connections from the doc
+	OutDegree uint64 `protobuf:"varint,3,opt,name=out_degree,json=outDegree,proto3" json:"out_degree,omitempty"`
 }
 
-func (x *Flags) Reset() {
-	*x = Flags{}
+func (x *GtypeDegree) Reset() {
+	*x = GtypeDegree{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_graphik_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2151,13 +2562,13 @@ func (x *Flags) Reset() {
 	}
 }
 
-func (x *Flags) String() string {
+func (x *GtypeDegree) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Flags) ProtoMessage() {}
+func (*GtypeDegree) ProtoMessage() {}
 
-func (x *Flags) ProtoReflect() protoreflect.Message {
+func (x *GtypeDegree) ProtoReflect() protoreflect.Message {
 	mi := &file_graphik_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2169,121 +2580,152 @@ func (x *Flags) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Flags.ProtoReflect.Descriptor instead.
-func (*Flags) Descriptor() ([]byte, []int) {
+// Deprecated: Use GtypeDegree.ProtoReflect.Descriptor instead.
+func (*GtypeDegree) Descriptor() ([]byte, []int) {
 	return file_graphik_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *Flags) GetOpenIdDiscovery() string {
+func (x *GtypeDegree) GetGtype() string {
 	if x != nil {
-		return x.OpenIdDiscovery
+		return x.Gtype
 	}
 	return ""
 }
 
-func (x *Flags) GetStoragePath() string {
+func (x *GtypeDegree) GetInDegree() uint64 {
 	if x != nil {
-		return x.StoragePath
+		return x.InDegree
 	}
-	return ""
+	return 0
 }
 
-func (x *Flags) GetMetrics() bool {
+func (x *GtypeDegree) GetOutDegree() uint64 {
 	if x != nil {
-		return x.Metrics
+		return x.OutDegree
 	}
-	return false
+	return 0
 }
 
-func (x *Flags) GetAllowHeaders() []string {
-	if x != nil {
-		return x.AllowHeaders
-	}
-	return nil
+// Degree is the number of connections to & from a doc. Undirected connections count towards both.
+type Degree struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Doc *Ref `protobuf:"bytes,1,opt,name=doc,proto3" json:"doc,omitempty"`
+	// in_degree is the number of connections to the doc
+	InDegree uint64 `protobuf:"varint,2,opt,name=in_degree,json=inDegree,proto3" json:"in_degree,omitempty"`
+	// out_degree is the number of connections from the doc
+	OutDegree uint64 `protobuf:"varint,3,opt,name=out_degree,json=outDegree,proto3" json:"out_degree,omitempty"`
+	// gtypes are the degrees of each type of connection in alphabetical order
+	Gtypes []*GtypeDegree `protobuf:"bytes,4,rep,name=gtypes,proto3" json:"gtypes,omitempty"`
 }
 
-func (x *Flags) GetAllowMethods() []string {
-	if x != nil {
-		return x.AllowMethods
+func (x *Degree) Reset() {
+	*x = Degree{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Flags) GetAllowOrigins() []string {
-	if x != nil {
-		return x.AllowOrigins
-	}
-	return nil
+func (x *Degree) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Flags) GetRootUsers() []string {
-	if x != nil {
-		return x.RootUsers
+func (*Degree) ProtoMessage() {}
+
+func (x *Degree) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Flags) GetTlsCert() string {
-	if x != nil {
-		return x.TlsCert
-	}
-	return ""
+// Deprecated: Use Degree.ProtoReflect.Descriptor instead.
+func (*Degree) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *Flags) GetTlsKey() string {
+func (x *Degree) GetDoc() *Ref {
 	if x != nil {
-		return x.TlsKey
+		return x.Doc
 	}
-	return ""
+	return nil
 }
 
-func (x *Flags) GetPlaygroundClientId() string {
+func (x *Degree) GetInDegree() uint64 {
 	if x != nil {
-		return x.PlaygroundClientId
+		return x.InDegree
 	}
-	return ""
+	return 0
 }
 
-func (x *Flags) GetPlaygroundClientSecret() string {
+func (x *Degree) GetOutDegree() uint64 {
 	if x != nil {
-		return x.PlaygroundClientSecret
+		return x.OutDegree
 	}
-	return ""
+	return 0
 }
 
-func (x *Flags) GetPlaygroundRedirect() string {
+func (x *Degree) GetGtypes() []*GtypeDegree {
 	if x != nil {
-		return x.PlaygroundRedirect
+		return x.Gtypes
 	}
-	return ""
+	return nil
 }
 
-// Boolean is a simple boolean value
-type Boolean struct {
+// Filter is a generic filter using Common Expression Language
+type Filter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value bool `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	// gtype is the doc/connection type to be filtered
+	Gtype string `protobuf:"bytes,1,opt,name=gtype,proto3" json:"gtype,omitempty"`
+	// expression is a CEL expression used to filter connections/modes
+	Expression string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	// limit is the maximum number of items to return
+	Limit uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// custom sorting of the results. SearchDocs also sorts by degree(most connected first) ex: degree.in.follows, degree.out
+	Sort string `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	// seek to a specific key for pagination
+	Seek string `protobuf:"bytes,5,opt,name=seek,proto3" json:"seek,omitempty"`
+	// reverse the results
+	Reverse bool `protobuf:"varint,6,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	// index overrides the query planner - searching a specific EXPRESSION index. If empty, the planner picks the cheapest
+	// of a full scan, an EXPRESSION index whose expression is part of the filter's expression & a VALUE index on an attribute
+	// the filter's expression compares to a constant.
+	Index string `protobuf:"bytes,7,opt,name=index,proto3" json:"index,omitempty"`
+	// dry_run previews a bulk mutation(DelDocs, DelConnections, EditDocs, EditConnections) without committing it. Searches, streams & aggregations reject it.
+	DryRun bool `protobuf:"varint,8,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// budget limits the work done by searches & aggregations
+	Budget *Budget `protobuf:"bytes,9,opt,name=budget,proto3" json:"budget,omitempty"`
 }
 
-func (x *Boolean) Reset() {
-	*x = Boolean{}
+func (x *Filter) Reset() {
+	*x = Filter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphik_proto_msgTypes[30]
+		mi := &file_graphik_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Boolean) String() string {
+func (x *Filter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Boolean) ProtoMessage() {}
+func (*Filter) ProtoMessage() {}
 
-func (x *Boolean) ProtoReflect() protoreflect.Message {
-	mi := &file_graphik_proto_msgTypes[30]
+func (x *Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2294,84 +2736,91 @@ func (x *Boolean) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Boolean.ProtoReflect.Descriptor instead.
-func (*Boolean) Descriptor() ([]byte, []int) {
-	return file_graphik_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use Filter.ProtoReflect.Descriptor instead.
+func (*Filter) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *Boolean) GetValue() bool {
+func (x *Filter) GetGtype() string {
 	if x != nil {
-		return x.Value
+		return x.Gtype
 	}
-	return false
+	return ""
 }
 
-// Number is a simple double/float64 value
-type Number struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Filter) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
 
-	Value float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+func (x *Filter) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
 }
 
-func (x *Number) Reset() {
-	*x = Number{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_graphik_proto_msgTypes[31]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Filter) GetSort() string {
+	if x != nil {
+		return x.Sort
 	}
+	return ""
 }
 
-func (x *Number) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Filter) GetSeek() string {
+	if x != nil {
+		return x.Seek
+	}
+	return ""
 }
 
-func (*Number) ProtoMessage() {}
+func (x *Filter) GetReverse() bool {
+	if x != nil {
+		return x.Reverse
+	}
+	return false
+}
 
-func (x *Number) ProtoReflect() protoreflect.Message {
-	mi := &file_graphik_proto_msgTypes[31]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Filter) GetIndex() string {
+	if x != nil {
+		return x.Index
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Number.ProtoReflect.Descriptor instead.
-func (*Number) Descriptor() ([]byte, []int) {
-	return file_graphik_proto_rawDescGZIP(), []int{31}
+func (x *Filter) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
 }
 
-func (x *Number) GetValue() float64 {
+func (x *Filter) GetBudget() *Budget {
 	if x != nil {
-		return x.Value
+		return x.Budget
 	}
-	return 0
+	return nil
 }
 
-// ExistsFilter is used as input into all Exists(boolean) related queries.
-type ExistsFilter struct {
+// Budget limits the work done by a search, aggregation or traversal. Zero values use the server's limits & values greater
+// than the server's limits are capped by them. Queries that exceed their budget return RESOURCE_EXHAUSTED.
+type Budget struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Gtype      string `protobuf:"bytes,1,opt,name=gtype,proto3" json:"gtype,omitempty"`
-	Expression string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
-	// seek to a specific key for pagination
-	Seek string `protobuf:"bytes,3,opt,name=seek,proto3" json:"seek,omitempty"`
-	// reverse the results
-	Reverse bool `protobuf:"varint,4,opt,name=reverse,proto3" json:"reverse,omitempty"`
-	// search in a specific index
-	Index string `protobuf:"bytes,5,opt,name=index,proto3" json:"index,omitempty"`
+	// max_scan is the maximum number of keys read from the db
+	MaxScan uint64 `protobuf:"varint,1,opt,name=max_scan,json=maxScan,proto3" json:"max_scan,omitempty"`
+	// max_evaluations is the maximum number of CEL expression evaluations
+	MaxEvaluations uint64 `protobuf:"varint,2,opt,name=max_evaluations,json=maxEvaluations,proto3" json:"max_evaluations,omitempty"`
+	// timeout is the maximum duration of the query
+	Timeout *duration.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
 }
 
-func (x *ExistsFilter) Reset() {
-	*x = ExistsFilter{}
+func (x *Budget) Reset() {
+	*x = Budget{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_graphik_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2379,13 +2828,13 @@ func (x *ExistsFilter) Reset() {
 	}
 }
 
-func (x *ExistsFilter) String() string {
+func (x *Budget) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExistsFilter) ProtoMessage() {}
+func (*Budget) ProtoMessage() {}
 
-func (x *ExistsFilter) ProtoReflect() protoreflect.Message {
+func (x *Budget) ProtoReflect() protoreflect.Message {
 	mi := &file_graphik_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2397,60 +2846,50 @@ func (x *ExistsFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExistsFilter.ProtoReflect.Descriptor instead.
-func (*ExistsFilter) Descriptor() ([]byte, []int) {
+// Deprecated: Use Budget.ProtoReflect.Descriptor instead.
+func (*Budget) Descriptor() ([]byte, []int) {
 	return file_graphik_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ExistsFilter) GetGtype() string {
-	if x != nil {
-		return x.Gtype
-	}
-	return ""
-}
-
-func (x *ExistsFilter) GetExpression() string {
-	if x != nil {
-		return x.Expression
-	}
-	return ""
-}
-
-func (x *ExistsFilter) GetSeek() string {
+func (x *Budget) GetMaxScan() uint64 {
 	if x != nil {
-		return x.Seek
+		return x.MaxScan
 	}
-	return ""
+	return 0
 }
 
-func (x *ExistsFilter) GetReverse() bool {
+func (x *Budget) GetMaxEvaluations() uint64 {
 	if x != nil {
-		return x.Reverse
+		return x.MaxEvaluations
 	}
-	return false
+	return 0
 }
 
-func (x *ExistsFilter) GetIndex() string {
+func (x *Budget) GetTimeout() *duration.Duration {
 	if x != nil {
-		return x.Index
+		return x.Timeout
 	}
-	return ""
+	return nil
 }
 
-// Edit patches the attributes of a Doc or Connection
-type Edit struct {
+// TextSearchFilter is used to search a FULL_TEXT index
+type TextSearchFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ref is the ref to the target doc/connection to patch
-	Ref *Ref `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
-	// attributes are k/v pairs used to overwrite k/v pairs on a doc/connection
-	Attributes *_struct.Struct `protobuf:"bytes,2,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// index is the name of the FULL_TEXT index to search
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	// query is the text to search for ex: "quick brown fox"
+	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// limit is the maximum number of items to return
+	Limit uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// expression is an optional CEL expression used to filter ranked docs
+	Expression string `protobuf:"bytes,4,opt,name=expression,proto3" json:"expression,omitempty"`
 }
 
-func (x *Edit) Reset() {
-	*x = Edit{}
+func (x *TextSearchFilter) Reset() {
+	*x = TextSearchFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_graphik_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2458,13 +2897,13 @@ func (x *Edit) Reset() {
 	}
 }
 
-func (x *Edit) String() string {
+func (x *TextSearchFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Edit) ProtoMessage() {}
+func (*TextSearchFilter) ProtoMessage() {}
 
-func (x *Edit) ProtoReflect() protoreflect.Message {
+func (x *TextSearchFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_graphik_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2476,39 +2915,51 @@ func (x *Edit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Edit.ProtoReflect.Descriptor instead.
-func (*Edit) Descriptor() ([]byte, []int) {
+// Deprecated: Use TextSearchFilter.ProtoReflect.Descriptor instead.
+func (*TextSearchFilter) Descriptor() ([]byte, []int) {
 	return file_graphik_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *Edit) GetRef() *Ref {
+func (x *TextSearchFilter) GetIndex() string {
 	if x != nil {
-		return x.Ref
+		return x.Index
 	}
-	return nil
+	return ""
 }
 
-func (x *Edit) GetAttributes() *_struct.Struct {
+func (x *TextSearchFilter) GetQuery() string {
 	if x != nil {
-		return x.Attributes
+		return x.Query
 	}
-	return nil
+	return ""
 }
 
-// EditFilter is used to patch/edit docs/connections
-type EditFilter struct {
+func (x *TextSearchFilter) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *TextSearchFilter) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+// ScoredDoc is a doc & it's relevance score
+type ScoredDoc struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// filter is used to filter docs/connections to patch
-	Filter *Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-	// attributes are k/v pairs used to overwrite k/v pairs on all docs/connections that pass the filter
-	Attributes *_struct.Struct `protobuf:"bytes,2,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	Doc   *Doc    `protobuf:"bytes,1,opt,name=doc,proto3" json:"doc,omitempty"`
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
 }
 
-func (x *EditFilter) Reset() {
-	*x = EditFilter{}
+func (x *ScoredDoc) Reset() {
+	*x = ScoredDoc{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_graphik_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2516,13 +2967,13 @@ func (x *EditFilter) Reset() {
 	}
 }
 
-func (x *EditFilter) String() string {
+func (x *ScoredDoc) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditFilter) ProtoMessage() {}
+func (*ScoredDoc) ProtoMessage() {}
 
-func (x *EditFilter) ProtoReflect() protoreflect.Message {
+func (x *ScoredDoc) ProtoReflect() protoreflect.Message {
 	mi := &file_graphik_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2534,37 +2985,36 @@ func (x *EditFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditFilter.ProtoReflect.Descriptor instead.
-func (*EditFilter) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScoredDoc.ProtoReflect.Descriptor instead.
+func (*ScoredDoc) Descriptor() ([]byte, []int) {
 	return file_graphik_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *EditFilter) GetFilter() *Filter {
+func (x *ScoredDoc) GetDoc() *Doc {
 	if x != nil {
-		return x.Filter
+		return x.Doc
 	}
 	return nil
 }
 
-func (x *EditFilter) GetAttributes() *_struct.Struct {
+func (x *ScoredDoc) GetScore() float64 {
 	if x != nil {
-		return x.Attributes
+		return x.Score
 	}
-	return nil
+	return 0
 }
 
-// Pong returns PONG if the server is healthy
-type Pong struct {
+// ScoredDocs is an array of ScoredDoc sorted by score(highest first)
+type ScoredDocs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// message returns PONG if healthy
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Docs []*ScoredDoc `protobuf:"bytes,1,rep,name=docs,proto3" json:"docs,omitempty"`
 }
 
-func (x *Pong) Reset() {
-	*x = Pong{}
+func (x *ScoredDocs) Reset() {
+	*x = ScoredDocs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_graphik_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2572,13 +3022,13 @@ func (x *Pong) Reset() {
 	}
 }
 
-func (x *Pong) String() string {
+func (x *ScoredDocs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pong) ProtoMessage() {}
+func (*ScoredDocs) ProtoMessage() {}
 
-func (x *Pong) ProtoReflect() protoreflect.Message {
+func (x *ScoredDocs) ProtoReflect() protoreflect.Message {
 	mi := &file_graphik_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2590,32 +3040,30 @@ func (x *Pong) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pong.ProtoReflect.Descriptor instead.
-func (*Pong) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScoredDocs.ProtoReflect.Descriptor instead.
+func (*ScoredDocs) Descriptor() ([]byte, []int) {
 	return file_graphik_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *Pong) GetMessage() string {
+func (x *ScoredDocs) GetDocs() []*ScoredDoc {
 	if x != nil {
-		return x.Message
+		return x.Docs
 	}
-	return ""
+	return nil
 }
 
-// OutboundMessage is a message to be published to a pubsub channel
-type OutboundMessage struct {
+// GeoPoint is a latitude/longitude pair in degrees
+type GeoPoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// channel is the target channel to send the message to
-	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	// data is the data to send with the message
-	Data *_struct.Struct `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Lat float64 `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lng float64 `protobuf:"fixed64,2,opt,name=lng,proto3" json:"lng,omitempty"`
 }
 
-func (x *OutboundMessage) Reset() {
-	*x = OutboundMessage{}
+func (x *GeoPoint) Reset() {
+	*x = GeoPoint{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_graphik_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2623,13 +3071,13 @@ func (x *OutboundMessage) Reset() {
 	}
 }
 
-func (x *OutboundMessage) String() string {
+func (x *GeoPoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OutboundMessage) ProtoMessage() {}
+func (*GeoPoint) ProtoMessage() {}
 
-func (x *OutboundMessage) ProtoReflect() protoreflect.Message {
+func (x *GeoPoint) ProtoReflect() protoreflect.Message {
 	mi := &file_graphik_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2641,44 +3089,37 @@ func (x *OutboundMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OutboundMessage.ProtoReflect.Descriptor instead.
-func (*OutboundMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use GeoPoint.ProtoReflect.Descriptor instead.
+func (*GeoPoint) Descriptor() ([]byte, []int) {
 	return file_graphik_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *OutboundMessage) GetChannel() string {
+func (x *GeoPoint) GetLat() float64 {
 	if x != nil {
-		return x.Channel
+		return x.Lat
 	}
-	return ""
+	return 0
 }
 
-func (x *OutboundMessage) GetData() *_struct.Struct {
+func (x *GeoPoint) GetLng() float64 {
 	if x != nil {
-		return x.Data
+		return x.Lng
 	}
-	return nil
+	return 0
 }
 
-// Message is received on PubSub subscriptions
-type Message struct {
+// GeoRadius matches points within meters of the center
+type GeoRadius struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// channel is the channel the message was sent to
-	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	// data is the data sent with the message
-	Data *_struct.Struct `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	// user is the sender that triggered/sent the message
-	User *Ref `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
-	// timestamp is when the message was sent
-	Timestamp *timestamp.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Method    string               `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	Center *GeoPoint `protobuf:"bytes,1,opt,name=center,proto3" json:"center,omitempty"`
+	Meters float64   `protobuf:"fixed64,2,opt,name=meters,proto3" json:"meters,omitempty"`
 }
 
-func (x *Message) Reset() {
-	*x = Message{}
+func (x *GeoRadius) Reset() {
+	*x = GeoRadius{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_graphik_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2686,13 +3127,13 @@ func (x *Message) Reset() {
 	}
 }
 
-func (x *Message) String() string {
+func (x *GeoRadius) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Message) ProtoMessage() {}
+func (*GeoRadius) ProtoMessage() {}
 
-func (x *Message) ProtoReflect() protoreflect.Message {
+func (x *GeoRadius) ProtoReflect() protoreflect.Message {
 	mi := &file_graphik_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2704,78 +3145,121 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Message.ProtoReflect.Descriptor instead.
-func (*Message) Descriptor() ([]byte, []int) {
+// Deprecated: Use GeoRadius.ProtoReflect.Descriptor instead.
+func (*GeoRadius) Descriptor() ([]byte, []int) {
 	return file_graphik_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *Message) GetChannel() string {
+func (x *GeoRadius) GetCenter() *GeoPoint {
 	if x != nil {
-		return x.Channel
+		return x.Center
 	}
-	return ""
+	return nil
 }
 
-func (x *Message) GetData() *_struct.Struct {
+func (x *GeoRadius) GetMeters() float64 {
 	if x != nil {
-		return x.Data
+		return x.Meters
 	}
-	return nil
+	return 0
 }
 
-func (x *Message) GetUser() *Ref {
-	if x != nil {
-		return x.User
+// GeoBox matches points within a bounding box. A box whose min lng is greater than it's max lng crosses the antimeridian.
+type GeoBox struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// min is the south west corner of the box
+	Min *GeoPoint `protobuf:"bytes,1,opt,name=min,proto3" json:"min,omitempty"`
+	// max is the north east corner of the box
+	Max *GeoPoint `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *GeoBox) Reset() {
+	*x = GeoBox{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Message) GetTimestamp() *timestamp.Timestamp {
+func (x *GeoBox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoBox) ProtoMessage() {}
+
+func (x *GeoBox) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoBox.ProtoReflect.Descriptor instead.
+func (*GeoBox) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GeoBox) GetMin() *GeoPoint {
 	if x != nil {
-		return x.Timestamp
+		return x.Min
 	}
 	return nil
 }
 
-func (x *Message) GetMethod() string {
+func (x *GeoBox) GetMax() *GeoPoint {
 	if x != nil {
-		return x.Method
+		return x.Max
 	}
-	return ""
+	return nil
 }
 
-// Schema returns registered connection & doc types
-type Schema struct {
+// GeoFilter is used to search a GEO index
+type GeoFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// connection_types are the types of connections in the graph
-	ConnectionTypes []string `protobuf:"bytes,1,rep,name=connection_types,json=connectionTypes,proto3" json:"connection_types,omitempty"`
-	// doc_types are the types of docs in the graph
-	DocTypes    []string        `protobuf:"bytes,2,rep,name=doc_types,json=docTypes,proto3" json:"doc_types,omitempty"`
-	Authorizers *Authorizers    `protobuf:"bytes,3,opt,name=authorizers,proto3" json:"authorizers,omitempty"`
-	Validators  *TypeValidators `protobuf:"bytes,4,opt,name=validators,proto3" json:"validators,omitempty"`
-	Indexes     *Indexes        `protobuf:"bytes,5,opt,name=indexes,proto3" json:"indexes,omitempty"`
+	// index is the name of the GEO index to search
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Types that are assignable to Query:
+	//	*GeoFilter_Radius
+	//	*GeoFilter_Box
+	//	*GeoFilter_Nearest
+	Query isGeoFilter_Query `protobuf_oneof:"query"`
+	// limit is the maximum number of items to return
+	Limit uint64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// expression is an optional CEL expression used to filter docs
+	Expression string `protobuf:"bytes,6,opt,name=expression,proto3" json:"expression,omitempty"`
+	// traverse optionally limits results to docs found by a graph traversal ex: places connected to a user
+	Traverse *TraverseFilter `protobuf:"bytes,7,opt,name=traverse,proto3" json:"traverse,omitempty"`
 }
 
-func (x *Schema) Reset() {
-	*x = Schema{}
+func (x *GeoFilter) Reset() {
+	*x = GeoFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphik_proto_msgTypes[38]
+		mi := &file_graphik_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Schema) String() string {
+func (x *GeoFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Schema) ProtoMessage() {}
+func (*GeoFilter) ProtoMessage() {}
 
-func (x *Schema) ProtoReflect() protoreflect.Message {
-	mi := &file_graphik_proto_msgTypes[38]
+func (x *GeoFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2786,72 +3270,119 @@ func (x *Schema) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Schema.ProtoReflect.Descriptor instead.
-func (*Schema) Descriptor() ([]byte, []int) {
-	return file_graphik_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use GeoFilter.ProtoReflect.Descriptor instead.
+func (*GeoFilter) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *Schema) GetConnectionTypes() []string {
+func (x *GeoFilter) GetIndex() string {
 	if x != nil {
-		return x.ConnectionTypes
+		return x.Index
+	}
+	return ""
+}
+
+func (m *GeoFilter) GetQuery() isGeoFilter_Query {
+	if m != nil {
+		return m.Query
 	}
 	return nil
 }
 
-func (x *Schema) GetDocTypes() []string {
-	if x != nil {
-		return x.DocTypes
+func (x *GeoFilter) GetRadius() *GeoRadius {
+	if x, ok := x.GetQuery().(*GeoFilter_Radius); ok {
+		return x.Radius
 	}
 	return nil
 }
 
-func (x *Schema) GetAuthorizers() *Authorizers {
-	if x != nil {
-		return x.Authorizers
+func (x *GeoFilter) GetBox() *GeoBox {
+	if x, ok := x.GetQuery().(*GeoFilter_Box); ok {
+		return x.Box
 	}
 	return nil
 }
 
-func (x *Schema) GetValidators() *TypeValidators {
-	if x != nil {
-		return x.Validators
+func (x *GeoFilter) GetNearest() *GeoPoint {
+	if x, ok := x.GetQuery().(*GeoFilter_Nearest); ok {
+		return x.Nearest
 	}
 	return nil
 }
 
-func (x *Schema) GetIndexes() *Indexes {
+func (x *GeoFilter) GetLimit() uint64 {
 	if x != nil {
-		return x.Indexes
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GeoFilter) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *GeoFilter) GetTraverse() *TraverseFilter {
+	if x != nil {
+		return x.Traverse
 	}
 	return nil
 }
 
-type ExprFilter struct {
+type isGeoFilter_Query interface {
+	isGeoFilter_Query()
+}
+
+type GeoFilter_Radius struct {
+	// radius returns docs within a radius sorted by distance
+	Radius *GeoRadius `protobuf:"bytes,2,opt,name=radius,proto3,oneof"`
+}
+
+type GeoFilter_Box struct {
+	// box returns docs within a bounding box
+	Box *GeoBox `protobuf:"bytes,3,opt,name=box,proto3,oneof"`
+}
+
+type GeoFilter_Nearest struct {
+	// nearest returns the k(limit) docs nearest to a point sorted by distance
+	Nearest *GeoPoint `protobuf:"bytes,4,opt,name=nearest,proto3,oneof"`
+}
+
+func (*GeoFilter_Radius) isGeoFilter_Query() {}
+
+func (*GeoFilter_Box) isGeoFilter_Query() {}
+
+func (*GeoFilter_Nearest) isGeoFilter_Query() {}
+
+// GeoDoc is a doc & it's distance(meters) from the center of a radius/nearest query
+type GeoDoc struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// expression is a CEL expression used to filter connections/nodes
-	Expression string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	Doc    *Doc    `protobuf:"bytes,1,opt,name=doc,proto3" json:"doc,omitempty"`
+	Meters float64 `protobuf:"fixed64,2,opt,name=meters,proto3" json:"meters,omitempty"`
 }
 
-func (x *ExprFilter) Reset() {
-	*x = ExprFilter{}
+func (x *GeoDoc) Reset() {
+	*x = GeoDoc{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphik_proto_msgTypes[39]
+		mi := &file_graphik_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExprFilter) String() string {
+func (x *GeoDoc) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExprFilter) ProtoMessage() {}
+func (*GeoDoc) ProtoMessage() {}
 
-func (x *ExprFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_graphik_proto_msgTypes[39]
+func (x *GeoDoc) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2862,50 +3393,51 @@ func (x *ExprFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExprFilter.ProtoReflect.Descriptor instead.
-func (*ExprFilter) Descriptor() ([]byte, []int) {
-	return file_graphik_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use GeoDoc.ProtoReflect.Descriptor instead.
+func (*GeoDoc) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ExprFilter) GetExpression() string {
+func (x *GeoDoc) GetDoc() *Doc {
 	if x != nil {
-		return x.Expression
+		return x.Doc
 	}
-	return ""
+	return nil
 }
 
-type Request struct {
+func (x *GeoDoc) GetMeters() float64 {
+	if x != nil {
+		return x.Meters
+	}
+	return 0
+}
+
+// GeoDocs is an array of GeoDoc
+type GeoDocs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// method is the rpc method
-	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
-	// user is the user making the request
-	User *Doc `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
-	// timestamp is when the intercept was received
-	Timestamp *timestamp.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// request is the intercepted request
-	Request *_struct.Struct `protobuf:"bytes,4,opt,name=request,proto3" json:"request,omitempty"`
+	Docs []*GeoDoc `protobuf:"bytes,1,rep,name=docs,proto3" json:"docs,omitempty"`
 }
 
-func (x *Request) Reset() {
-	*x = Request{}
+func (x *GeoDocs) Reset() {
+	*x = GeoDocs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphik_proto_msgTypes[40]
+		mi := &file_graphik_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Request) String() string {
+func (x *GeoDocs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Request) ProtoMessage() {}
+func (*GeoDocs) ProtoMessage() {}
 
-func (x *Request) ProtoReflect() protoreflect.Message {
-	mi := &file_graphik_proto_msgTypes[40]
+func (x *GeoDocs) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2916,785 +3448,6771 @@ func (x *Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Request.ProtoReflect.Descriptor instead.
-func (*Request) Descriptor() ([]byte, []int) {
-	return file_graphik_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use GeoDocs.ProtoReflect.Descriptor instead.
+func (*GeoDocs) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *Request) GetMethod() string {
+func (x *GeoDocs) GetDocs() []*GeoDoc {
 	if x != nil {
-		return x.Method
+		return x.Docs
 	}
-	return ""
+	return nil
 }
 
-func (x *Request) GetUser() *Doc {
-	if x != nil {
-		return x.User
+// ExplainFilter is the search or traversal to explain
+type ExplainFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Query:
+	//	*ExplainFilter_SearchDocs
+	//	*ExplainFilter_SearchConnections
+	//	*ExplainFilter_Traverse
+	Query isExplainFilter_Query `protobuf_oneof:"query"`
+}
+
+func (x *ExplainFilter) Reset() {
+	*x = ExplainFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainFilter) ProtoMessage() {}
+
+func (x *ExplainFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainFilter.ProtoReflect.Descriptor instead.
+func (*ExplainFilter) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{42}
+}
+
+func (m *ExplainFilter) GetQuery() isExplainFilter_Query {
+	if m != nil {
+		return m.Query
 	}
 	return nil
 }
 
-func (x *Request) GetTimestamp() *timestamp.Timestamp {
-	if x != nil {
-		return x.Timestamp
+func (x *ExplainFilter) GetSearchDocs() *Filter {
+	if x, ok := x.GetQuery().(*ExplainFilter_SearchDocs); ok {
+		return x.SearchDocs
 	}
 	return nil
 }
 
-func (x *Request) GetRequest() *_struct.Struct {
-	if x != nil {
-		return x.Request
+func (x *ExplainFilter) GetSearchConnections() *Filter {
+	if x, ok := x.GetQuery().(*ExplainFilter_SearchConnections); ok {
+		return x.SearchConnections
 	}
 	return nil
 }
 
-var File_graphik_proto protoreflect.FileDescriptor
+func (x *ExplainFilter) GetTraverse() *TraverseFilter {
+	if x, ok := x.GetQuery().(*ExplainFilter_Traverse); ok {
+		return x.Traverse
+	}
+	return nil
+}
 
-var file_graphik_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x03, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x36, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x77, 0x69, 0x74, 0x6b, 0x6f, 0x77, 0x2f,
-	0x67, 0x6f, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x51, 0x0a, 0x03, 0x52, 0x65, 0x66, 0x12, 0x26, 0x0a, 0x05, 0x67, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a,
-	0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x05, 0x67, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x67, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d,
-	0x24, 0x52, 0x03, 0x67, 0x69, 0x64, 0x22, 0x4a, 0x0a, 0x0e, 0x52, 0x65, 0x66, 0x43, 0x6f, 0x6e,
-	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x67, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e,
-	0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x67, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x67,
-	0x69, 0x64, 0x22, 0x24, 0x0a, 0x04, 0x52, 0x65, 0x66, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x72, 0x65,
-	0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
-	0x65, 0x66, 0x52, 0x04, 0x72, 0x65, 0x66, 0x73, 0x22, 0x62, 0x0a, 0x03, 0x44, 0x6f, 0x63, 0x12,
-	0x22, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x03,
-	0x72, 0x65, 0x66, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x78, 0x0a, 0x0e,
-	0x44, 0x6f, 0x63, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2d,
-	0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x52, 0x65, 0x66, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72,
-	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x37, 0x0a,
-	0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x3a, 0x0a, 0x0f, 0x44, 0x6f, 0x63, 0x43, 0x6f, 0x6e,
-	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x64, 0x6f, 0x63,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f,
-	0x63, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x04, 0x64, 0x6f,
-	0x63, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c,
-	0x12, 0x1a, 0x0a, 0x03, 0x64, 0x6f, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x52, 0x03, 0x64, 0x6f, 0x63, 0x12, 0x2f, 0x0a, 0x0e,
-	0x74, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x0d,
-	0x74, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a,
-	0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x65,
-	0x70, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x04, 0x68, 0x6f, 0x70, 0x73, 0x22, 0x3c, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x76, 0x65,
-	0x72, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x2e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73,
-	0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x76, 0x65,
-	0x72, 0x73, 0x61, 0x6c, 0x73, 0x22, 0x41, 0x0a, 0x04, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x1c, 0x0a,
-	0x04, 0x64, 0x6f, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x44, 0x6f, 0x63, 0x52, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73,
-	0x65, 0x65, 0x6b, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x73, 0x65, 0x65, 0x6b, 0x4e, 0x65, 0x78, 0x74, 0x22, 0xcd, 0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x42, 0x06,
-	0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x37, 0x0a, 0x0a, 0x61,
-	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x12, 0x24, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01,
-	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x20, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x42, 0x06, 0xe2, 0xdf,
-	0x1f, 0x02, 0x20, 0x01, 0x52, 0x02, 0x74, 0x6f, 0x22, 0xe3, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75,
-	0x63, 0x74, 0x6f, 0x72, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x03, 0x72, 0x65,
-	0x66, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a,
-	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x42, 0x06,
-	0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x20, 0x0a, 0x02,
-	0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
-	0x65, 0x66, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x02, 0x74, 0x6f, 0x22, 0xcb,
-	0x01, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x67,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a,
-	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x42, 0x06,
-	0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0xa7, 0x01, 0x0a,
-	0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x65,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x67,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a,
-	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x56, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x73,
-	0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f,
-	0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5d,
-	0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a,
-	0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x65, 0x6b, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x65, 0x6b, 0x4e, 0x65, 0x78, 0x74, 0x22, 0x99, 0x02,
-	0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
-	0x29, 0x0a, 0x07, 0x64, 0x6f, 0x63, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02,
-	0x20, 0x01, 0x52, 0x06, 0x64, 0x6f, 0x63, 0x52, 0x65, 0x66, 0x12, 0x26, 0x0a, 0x05, 0x67, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a,
-	0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x05, 0x67, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x10, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x12, 0x49, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35,
-	0xe2, 0xdf, 0x1f, 0x31, 0x0a, 0x2f, 0x28, 0x28, 0x5e, 0x7c, 0x2c, 0x20, 0x29, 0x28, 0x7c, 0x72,
-	0x65, 0x66, 0x2e, 0x67, 0x69, 0x64, 0x7c, 0x72, 0x65, 0x66, 0x2e, 0x67, 0x74, 0x79, 0x70, 0x65,
-	0x7c, 0x5e, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x28, 0x2e, 0x2a,
-	0x29, 0x29, 0x29, 0x2b, 0x24, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x65, 0x65, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x65, 0x65, 0x6b, 0x12,
-	0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x22, 0xfd, 0x01, 0x0a, 0x06, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c,
-	0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f,
-	0x02, 0x10, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x49, 0x0a, 0x04, 0x73, 0x6f,
-	0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35, 0xe2, 0xdf, 0x1f, 0x31, 0x0a, 0x2f,
-	0x28, 0x28, 0x5e, 0x7c, 0x2c, 0x20, 0x29, 0x28, 0x7c, 0x72, 0x65, 0x66, 0x2e, 0x67, 0x69, 0x64,
-	0x7c, 0x72, 0x65, 0x66, 0x2e, 0x67, 0x74, 0x79, 0x70, 0x65, 0x7c, 0x5e, 0x61, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x28, 0x2e, 0x2a, 0x29, 0x29, 0x29, 0x2b, 0x24, 0x52,
-	0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x6b, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x65, 0x65, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76,
-	0x65, 0x72, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65,
-	0x72, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xa1, 0x01, 0x0a, 0x09, 0x41, 0x67,
-	0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x06, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x67,
-	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
-	0x74, 0x65, 0x12, 0x39, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x23, 0xe2, 0xdf, 0x1f, 0x1f, 0x0a, 0x1d, 0x28, 0x28, 0x5e, 0x7c, 0x2c, 0x20, 0x29,
-	0x28, 0x7c, 0x5e, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x28, 0x2e,
-	0x2a, 0x29, 0x29, 0x29, 0x2b, 0x24, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x8b, 0x03,
-	0x0a, 0x0e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x12, 0x24, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01,
-	0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x6f, 0x63, 0x5f, 0x65, 0x78,
-	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x64, 0x6f, 0x63, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a,
-	0x15, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x72,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x10, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x12, 0x49, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35,
-	0xe2, 0xdf, 0x1f, 0x31, 0x0a, 0x2f, 0x28, 0x28, 0x5e, 0x7c, 0x2c, 0x20, 0x29, 0x28, 0x7c, 0x72,
-	0x65, 0x66, 0x2e, 0x67, 0x69, 0x64, 0x7c, 0x72, 0x65, 0x66, 0x2e, 0x67, 0x74, 0x79, 0x70, 0x65,
-	0x7c, 0x5e, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x28, 0x2e, 0x2a,
-	0x29, 0x29, 0x29, 0x2b, 0x24, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72,
-	0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65,
-	0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
-	0x68, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
-	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69,
-	0x74, 0x68, 0x6d, 0x12, 0x23, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x10, 0x00, 0x52, 0x08,
-	0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f,
-	0x68, 0x6f, 0x70, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02,
-	0x10, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x48, 0x6f, 0x70, 0x73, 0x22, 0xe7, 0x02, 0x0a, 0x10,
-	0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4d, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x12, 0x25, 0x0a, 0x0e, 0x64, 0x6f, 0x63, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x6f, 0x63, 0x45, 0x78, 0x70,
-	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f,
-	0x02, 0x10, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x49, 0x0a, 0x04, 0x73, 0x6f,
-	0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35, 0xe2, 0xdf, 0x1f, 0x31, 0x0a, 0x2f,
-	0x28, 0x28, 0x5e, 0x7c, 0x2c, 0x20, 0x29, 0x28, 0x7c, 0x72, 0x65, 0x66, 0x2e, 0x67, 0x69, 0x64,
-	0x7c, 0x72, 0x65, 0x66, 0x2e, 0x67, 0x74, 0x79, 0x70, 0x65, 0x7c, 0x5e, 0x61, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x28, 0x2e, 0x2a, 0x29, 0x29, 0x29, 0x2b, 0x24, 0x52,
-	0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12,
-	0x2c, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
-	0x68, 0x6d, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x23, 0x0a,
-	0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x10, 0x00, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70,
-	0x74, 0x68, 0x12, 0x21, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x68, 0x6f, 0x70, 0x73, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x10, 0x00, 0x52, 0x07, 0x6d, 0x61,
-	0x78, 0x48, 0x6f, 0x70, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x43,
-	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a,
-	0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x26, 0x0a, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d,
-	0x24, 0x52, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf,
-	0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x0a,
-	0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f,
-	0x63, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x12, 0x20,
-	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0x64, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x24,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf,
-	0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a,
-	0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x40, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x7a, 0x65, 0x72, 0x73, 0x12, 0x31, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x7a, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x52, 0x0b, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x0d, 0x54, 0x79, 0x70,
-	0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a,
-	0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x26, 0x0a, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d,
-	0x24, 0x52, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf,
-	0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x0a,
-	0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f,
-	0x63, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x12, 0x20,
-	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0x44, 0x0a, 0x0e, 0x54, 0x79, 0x70, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x79, 0x70,
-	0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x12, 0x24, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10,
-	0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b,
-	0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x12, 0x30,
-	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32,
-	0x32, 0x35, 0x7d, 0x24, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
-	0x64, 0x6f, 0x63, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2f, 0x0a, 0x07, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65,
-	0x73, 0x12, 0x24, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x07,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x22, 0x5a, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a,
-	0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x22, 0x5a, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x1d, 0x0a, 0x04,
-	0x64, 0x6f, 0x63, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x44, 0x6f, 0x63, 0x73, 0x52, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x12, 0x32, 0x0a, 0x0b, 0x63,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0xcf, 0x03, 0x0a, 0x05, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x70, 0x65,
-	0x6e, 0x5f, 0x69, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x49, 0x64, 0x44, 0x69, 0x73, 0x63,
-	0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x68, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
-	0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x07, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18,
-	0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73,
-	0x12, 0x19, 0x0a, 0x08, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x74, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74,
-	0x6c, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6c,
-	0x73, 0x4b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75,
-	0x6e, 0x64, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x12, 0x70, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x6c, 0x61, 0x79, 0x67, 0x72,
-	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x70, 0x6c, 0x61, 0x79, 0x67, 0x72,
-	0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x12, 0x2f, 0x0a, 0x13, 0x70, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x72,
-	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70,
-	0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x22, 0x1f, 0x0a, 0x07, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x22, 0x1e, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x0c, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32,
-	0x32, 0x35, 0x7d, 0x24, 0x52, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x65,
-	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d,
-	0x24, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
-	0x04, 0x73, 0x65, 0x65, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x65, 0x65,
-	0x6b, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x22, 0x63, 0x0a, 0x04, 0x45, 0x64, 0x69, 0x74, 0x12, 0x22, 0x0a, 0x03, 0x72, 0x65, 0x66,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66,
-	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x37, 0x0a,
-	0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x6a, 0x0a, 0x0a, 0x45, 0x64, 0x69, 0x74, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x74, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x65, 0x73, 0x22, 0x20, 0x0a, 0x04, 0x50, 0x6f, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x22, 0x72, 0x0a, 0x0f, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a,
-	0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02,
-	0x20, 0x01, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xfc, 0x01, 0x0a, 0x07, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b,
-	0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x42, 0x06, 0xe2,
-	0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02,
-	0x20, 0x01, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x28, 0x0a,
-	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2,
-	0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52,
-	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0xe1, 0x01, 0x0a, 0x06, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1b, 0x0a,
-	0x09, 0x64, 0x6f, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x08, 0x64, 0x6f, 0x63, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x0b, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72,
-	0x73, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x73, 0x12, 0x33,
-	0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x65, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x22, 0x2c, 0x0a, 0x0a, 0x45,
-	0x78, 0x70, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70,
-	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
-	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xce, 0x01, 0x0a, 0x07, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b,
-	0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
-	0x24, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2a, 0x1d, 0x0a, 0x09, 0x41, 0x6c,
-	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x46, 0x53, 0x10, 0x00,
-	0x12, 0x07, 0x0a, 0x03, 0x44, 0x46, 0x53, 0x10, 0x01, 0x2a, 0x44, 0x0a, 0x09, 0x41, 0x67, 0x67,
-	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10,
-	0x00, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x55, 0x4d, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x56,
-	0x47, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x41, 0x58, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03,
-	0x4d, 0x49, 0x4e, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x52, 0x4f, 0x44, 0x10, 0x05, 0x32,
-	0xda, 0x10, 0x0a, 0x0f, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6f, 0x6e, 0x67, 0x22, 0x00,
-	0x12, 0x32, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x7a, 0x65, 0x72, 0x73, 0x12, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x34, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73,
-	0x12, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x13, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x02,
-	0x4d, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x08, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x44, 0x6f, 0x63, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x44, 0x6f, 0x63, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x43, 0x6f, 0x6e,
-	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44,
-	0x6f, 0x63, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f,
-	0x63, 0x73, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x43, 0x6f, 0x6e, 0x73,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x1a, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44,
-	0x6f, 0x63, 0x73, 0x22, 0x00, 0x12, 0x1e, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x12,
-	0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x1a, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x44, 0x6f, 0x63, 0x22, 0x00, 0x12, 0x26, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44,
-	0x6f, 0x63, 0x73, 0x12, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x1a, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x73, 0x22, 0x00, 0x12, 0x32, 0x0a,
-	0x08, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x0f,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x73, 0x22,
-	0x00, 0x12, 0x36, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4d, 0x65, 0x12,
-	0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4d, 0x65,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61,
-	0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x73, 0x22, 0x00, 0x12, 0x20, 0x0a, 0x07, 0x45, 0x64, 0x69,
-	0x74, 0x44, 0x6f, 0x63, 0x12, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x1a,
-	0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x08, 0x45,
-	0x64, 0x69, 0x74, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x64,
-	0x69, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44,
-	0x6f, 0x63, 0x73, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x44, 0x6f, 0x63, 0x12,
-	0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x07, 0x44, 0x65, 0x6c, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x0b,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x09, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x44,
-	0x6f, 0x63, 0x12, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
-	0x65, 0x61, 0x6e, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x10, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x0c, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x00, 0x12, 0x22, 0x0a, 0x06,
-	0x48, 0x61, 0x73, 0x44, 0x6f, 0x63, 0x12, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66,
-	0x1a, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x00,
-	0x12, 0x29, 0x0a, 0x0d, 0x48, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x1a, 0x0c, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x10, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x0f, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x44,
-	0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x73,
-	0x1a, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x10, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6e,
-	0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x1a, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x41, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x12, 0x1a, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x4d, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x1a, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x11, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x10, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00,
-	0x12, 0x2e, 0x0a, 0x0e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x1a, 0x0f, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00,
-	0x12, 0x36, 0x0a, 0x0f, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x1a, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x52, 0x65, 0x66, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a,
-	0x0e, 0x44, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x10, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0x00, 0x12, 0x37, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x54, 0x6f, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x0d, 0x41, 0x67,
-	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x0e, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x41, 0x67, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x0b, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x14, 0x41, 0x67,
-	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x67, 0x67, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22,
-	0x00, 0x12, 0x3b, 0x0a, 0x09, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x14,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x2d,
-	0x0a, 0x06, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x0c, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3a, 0x0a,
-	0x13, 0x50, 0x75, 0x73, 0x68, 0x44, 0x6f, 0x63, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x6f, 0x72, 0x73, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x43, 0x6f,
-	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x44, 0x6f, 0x63, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x1a, 0x50, 0x75, 0x73,
-	0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x6f, 0x72, 0x1a, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x30, 0x0a, 0x08, 0x53, 0x65,
-	0x65, 0x64, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x28, 0x01, 0x12, 0x3e, 0x0a, 0x0f,
-	0x53, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x28, 0x01, 0x42, 0x07, 0x5a, 0x05,
-	0x61, 0x70, 0x69, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+type isExplainFilter_Query interface {
+	isExplainFilter_Query()
 }
 
-var (
-	file_graphik_proto_rawDescOnce sync.Once
-	file_graphik_proto_rawDescData = file_graphik_proto_rawDesc
-)
+type ExplainFilter_SearchDocs struct {
+	// search_docs explains SearchDocs
+	SearchDocs *Filter `protobuf:"bytes,1,opt,name=search_docs,json=searchDocs,proto3,oneof"`
+}
 
-func file_graphik_proto_rawDescGZIP() []byte {
-	file_graphik_proto_rawDescOnce.Do(func() {
-		file_graphik_proto_rawDescData = protoimpl.X.CompressGZIP(file_graphik_proto_rawDescData)
-	})
-	return file_graphik_proto_rawDescData
+type ExplainFilter_SearchConnections struct {
+	// search_connections explains SearchConnections
+	SearchConnections *Filter `protobuf:"bytes,2,opt,name=search_connections,json=searchConnections,proto3,oneof"`
 }
 
-var file_graphik_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_graphik_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
-var file_graphik_proto_goTypes = []interface{}{
-	(Algorithm)(0),                 // 0: api.Algorithm
-	(Aggregate)(0),                 // 1: api.Aggregate
-	(*Ref)(nil),                    // 2: api.Ref
-	(*RefConstructor)(nil),         // 3: api.RefConstructor
-	(*Refs)(nil),                   // 4: api.Refs
-	(*Doc)(nil),                    // 5: api.Doc
-	(*DocConstructor)(nil),         // 6: api.DocConstructor
-	(*DocConstructors)(nil),        // 7: api.DocConstructors
-	(*Traversal)(nil),              // 8: api.Traversal
-	(*Traversals)(nil),             // 9: api.Traversals
-	(*Docs)(nil),                   // 10: api.Docs
-	(*Connection)(nil),             // 11: api.Connection
-	(*ConnectionConstructor)(nil),  // 12: api.ConnectionConstructor
-	(*SearchConnectFilter)(nil),    // 13: api.SearchConnectFilter
-	(*SearchConnectMeFilter)(nil),  // 14: api.SearchConnectMeFilter
-	(*ConnectionConstructors)(nil), // 15: api.ConnectionConstructors
-	(*Connections)(nil),            // 16: api.Connections
-	(*ConnectFilter)(nil),          // 17: api.ConnectFilter
-	(*Filter)(nil),                 // 18: api.Filter
-	(*AggFilter)(nil),              // 19: api.AggFilter
-	(*TraverseFilter)(nil),         // 20: api.TraverseFilter
-	(*TraverseMeFilter)(nil),       // 21: api.TraverseMeFilter
-	(*IndexConstructor)(nil),       // 22: api.IndexConstructor
-	(*Authorizer)(nil),             // 23: api.Authorizer
-	(*Authorizers)(nil),            // 24: api.Authorizers
-	(*TypeValidator)(nil),          // 25: api.TypeValidator
-	(*TypeValidators)(nil),         // 26: api.TypeValidators
-	(*Index)(nil),                  // 27: api.Index
-	(*Indexes)(nil),                // 28: api.Indexes
-	(*StreamFilter)(nil),           // 29: api.StreamFilter
-	(*Graph)(nil),                  // 30: api.Graph
-	(*Flags)(nil),                  // 31: api.Flags
-	(*Boolean)(nil),                // 32: api.Boolean
-	(*Number)(nil),                 // 33: api.Number
-	(*ExistsFilter)(nil),           // 34: api.ExistsFilter
-	(*Edit)(nil),                   // 35: api.Edit
-	(*EditFilter)(nil),             // 36: api.EditFilter
-	(*Pong)(nil),                   // 37: api.Pong
-	(*OutboundMessage)(nil),        // 38: api.OutboundMessage
-	(*Message)(nil),                // 39: api.Message
-	(*Schema)(nil),                 // 40: api.Schema
-	(*ExprFilter)(nil),             // 41: api.ExprFilter
-	(*Request)(nil),                // 42: api.Request
-	(*_struct.Struct)(nil),         // 43: google.protobuf.Struct
-	(*timestamp.Timestamp)(nil),    // 44: google.protobuf.Timestamp
-	(*empty.Empty)(nil),            // 45: google.protobuf.Empty
+type ExplainFilter_Traverse struct {
+	// traverse explains Traverse
+	Traverse *TraverseFilter `protobuf:"bytes,3,opt,name=traverse,proto3,oneof"`
 }
-var file_graphik_proto_depIdxs = []int32{
-	2,  // 0: api.Refs.refs:type_name -> api.Ref
-	2,  // 1: api.Doc.ref:type_name -> api.Ref
-	43, // 2: api.Doc.attributes:type_name -> google.protobuf.Struct
-	3,  // 3: api.DocConstructor.ref:type_name -> api.RefConstructor
-	43, // 4: api.DocConstructor.attributes:type_name -> google.protobuf.Struct
-	6,  // 5: api.DocConstructors.docs:type_name -> api.DocConstructor
-	5,  // 6: api.Traversal.doc:type_name -> api.Doc
-	2,  // 7: api.Traversal.traversal_path:type_name -> api.Ref
-	8,  // 8: api.Traversals.traversals:type_name -> api.Traversal
-	5,  // 9: api.Docs.docs:type_name -> api.Doc
-	2,  // 10: api.Connection.ref:type_name -> api.Ref
-	43, // 11: api.Connection.attributes:type_name -> google.protobuf.Struct
-	2,  // 12: api.Connection.from:type_name -> api.Ref
-	2,  // 13: api.Connection.to:type_name -> api.Ref
-	3,  // 14: api.ConnectionConstructor.ref:type_name -> api.RefConstructor
-	43, // 15: api.ConnectionConstructor.attributes:type_name -> google.protobuf.Struct
-	2,  // 16: api.ConnectionConstructor.from:type_name -> api.Ref
-	2,  // 17: api.ConnectionConstructor.to:type_name -> api.Ref
-	18, // 18: api.SearchConnectFilter.filter:type_name -> api.Filter
-	43, // 19: api.SearchConnectFilter.attributes:type_name -> google.protobuf.Struct
-	2,  // 20: api.SearchConnectFilter.from:type_name -> api.Ref
-	18, // 21: api.SearchConnectMeFilter.filter:type_name -> api.Filter
-	43, // 22: api.SearchConnectMeFilter.attributes:type_name -> google.protobuf.Struct
-	12, // 23: api.ConnectionConstructors.connections:type_name -> api.ConnectionConstructor
-	11, // 24: api.Connections.connections:type_name -> api.Connection
-	2,  // 25: api.ConnectFilter.doc_ref:type_name -> api.Ref
-	18, // 26: api.AggFilter.filter:type_name -> api.Filter
-	1,  // 27: api.AggFilter.aggregate:type_name -> api.Aggregate
-	2,  // 28: api.TraverseFilter.root:type_name -> api.Ref
-	0,  // 29: api.TraverseFilter.algorithm:type_name -> api.Algorithm
-	0,  // 30: api.TraverseMeFilter.algorithm:type_name -> api.Algorithm
-	23, // 31: api.Authorizers.authorizers:type_name -> api.Authorizer
-	25, // 32: api.TypeValidators.validators:type_name -> api.TypeValidator
-	27, // 33: api.Indexes.indexes:type_name -> api.Index
-	10, // 34: api.Graph.docs:type_name -> api.Docs
-	16, // 35: api.Graph.connections:type_name -> api.Connections
-	2,  // 36: api.Edit.ref:type_name -> api.Ref
-	43, // 37: api.Edit.attributes:type_name -> google.protobuf.Struct
-	18, // 38: api.EditFilter.filter:type_name -> api.Filter
-	43, // 39: api.EditFilter.attributes:type_name -> google.protobuf.Struct
-	43, // 40: api.OutboundMessage.data:type_name -> google.protobuf.Struct
-	43, // 41: api.Message.data:type_name -> google.protobuf.Struct
-	2,  // 42: api.Message.user:type_name -> api.Ref
-	44, // 43: api.Message.timestamp:type_name -> google.protobuf.Timestamp
-	24, // 44: api.Schema.authorizers:type_name -> api.Authorizers
-	26, // 45: api.Schema.validators:type_name -> api.TypeValidators
-	28, // 46: api.Schema.indexes:type_name -> api.Indexes
-	5,  // 47: api.Request.user:type_name -> api.Doc
-	44, // 48: api.Request.timestamp:type_name -> google.protobuf.Timestamp
-	43, // 49: api.Request.request:type_name -> google.protobuf.Struct
-	45, // 50: api.DatabaseService.Ping:input_type -> google.protobuf.Empty
-	45, // 51: api.DatabaseService.GetSchema:input_type -> google.protobuf.Empty
-	24, // 52: api.DatabaseService.SetAuthorizers:input_type -> api.Authorizers
-	28, // 53: api.DatabaseService.SetIndexes:input_type -> api.Indexes
-	26, // 54: api.DatabaseService.SetTypeValidators:input_type -> api.TypeValidators
-	45, // 55: api.DatabaseService.Me:input_type -> google.protobuf.Empty
-	6,  // 56: api.DatabaseService.CreateDoc:input_type -> api.DocConstructor
-	7,  // 57: api.DatabaseService.CreateDocs:input_type -> api.DocConstructors
-	2,  // 58: api.DatabaseService.GetDoc:input_type -> api.Ref
-	18, // 59: api.DatabaseService.SearchDocs:input_type -> api.Filter
-	20, // 60: api.DatabaseService.Traverse:input_type -> api.TraverseFilter
-	21, // 61: api.DatabaseService.TraverseMe:input_type -> api.TraverseMeFilter
-	35, // 62: api.DatabaseService.EditDoc:input_type -> api.Edit
-	36, // 63: api.DatabaseService.EditDocs:input_type -> api.EditFilter
-	2,  // 64: api.DatabaseService.DelDoc:input_type -> api.Ref
-	18, // 65: api.DatabaseService.DelDocs:input_type -> api.Filter
-	34, // 66: api.DatabaseService.ExistsDoc:input_type -> api.ExistsFilter
-	34, // 67: api.DatabaseService.ExistsConnection:input_type -> api.ExistsFilter
-	2,  // 68: api.DatabaseService.HasDoc:input_type -> api.Ref
-	2,  // 69: api.DatabaseService.HasConnection:input_type -> api.Ref
-	12, // 70: api.DatabaseService.CreateConnection:input_type -> api.ConnectionConstructor
-	15, // 71: api.DatabaseService.CreateConnections:input_type -> api.ConnectionConstructors
-	13, // 72: api.DatabaseService.SearchAndConnect:input_type -> api.SearchConnectFilter
-	14, // 73: api.DatabaseService.SearchAndConnectMe:input_type -> api.SearchConnectMeFilter
-	2,  // 74: api.DatabaseService.GetConnection:input_type -> api.Ref
-	18, // 75: api.DatabaseService.SearchConnections:input_type -> api.Filter
-	35, // 76: api.DatabaseService.EditConnection:input_type -> api.Edit
-	36, // 77: api.DatabaseService.EditConnections:input_type -> api.EditFilter
-	2,  // 78: api.DatabaseService.DelConnection:input_type -> api.Ref
-	18, // 79: api.DatabaseService.DelConnections:input_type -> api.Filter
-	17, // 80: api.DatabaseService.ConnectionsFrom:input_type -> api.ConnectFilter
-	17, // 81: api.DatabaseService.ConnectionsTo:input_type -> api.ConnectFilter
-	19, // 82: api.DatabaseService.AggregateDocs:input_type -> api.AggFilter
-	19, // 83: api.DatabaseService.AggregateConnections:input_type -> api.AggFilter
-	38, // 84: api.DatabaseService.Broadcast:input_type -> api.OutboundMessage
-	29, // 85: api.DatabaseService.Stream:input_type -> api.StreamFilter
-	6,  // 86: api.DatabaseService.PushDocConstructors:input_type -> api.DocConstructor
-	12, // 87: api.DatabaseService.PushConnectionConstructors:input_type -> api.ConnectionConstructor
-	5,  // 88: api.DatabaseService.SeedDocs:input_type -> api.Doc
-	11, // 89: api.DatabaseService.SeedConnections:input_type -> api.Connection
-	37, // 90: api.DatabaseService.Ping:output_type -> api.Pong
-	40, // 91: api.DatabaseService.GetSchema:output_type -> api.Schema
-	45, // 92: api.DatabaseService.SetAuthorizers:output_type -> google.protobuf.Empty
-	45, // 93: api.DatabaseService.SetIndexes:output_type -> google.protobuf.Empty
-	45, // 94: api.DatabaseService.SetTypeValidators:output_type -> google.protobuf.Empty
-	5,  // 95: api.DatabaseService.Me:output_type -> api.Doc
-	5,  // 96: api.DatabaseService.CreateDoc:output_type -> api.Doc
-	10, // 97: api.DatabaseService.CreateDocs:output_type -> api.Docs
-	5,  // 98: api.DatabaseService.GetDoc:output_type -> api.Doc
-	10, // 99: api.DatabaseService.SearchDocs:output_type -> api.Docs
-	9,  // 100: api.DatabaseService.Traverse:output_type -> api.Traversals
-	9,  // 101: api.DatabaseService.TraverseMe:output_type -> api.Traversals
-	5,  // 102: api.DatabaseService.EditDoc:output_type -> api.Doc
-	10, // 103: api.DatabaseService.EditDocs:output_type -> api.Docs
-	45, // 104: api.DatabaseService.DelDoc:output_type -> google.protobuf.Empty
-	45, // 105: api.DatabaseService.DelDocs:output_type -> google.protobuf.Empty
-	32, // 106: api.DatabaseService.ExistsDoc:output_type -> api.Boolean
-	32, // 107: api.DatabaseService.ExistsConnection:output_type -> api.Boolean
-	32, // 108: api.DatabaseService.HasDoc:output_type -> api.Boolean
-	32, // 109: api.DatabaseService.HasConnection:output_type -> api.Boolean
-	11, // 110: api.DatabaseService.CreateConnection:output_type -> api.Connection
-	16, // 111: api.DatabaseService.CreateConnections:output_type -> api.Connections
-	16, // 112: api.DatabaseService.SearchAndConnect:output_type -> api.Connections
-	16, // 113: api.DatabaseService.SearchAndConnectMe:output_type -> api.Connections
-	11, // 114: api.DatabaseService.GetConnection:output_type -> api.Connection
-	16, // 115: api.DatabaseService.SearchConnections:output_type -> api.Connections
-	11, // 116: api.DatabaseService.EditConnection:output_type -> api.Connection
-	16, // 117: api.DatabaseService.EditConnections:output_type -> api.Connections
-	45, // 118: api.DatabaseService.DelConnection:output_type -> google.protobuf.Empty
-	45, // 119: api.DatabaseService.DelConnections:output_type -> google.protobuf.Empty
-	16, // 120: api.DatabaseService.ConnectionsFrom:output_type -> api.Connections
-	16, // 121: api.DatabaseService.ConnectionsTo:output_type -> api.Connections
-	33, // 122: api.DatabaseService.AggregateDocs:output_type -> api.Number
-	33, // 123: api.DatabaseService.AggregateConnections:output_type -> api.Number
-	45, // 124: api.DatabaseService.Broadcast:output_type -> google.protobuf.Empty
-	39, // 125: api.DatabaseService.Stream:output_type -> api.Message
-	5,  // 126: api.DatabaseService.PushDocConstructors:output_type -> api.Doc
-	11, // 127: api.DatabaseService.PushConnectionConstructors:output_type -> api.Connection
-	45, // 128: api.DatabaseService.SeedDocs:output_type -> google.protobuf.Empty
-	45, // 129: api.DatabaseService.SeedConnections:output_type -> google.protobuf.Empty
-	90, // [90:130] is the sub-list for method output_type
-	50, // [50:90] is the sub-list for method input_type
-	50, // [50:50] is the sub-list for extension type_name
-	50, // [50:50] is the sub-list for extension extendee
-	0,  // [0:50] is the sub-list for field type_name
+
+func (*ExplainFilter_SearchDocs) isExplainFilter_Query() {}
+
+func (*ExplainFilter_SearchConnections) isExplainFilter_Query() {}
+
+func (*ExplainFilter_Traverse) isExplainFilter_Query() {}
+
+// ExplainCandidate is an access path considered by the query planner
+type ExplainCandidate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessPath string `protobuf:"bytes,1,opt,name=access_path,json=accessPath,proto3" json:"access_path,omitempty"`
+	// estimate is the estimated number of keys the access path reads
+	Estimate uint64 `protobuf:"varint,2,opt,name=estimate,proto3" json:"estimate,omitempty"`
 }
 
-func init() { file_graphik_proto_init() }
-func file_graphik_proto_init() {
-	if File_graphik_proto != nil {
-		return
+func (x *ExplainCandidate) Reset() {
+	*x = ExplainCandidate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_graphik_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Ref); i {
+}
+
+func (x *ExplainCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainCandidate) ProtoMessage() {}
+
+func (x *ExplainCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainCandidate.ProtoReflect.Descriptor instead.
+func (*ExplainCandidate) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ExplainCandidate) GetAccessPath() string {
+	if x != nil {
+		return x.AccessPath
+	}
+	return ""
+}
+
+func (x *ExplainCandidate) GetEstimate() uint64 {
+	if x != nil {
+		return x.Estimate
+	}
+	return 0
+}
+
+// ExplainError is an error that was swallowed during execution ex: no such key(an expression referenced a missing attribute)
+type ExplainError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ExplainError) Reset() {
+	*x = ExplainError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainError) ProtoMessage() {}
+
+func (x *ExplainError) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainError.ProtoReflect.Descriptor instead.
+func (*ExplainError) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ExplainError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ExplainError) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// ExplainPhase is the time spent in a phase of execution ex: plan, scan, sort
+type ExplainPhase struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Duration *duration.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *ExplainPhase) Reset() {
+	*x = ExplainPhase{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainPhase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainPhase) ProtoMessage() {}
+
+func (x *ExplainPhase) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainPhase.ProtoReflect.Descriptor instead.
+func (*ExplainPhase) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ExplainPhase) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExplainPhase) GetDuration() *duration.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+// Explanation describes how a search or traversal was executed
+type Explanation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// access_path is how docs/connections were read ex: bucket scan, expression index active_users, traversal(BFS)
+	AccessPath string `protobuf:"bytes,1,opt,name=access_path,json=accessPath,proto3" json:"access_path,omitempty"`
+	// estimate is the query planner's estimate of the number of keys the access path reads
+	Estimate uint64 `protobuf:"varint,2,opt,name=estimate,proto3" json:"estimate,omitempty"`
+	// candidates are the access paths the query planner considered
+	Candidates []*ExplainCandidate `protobuf:"bytes,3,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	// keys_scanned is the number of keys read from the db
+	KeysScanned uint64 `protobuf:"varint,4,opt,name=keys_scanned,json=keysScanned,proto3" json:"keys_scanned,omitempty"`
+	// docs_decoded is the number of docs decoded
+	DocsDecoded uint64 `protobuf:"varint,5,opt,name=docs_decoded,json=docsDecoded,proto3" json:"docs_decoded,omitempty"`
+	// connections_decoded is the number of connections decoded
+	ConnectionsDecoded uint64 `protobuf:"varint,6,opt,name=connections_decoded,json=connectionsDecoded,proto3" json:"connections_decoded,omitempty"`
+	// evaluations is the number of CEL expressions evaluated
+	Evaluations uint64 `protobuf:"varint,7,opt,name=evaluations,proto3" json:"evaluations,omitempty"`
+	// errors are the errors swallowed during execution
+	Errors []*ExplainError `protobuf:"bytes,8,rep,name=errors,proto3" json:"errors,omitempty"`
+	// results is the number of results returned
+	Results uint64 `protobuf:"varint,9,opt,name=results,proto3" json:"results,omitempty"`
+	// phases are the timings of each phase of execution
+	Phases []*ExplainPhase `protobuf:"bytes,10,rep,name=phases,proto3" json:"phases,omitempty"`
+	// duration is the total execution time
+	Duration *duration.Duration `protobuf:"bytes,11,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *Explanation) Reset() {
+	*x = Explanation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Explanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Explanation) ProtoMessage() {}
+
+func (x *Explanation) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Explanation.ProtoReflect.Descriptor instead.
+func (*Explanation) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Explanation) GetAccessPath() string {
+	if x != nil {
+		return x.AccessPath
+	}
+	return ""
+}
+
+func (x *Explanation) GetEstimate() uint64 {
+	if x != nil {
+		return x.Estimate
+	}
+	return 0
+}
+
+func (x *Explanation) GetCandidates() []*ExplainCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *Explanation) GetKeysScanned() uint64 {
+	if x != nil {
+		return x.KeysScanned
+	}
+	return 0
+}
+
+func (x *Explanation) GetDocsDecoded() uint64 {
+	if x != nil {
+		return x.DocsDecoded
+	}
+	return 0
+}
+
+func (x *Explanation) GetConnectionsDecoded() uint64 {
+	if x != nil {
+		return x.ConnectionsDecoded
+	}
+	return 0
+}
+
+func (x *Explanation) GetEvaluations() uint64 {
+	if x != nil {
+		return x.Evaluations
+	}
+	return 0
+}
+
+func (x *Explanation) GetErrors() []*ExplainError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *Explanation) GetResults() uint64 {
+	if x != nil {
+		return x.Results
+	}
+	return 0
+}
+
+func (x *Explanation) GetPhases() []*ExplainPhase {
+	if x != nil {
+		return x.Phases
+	}
+	return nil
+}
+
+func (x *Explanation) GetDuration() *duration.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+// SimilarFilter is used to search a VECTOR index
+type SimilarFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// index is the name of the VECTOR index to search
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	// vector is the query vector. It must have the same number of dimensions as the index.
+	Vector []float64 `protobuf:"fixed64,2,rep,packed,name=vector,proto3" json:"vector,omitempty"`
+	// like uses an indexed doc's vector as the query vector(the doc itself is excluded from the results). It's ignored if vector is set.
+	Like *Ref `protobuf:"bytes,3,opt,name=like,proto3" json:"like,omitempty"`
+	// limit is the maximum number of items to return
+	Limit uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// expression is an optional CEL expression - only docs that pass it are considered
+	Expression string `protobuf:"bytes,5,opt,name=expression,proto3" json:"expression,omitempty"`
+	// traverse optionally limits results to docs found by a graph traversal ex: items connected to a user's purchases
+	Traverse *TraverseFilter `protobuf:"bytes,6,opt,name=traverse,proto3" json:"traverse,omitempty"`
+	// exact forces an exact(brute force) search even if the index is large enough to use it's HNSW graph
+	Exact bool `protobuf:"varint,7,opt,name=exact,proto3" json:"exact,omitempty"`
+	// ef is the size of the HNSW candidate list - higher values are more accurate & slower. defaults to 64.
+	Ef uint32 `protobuf:"varint,8,opt,name=ef,proto3" json:"ef,omitempty"`
+}
+
+func (x *SimilarFilter) Reset() {
+	*x = SimilarFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimilarFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarFilter) ProtoMessage() {}
+
+func (x *SimilarFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarFilter.ProtoReflect.Descriptor instead.
+func (*SimilarFilter) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SimilarFilter) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+func (x *SimilarFilter) GetVector() []float64 {
+	if x != nil {
+		return x.Vector
+	}
+	return nil
+}
+
+func (x *SimilarFilter) GetLike() *Ref {
+	if x != nil {
+		return x.Like
+	}
+	return nil
+}
+
+func (x *SimilarFilter) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SimilarFilter) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *SimilarFilter) GetTraverse() *TraverseFilter {
+	if x != nil {
+		return x.Traverse
+	}
+	return nil
+}
+
+func (x *SimilarFilter) GetExact() bool {
+	if x != nil {
+		return x.Exact
+	}
+	return false
+}
+
+func (x *SimilarFilter) GetEf() uint32 {
+	if x != nil {
+		return x.Ef
+	}
+	return 0
+}
+
+// SimilarDoc is a doc & it's distance from the query vector(lower is more similar)
+// NeighborFilter is used to find the docs whose neighborhoods are most similar to a doc's
+type NeighborFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// doc is the doc candidates are compared to
+	Doc *Ref `protobuf:"bytes,1,opt,name=doc,proto3" json:"doc,omitempty"`
+	// gtype is the type of connections that join docs to their neighbors ex: friend, bought
+	Gtype string `protobuf:"bytes,2,opt,name=gtype,proto3" json:"gtype,omitempty"`
+	// direction is the direction connections are followed in from a doc to it's neighbors - OUT(the default), IN or BOTH.
+	// Candidates are found by following connections from the doc's neighbors in the opposite direction ex: IN finds the
+	// users that bought an item, then the other items they bought.
+	Direction Direction `protobuf:"varint,3,opt,name=direction,proto3,enum=api.Direction" json:"direction,omitempty"`
+	// metric is the similarity metric docs are ranked by - defaults to COMMON_NEIGHBORS
+	Metric NeighborMetric `protobuf:"varint,4,opt,name=metric,proto3,enum=api.NeighborMetric" json:"metric,omitempty"`
+	// expression is a boolean CEL expression candidates must pass
+	Expression string `protobuf:"bytes,5,opt,name=expression,proto3" json:"expression,omitempty"`
+	// exclude_neighbors excludes candidates that are already neighbors of the doc ex: existing friends
+	ExcludeNeighbors bool `protobuf:"varint,6,opt,name=exclude_neighbors,json=excludeNeighbors,proto3" json:"exclude_neighbors,omitempty"`
+	// limit is the maximum number of docs to return
+	Limit uint64 `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	// budget limits the work done by the search
+	Budget *Budget `protobuf:"bytes,8,opt,name=budget,proto3" json:"budget,omitempty"`
+}
+
+func (x *NeighborFilter) Reset() {
+	*x = NeighborFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NeighborFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NeighborFilter) ProtoMessage() {}
+
+func (x *NeighborFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NeighborFilter.ProtoReflect.Descriptor instead.
+func (*NeighborFilter) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *NeighborFilter) GetDoc() *Ref {
+	if x != nil {
+		return x.Doc
+	}
+	return nil
+}
+
+func (x *NeighborFilter) GetGtype() string {
+	if x != nil {
+		return x.Gtype
+	}
+	return ""
+}
+
+func (x *NeighborFilter) GetDirection() Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return Direction_OUT
+}
+
+func (x *NeighborFilter) GetMetric() NeighborMetric {
+	if x != nil {
+		return x.Metric
+	}
+	return NeighborMetric_COMMON_NEIGHBORS
+}
+
+func (x *NeighborFilter) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *NeighborFilter) GetExcludeNeighbors() bool {
+	if x != nil {
+		return x.ExcludeNeighbors
+	}
+	return false
+}
+
+func (x *NeighborFilter) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *NeighborFilter) GetBudget() *Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+// NeighborDoc is a doc that shares neighbors with the filter's doc
+type NeighborDoc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Doc *Doc `protobuf:"bytes,1,opt,name=doc,proto3" json:"doc,omitempty"`
+	// score is the similarity of the doc's neighborhood to the filter's doc's neighborhood
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// common is the number of neighbors the docs share
+	Common uint64 `protobuf:"varint,3,opt,name=common,proto3" json:"common,omitempty"`
+}
+
+func (x *NeighborDoc) Reset() {
+	*x = NeighborDoc{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NeighborDoc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NeighborDoc) ProtoMessage() {}
+
+func (x *NeighborDoc) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NeighborDoc.ProtoReflect.Descriptor instead.
+func (*NeighborDoc) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *NeighborDoc) GetDoc() *Doc {
+	if x != nil {
+		return x.Doc
+	}
+	return nil
+}
+
+func (x *NeighborDoc) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *NeighborDoc) GetCommon() uint64 {
+	if x != nil {
+		return x.Common
+	}
+	return 0
+}
+
+// NeighborDocs is an array of NeighborDoc in descending order of score
+type NeighborDocs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Docs []*NeighborDoc `protobuf:"bytes,1,rep,name=docs,proto3" json:"docs,omitempty"`
+}
+
+func (x *NeighborDocs) Reset() {
+	*x = NeighborDocs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NeighborDocs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NeighborDocs) ProtoMessage() {}
+
+func (x *NeighborDocs) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NeighborDocs.ProtoReflect.Descriptor instead.
+func (*NeighborDocs) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *NeighborDocs) GetDocs() []*NeighborDoc {
+	if x != nil {
+		return x.Docs
+	}
+	return nil
+}
+
+type SimilarDoc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Doc *Doc `protobuf:"bytes,1,opt,name=doc,proto3" json:"doc,omitempty"`
+	// distance is 1 - cosine similarity for COSINE indexes, the negative dot product for DOT indexes & the euclidean distance for L2 indexes
+	Distance float64 `protobuf:"fixed64,2,opt,name=distance,proto3" json:"distance,omitempty"`
+}
+
+func (x *SimilarDoc) Reset() {
+	*x = SimilarDoc{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimilarDoc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarDoc) ProtoMessage() {}
+
+func (x *SimilarDoc) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarDoc.ProtoReflect.Descriptor instead.
+func (*SimilarDoc) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SimilarDoc) GetDoc() *Doc {
+	if x != nil {
+		return x.Doc
+	}
+	return nil
+}
+
+func (x *SimilarDoc) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+// SimilarDocs is an array of SimilarDoc sorted by distance(most similar first)
+type SimilarDocs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Docs []*SimilarDoc `protobuf:"bytes,1,rep,name=docs,proto3" json:"docs,omitempty"`
+}
+
+func (x *SimilarDocs) Reset() {
+	*x = SimilarDocs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimilarDocs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarDocs) ProtoMessage() {}
+
+func (x *SimilarDocs) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarDocs.ProtoReflect.Descriptor instead.
+func (*SimilarDocs) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SimilarDocs) GetDocs() []*SimilarDoc {
+	if x != nil {
+		return x.Docs
+	}
+	return nil
+}
+
+type AggFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// filter selects the docs/connections to aggregate - only the docs/connections within it's limit are aggregated
+	Filter    *Filter   `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Aggregate Aggregate `protobuf:"varint,2,opt,name=aggregate,proto3,enum=api.Aggregate" json:"aggregate,omitempty"`
+	Field     string    `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	// percentile(0-100] is used by the PERCENTILE aggregate ex: 99
+	Percentile float64 `protobuf:"fixed64,4,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	// approximate uses fixed memory sketches(HyperLogLog for COUNT_DISTINCT, t-digest for PERCENTILE) instead of holding every value in memory
+	Approximate bool `protobuf:"varint,5,opt,name=approximate,proto3" json:"approximate,omitempty"`
+}
+
+func (x *AggFilter) Reset() {
+	*x = AggFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggFilter) ProtoMessage() {}
+
+func (x *AggFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggFilter.ProtoReflect.Descriptor instead.
+func (*AggFilter) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AggFilter) GetFilter() *Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *AggFilter) GetAggregate() Aggregate {
+	if x != nil {
+		return x.Aggregate
+	}
+	return Aggregate_COUNT
+}
+
+func (x *AggFilter) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *AggFilter) GetPercentile() float64 {
+	if x != nil {
+		return x.Percentile
+	}
+	return 0
+}
+
+func (x *AggFilter) GetApproximate() bool {
+	if x != nil {
+		return x.Approximate
+	}
+	return false
+}
+
+// AggField is a single aggregation function executed against each group
+type AggField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Aggregate Aggregate `protobuf:"varint,1,opt,name=aggregate,proto3,enum=api.Aggregate" json:"aggregate,omitempty"`
+	// field is the attribute the aggregate is computed against(ignored by COUNT) ex: attributes.price
+	Field string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	// percentile(0-100] is used by the PERCENTILE aggregate ex: 99
+	Percentile float64 `protobuf:"fixed64,3,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	// approximate uses fixed memory sketches(HyperLogLog for COUNT_DISTINCT, t-digest for PERCENTILE) instead of holding every value in memory
+	Approximate bool `protobuf:"varint,4,opt,name=approximate,proto3" json:"approximate,omitempty"`
+	// bucket_size is the width of each HISTOGRAM bucket(seconds for timestamp attributes)
+	BucketSize float64 `protobuf:"fixed64,5,opt,name=bucket_size,json=bucketSize,proto3" json:"bucket_size,omitempty"`
+}
+
+func (x *AggField) Reset() {
+	*x = AggField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggField) ProtoMessage() {}
+
+func (x *AggField) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggField.ProtoReflect.Descriptor instead.
+func (*AggField) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AggField) GetAggregate() Aggregate {
+	if x != nil {
+		return x.Aggregate
+	}
+	return Aggregate_COUNT
+}
+
+func (x *AggField) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *AggField) GetPercentile() float64 {
+	if x != nil {
+		return x.Percentile
+	}
+	return 0
+}
+
+func (x *AggField) GetApproximate() bool {
+	if x != nil {
+		return x.Approximate
+	}
+	return false
+}
+
+func (x *AggField) GetBucketSize() float64 {
+	if x != nil {
+		return x.BucketSize
+	}
+	return 0
+}
+
+// GroupAggFilter is used to execute grouped aggregations ex: sum revenue per region
+type GroupAggFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// filter selects the docs/connections to aggregate. It's limit is ignored - every doc/connection that passes the filter is aggregated(bounded by it's budget)
+	Filter *Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// group_by are the paths docs/connections are grouped by ex: attributes.status. If empty, a single group is returned.
+	GroupBy []string `protobuf:"bytes,2,rep,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	// aggregates are executed against each group
+	Aggregates []*AggField `protobuf:"bytes,3,rep,name=aggregates,proto3" json:"aggregates,omitempty"`
+}
+
+func (x *GroupAggFilter) Reset() {
+	*x = GroupAggFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupAggFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupAggFilter) ProtoMessage() {}
+
+func (x *GroupAggFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupAggFilter.ProtoReflect.Descriptor instead.
+func (*GroupAggFilter) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GroupAggFilter) GetFilter() *Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GroupAggFilter) GetGroupBy() []string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+func (x *GroupAggFilter) GetAggregates() []*AggField {
+	if x != nil {
+		return x.Aggregates
+	}
+	return nil
+}
+
+// AggValue is the result of a single aggregation function against a group
+type AggValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Aggregate Aggregate `protobuf:"varint,1,opt,name=aggregate,proto3,enum=api.Aggregate" json:"aggregate,omitempty"`
+	Field     string    `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	// value is the result of the aggregate. For HISTOGRAM, it's the number of values that were bucketed
+	Value float64 `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	// buckets are the HISTOGRAM buckets sorted by lower bound - empty buckets are omitted
+	Buckets []*HistogramBucket `protobuf:"bytes,4,rep,name=buckets,proto3" json:"buckets,omitempty"`
+}
+
+func (x *AggValue) Reset() {
+	*x = AggValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggValue) ProtoMessage() {}
+
+func (x *AggValue) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggValue.ProtoReflect.Descriptor instead.
+func (*AggValue) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AggValue) GetAggregate() Aggregate {
+	if x != nil {
+		return x.Aggregate
+	}
+	return Aggregate_COUNT
+}
+
+func (x *AggValue) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *AggValue) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *AggValue) GetBuckets() []*HistogramBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+// HistogramBucket is a single HISTOGRAM bucket containing values in the range [lower, upper)
+type HistogramBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lower float64 `protobuf:"fixed64,1,opt,name=lower,proto3" json:"lower,omitempty"`
+	Upper float64 `protobuf:"fixed64,2,opt,name=upper,proto3" json:"upper,omitempty"`
+	Count uint64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *HistogramBucket) Reset() {
+	*x = HistogramBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistogramBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistogramBucket) ProtoMessage() {}
+
+func (x *HistogramBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistogramBucket.ProtoReflect.Descriptor instead.
+func (*HistogramBucket) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *HistogramBucket) GetLower() float64 {
+	if x != nil {
+		return x.Lower
+	}
+	return 0
+}
+
+func (x *HistogramBucket) GetUpper() float64 {
+	if x != nil {
+		return x.Upper
+	}
+	return 0
+}
+
+func (x *HistogramBucket) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// AggGroup is the result of aggregating a single group
+type AggGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key maps each group_by path to the group's value
+	Key *_struct.Struct `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// count is the number of docs/connections in the group
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// values are the aggregate results - in the same order as the filter's aggregates
+	Values []*AggValue `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *AggGroup) Reset() {
+	*x = AggGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggGroup) ProtoMessage() {}
+
+func (x *AggGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggGroup.ProtoReflect.Descriptor instead.
+func (*AggGroup) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *AggGroup) GetKey() *_struct.Struct {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *AggGroup) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AggGroup) GetValues() []*AggValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// AggGroups is an array of AggGroup
+type AggGroups struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups []*AggGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *AggGroups) Reset() {
+	*x = AggGroups{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggGroups) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggGroups) ProtoMessage() {}
+
+func (x *AggGroups) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggGroups.ProtoReflect.Descriptor instead.
+func (*AggGroups) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AggGroups) GetGroups() []*AggGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type TraverseFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Root                 *Ref   `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	DocExpression        string `protobuf:"bytes,2,opt,name=doc_expression,json=docExpression,proto3" json:"doc_expression,omitempty"`
+	ConnectionExpression string `protobuf:"bytes,3,opt,name=connection_expression,json=connectionExpression,proto3" json:"connection_expression,omitempty"`
+	Limit                uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// custom sorting of the results. (validator.field) = {regex : "((^|, )(|ref.gid|ref.gtype|^attributes.(.*)))+$"}
+	Sort      string    `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`
+	Reverse   bool      `protobuf:"varint,6,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	Algorithm Algorithm `protobuf:"varint,7,opt,name=algorithm,proto3,enum=api.Algorithm" json:"algorithm,omitempty"`
+	// maximum degree/depth of nodes to be visited during traversal
+	MaxDepth uint64 `protobuf:"varint,8,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	// maximum number of nodes to be visited during traversal
+	MaxHops uint64 `protobuf:"varint,9,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty"`
+	// budget limits the work done by the traversal
+	Budget *Budget `protobuf:"bytes,10,opt,name=budget,proto3" json:"budget,omitempty"`
+	// direction is the direction connections are followed in(reverse is equivalent to IN)
+	Direction Direction `protobuf:"varint,11,opt,name=direction,proto3,enum=api.Direction" json:"direction,omitempty"`
+}
+
+func (x *TraverseFilter) Reset() {
+	*x = TraverseFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraverseFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraverseFilter) ProtoMessage() {}
+
+func (x *TraverseFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraverseFilter.ProtoReflect.Descriptor instead.
+func (*TraverseFilter) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *TraverseFilter) GetRoot() *Ref {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *TraverseFilter) GetDocExpression() string {
+	if x != nil {
+		return x.DocExpression
+	}
+	return ""
+}
+
+func (x *TraverseFilter) GetConnectionExpression() string {
+	if x != nil {
+		return x.ConnectionExpression
+	}
+	return ""
+}
+
+func (x *TraverseFilter) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *TraverseFilter) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *TraverseFilter) GetReverse() bool {
+	if x != nil {
+		return x.Reverse
+	}
+	return false
+}
+
+func (x *TraverseFilter) GetAlgorithm() Algorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return Algorithm_BFS
+}
+
+func (x *TraverseFilter) GetMaxDepth() uint64 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *TraverseFilter) GetMaxHops() uint64 {
+	if x != nil {
+		return x.MaxHops
+	}
+	return 0
+}
+
+func (x *TraverseFilter) GetBudget() *Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+func (x *TraverseFilter) GetDirection() Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return Direction_OUT
+}
+
+type TraverseMeFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DocExpression        string `protobuf:"bytes,1,opt,name=doc_expression,json=docExpression,proto3" json:"doc_expression,omitempty"`
+	ConnectionExpression string `protobuf:"bytes,2,opt,name=connection_expression,json=connectionExpression,proto3" json:"connection_expression,omitempty"`
+	Limit                uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// custom sorting of the results. (validator.field) = {regex : "((^|, )(|ref.gid|ref.gtype|^attributes.(.*)))+$"}
+	Sort      string    `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	Reverse   bool      `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	Algorithm Algorithm `protobuf:"varint,6,opt,name=algorithm,proto3,enum=api.Algorithm" json:"algorithm,omitempty"`
+	// maximum degree/depth of nodes to be visited during traversal
+	MaxDepth uint64 `protobuf:"varint,7,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	// maximum number of nodes to be visited during traversal
+	MaxHops uint64 `protobuf:"varint,8,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty"`
+	// budget limits the work done by the traversal
+	Budget *Budget `protobuf:"bytes,9,opt,name=budget,proto3" json:"budget,omitempty"`
+	// direction is the direction connections are followed in(reverse is equivalent to IN)
+	Direction Direction `protobuf:"varint,10,opt,name=direction,proto3,enum=api.Direction" json:"direction,omitempty"`
+}
+
+func (x *TraverseMeFilter) Reset() {
+	*x = TraverseMeFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraverseMeFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraverseMeFilter) ProtoMessage() {}
+
+func (x *TraverseMeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraverseMeFilter.ProtoReflect.Descriptor instead.
+func (*TraverseMeFilter) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *TraverseMeFilter) GetDocExpression() string {
+	if x != nil {
+		return x.DocExpression
+	}
+	return ""
+}
+
+func (x *TraverseMeFilter) GetConnectionExpression() string {
+	if x != nil {
+		return x.ConnectionExpression
+	}
+	return ""
+}
+
+func (x *TraverseMeFilter) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *TraverseMeFilter) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *TraverseMeFilter) GetReverse() bool {
+	if x != nil {
+		return x.Reverse
+	}
+	return false
+}
+
+func (x *TraverseMeFilter) GetAlgorithm() Algorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return Algorithm_BFS
+}
+
+func (x *TraverseMeFilter) GetMaxDepth() uint64 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *TraverseMeFilter) GetMaxHops() uint64 {
+	if x != nil {
+		return x.MaxHops
+	}
+	return 0
+}
+
+func (x *TraverseMeFilter) GetBudget() *Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+func (x *TraverseMeFilter) GetDirection() Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return Direction_OUT
+}
+
+type IndexConstructor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// gtype is the doc/connection type to be filtered
+	Gtype string `protobuf:"bytes,3,opt,name=gtype,proto3" json:"gtype,omitempty"`
+	// expression is a CEL expression used to filter connections/modes
+	Expression string `protobuf:"bytes,4,opt,name=expression,proto3" json:"expression,omitempty"`
+	// if docs is true, this index will be applied to documents. Either docs or connections may be true, but not both.
+	Docs bool `protobuf:"varint,6,opt,name=docs,proto3" json:"docs,omitempty"`
+	// if docs is true, this index will be applied to connections. Either docs or connections may be true, but not both.
+	Connections bool `protobuf:"varint,7,opt,name=connections,proto3" json:"connections,omitempty"`
+}
+
+func (x *IndexConstructor) Reset() {
+	*x = IndexConstructor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexConstructor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexConstructor) ProtoMessage() {}
+
+func (x *IndexConstructor) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexConstructor.ProtoReflect.Descriptor instead.
+func (*IndexConstructor) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *IndexConstructor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IndexConstructor) GetGtype() string {
+	if x != nil {
+		return x.Gtype
+	}
+	return ""
+}
+
+func (x *IndexConstructor) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *IndexConstructor) GetDocs() bool {
+	if x != nil {
+		return x.Docs
+	}
+	return false
+}
+
+func (x *IndexConstructor) GetConnections() bool {
+	if x != nil {
+		return x.Connections
+	}
+	return false
+}
+
+type Authorizer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Expression string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+}
+
+func (x *Authorizer) Reset() {
+	*x = Authorizer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Authorizer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Authorizer) ProtoMessage() {}
+
+func (x *Authorizer) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Authorizer.ProtoReflect.Descriptor instead.
+func (*Authorizer) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *Authorizer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Authorizer) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+type Authorizers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authorizers []*Authorizer `protobuf:"bytes,1,rep,name=authorizers,proto3" json:"authorizers,omitempty"`
+}
+
+func (x *Authorizers) Reset() {
+	*x = Authorizers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Authorizers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Authorizers) ProtoMessage() {}
+
+func (x *Authorizers) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Authorizers.ProtoReflect.Descriptor instead.
+func (*Authorizers) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Authorizers) GetAuthorizers() []*Authorizer {
+	if x != nil {
+		return x.Authorizers
+	}
+	return nil
+}
+
+type TypeValidator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Gtype string `protobuf:"bytes,2,opt,name=gtype,proto3" json:"gtype,omitempty"`
+	// expression is a boolean CEL expression docs/connections must pass. It's optional for acyclic validators.
+	Expression string `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
+	// if docs is true, this validator will be applied to documents. Either docs or connections may be true, but not both.
+	Docs bool `protobuf:"varint,4,opt,name=docs,proto3" json:"docs,omitempty"`
+	// if docs is true, this validator will be applied to connections. Either docs or connections may be true, but not both.
+	Connections bool `protobuf:"varint,5,opt,name=connections,proto3" json:"connections,omitempty"`
+	// if acyclic is true, directed connections of the gtype that would create a cycle among the directed connections of the gtype are rejected(connections only)
+	Acyclic bool `protobuf:"varint,6,opt,name=acyclic,proto3" json:"acyclic,omitempty"`
+}
+
+func (x *TypeValidator) Reset() {
+	*x = TypeValidator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TypeValidator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypeValidator) ProtoMessage() {}
+
+func (x *TypeValidator) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypeValidator.ProtoReflect.Descriptor instead.
+func (*TypeValidator) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *TypeValidator) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TypeValidator) GetGtype() string {
+	if x != nil {
+		return x.Gtype
+	}
+	return ""
+}
+
+func (x *TypeValidator) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *TypeValidator) GetDocs() bool {
+	if x != nil {
+		return x.Docs
+	}
+	return false
+}
+
+func (x *TypeValidator) GetConnections() bool {
+	if x != nil {
+		return x.Connections
+	}
+	return false
+}
+
+func (x *TypeValidator) GetAcyclic() bool {
+	if x != nil {
+		return x.Acyclic
+	}
+	return false
+}
+
+type TypeValidators struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validators []*TypeValidator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (x *TypeValidators) Reset() {
+	*x = TypeValidators{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TypeValidators) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypeValidators) ProtoMessage() {}
+
+func (x *TypeValidators) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypeValidators.ProtoReflect.Descriptor instead.
+func (*TypeValidators) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *TypeValidators) GetValidators() []*TypeValidator {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+type Index struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// gtype is the doc/connection type to be filtered
+	Gtype string `protobuf:"bytes,3,opt,name=gtype,proto3" json:"gtype,omitempty"`
+	// expression is a CEL expression used to filter connections/modes. It's required by EXPRESSION indexes & optional for other kinds.
+	Expression string `protobuf:"bytes,4,opt,name=expression,proto3" json:"expression,omitempty"`
+	// if docs is true, this index will be applied to documents. Either docs or connections may be true, but not both.
+	Docs bool `protobuf:"varint,6,opt,name=docs,proto3" json:"docs,omitempty"`
+	// if docs is true, this index will be applied to connections. Either docs or connections may be true, but not both.
+	Connections bool `protobuf:"varint,7,opt,name=connections,proto3" json:"connections,omitempty"`
+	// kind is the kind of index - defaults to EXPRESSION
+	Kind IndexKind `protobuf:"varint,8,opt,name=kind,proto3,enum=api.IndexKind" json:"kind,omitempty"`
+	// fields are the attribute paths indexed by FULL_TEXT indexes ex: attributes.title
+	// GEO indexes require exactly two fields - the latitude & longitude paths ex: attributes.lat, attributes.lng
+	// VECTOR indexes require exactly one field - the embedding path ex: attributes.embedding
+	// VALUE indexes require exactly one field - the attribute path ex: attributes.age
+	Fields []string `protobuf:"bytes,9,rep,name=fields,proto3" json:"fields,omitempty"`
+	// metric is the distance metric used by VECTOR indexes - defaults to COSINE
+	Metric VectorMetric `protobuf:"varint,10,opt,name=metric,proto3,enum=api.VectorMetric" json:"metric,omitempty"`
+	// dimensions is the length of the vectors indexed by VECTOR indexes - docs with vectors of a different length aren't indexed
+	Dimensions uint32 `protobuf:"varint,11,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+}
+
+func (x *Index) Reset() {
+	*x = Index{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Index) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Index) ProtoMessage() {}
+
+func (x *Index) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Index.ProtoReflect.Descriptor instead.
+func (*Index) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Index) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Index) GetGtype() string {
+	if x != nil {
+		return x.Gtype
+	}
+	return ""
+}
+
+func (x *Index) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *Index) GetDocs() bool {
+	if x != nil {
+		return x.Docs
+	}
+	return false
+}
+
+func (x *Index) GetConnections() bool {
+	if x != nil {
+		return x.Connections
+	}
+	return false
+}
+
+func (x *Index) GetKind() IndexKind {
+	if x != nil {
+		return x.Kind
+	}
+	return IndexKind_EXPRESSION
+}
+
+func (x *Index) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *Index) GetMetric() VectorMetric {
+	if x != nil {
+		return x.Metric
+	}
+	return VectorMetric_COSINE
+}
+
+func (x *Index) GetDimensions() uint32 {
+	if x != nil {
+		return x.Dimensions
+	}
+	return 0
+}
+
+type Indexes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Indexes []*Index `protobuf:"bytes,1,rep,name=indexes,proto3" json:"indexes,omitempty"`
+}
+
+func (x *Indexes) Reset() {
+	*x = Indexes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Indexes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Indexes) ProtoMessage() {}
+
+func (x *Indexes) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Indexes.ProtoReflect.Descriptor instead.
+func (*Indexes) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *Indexes) GetIndexes() []*Index {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+// StreamFilter is used to filter messages in a pubsub channel
+type StreamFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// channel is the target channel to filter from
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// expression is CEL expression used to filter messages
+	Expression string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+}
+
+func (x *StreamFilter) Reset() {
+	*x = StreamFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamFilter) ProtoMessage() {}
+
+func (x *StreamFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamFilter.ProtoReflect.Descriptor instead.
+func (*StreamFilter) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *StreamFilter) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *StreamFilter) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+// Graph is an array of docs and connections
+type Graph struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// docs are docs present in the graph
+	Docs *Docs `protobuf:"bytes,1,opt,name=docs,proto3" json:"docs,omitempty"`
+	// connections are connections present in the graph
+	Connections *Connections `protobuf:"bytes,2,opt,name=connections,proto3" json:"connections,omitempty"`
+}
+
+func (x *Graph) Reset() {
+	*x = Graph{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Graph) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Graph) ProtoMessage() {}
+
+func (x *Graph) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Graph.ProtoReflect.Descriptor instead.
+func (*Graph) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Graph) GetDocs() *Docs {
+	if x != nil {
+		return x.Docs
+	}
+	return nil
+}
+
+func (x *Graph) GetConnections() *Connections {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+type Flags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// open id connect discovery uri ex: https://accounts.google.com/.well-known/openid-configuration (env: GRAPHIK_OPEN_ID)
+	OpenIdDiscovery string `protobuf:"bytes,1,opt,name=open_id_discovery,json=openIdDiscovery,proto3" json:"open_id_discovery,omitempty"`
+	// persistant storage ref (env: GRAPHIK_STORAGE_PATH)
+	StoragePath string `protobuf:"bytes,2,opt,name=storage_path,json=storagePath,proto3" json:"storage_path,omitempty"`
+	// enable prometheus & pprof metrics (emv: GRAPHIK_METRICS = true)
+	Metrics bool `protobuf:"varint,3,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	// cors allow headers (env: GRAPHIK_ALLOW_HEADERS)
+	AllowHeaders []string `protobuf:"bytes,5,rep,name=allow_headers,json=allowHeaders,proto3" json:"allow_headers,omitempty"`
+	// cors allow methods (env: GRAPHIK_ALLOW_METHODS)
+	AllowMethods []string `protobuf:"bytes,6,rep,name=allow_methods,json=allowMethods,proto3" json:"allow_methods,omitempty"`
+	// cors allow origins (env: GRAPHIK_ALLOW_ORIGINS)
+	AllowOrigins []string `protobuf:"bytes,7,rep,name=allow_origins,json=allowOrigins,proto3" json:"allow_origins,omitempty"`
+	// root user is a list of email addresses that bypass authorizers. (env: GRAPHIK_ROOT_USERS)
+	RootUsers              []string `protobuf:"bytes,8,rep,name=root_users,json=rootUsers,proto3" json:"root_users,omitempty"`
+	TlsCert                string   `protobuf:"bytes,9,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert,omitempty"`
+	TlsKey                 string   `protobuf:"bytes,10,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
+	PlaygroundClientId     string   `protobuf:"bytes,11,opt,name=playground_client_id,json=playgroundClientId,proto3" json:"playground_client_id,omitempty"`
+	PlaygroundClientSecret string   `protobuf:"bytes,12,opt,name=playground_client_secret,json=playgroundClientSecret,proto3" json:"playground_client_secret,omitempty"`
+	PlaygroundRedirect     string   `protobuf:"bytes,13,opt,name=playground_redirect,json=playgroundRedirect,proto3" json:"playground_redirect,omitempty"`
+	// base64 encoded AES key(16, 24 or 32 bytes) used to encrypt docs & connections at rest - full-text, geo, vector & value indexes are refused while it's set (env: GRAPHIK_ENCRYPTION_KEY)
+	EncryptionKey string `protobuf:"bytes,14,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key,omitempty"`
+	// path to a file containing a base64 encoded AES key used to encrypt docs & connections at rest - full-text, geo, vector & value indexes are refused while it's set (env: GRAPHIK_ENCRYPTION_KEY_FILE)
+	EncryptionKeyFile string `protobuf:"bytes,15,opt,name=encryption_key_file,json=encryptionKeyFile,proto3" json:"encryption_key_file,omitempty"`
+	// previous base64 encoded AES keys that may still be used to decrypt values after a key rotation (env: GRAPHIK_DECRYPTION_KEYS)
+	DecryptionKeys []string `protobuf:"bytes,16,rep,name=decryption_keys,json=decryptionKeys,proto3" json:"decryption_keys,omitempty"`
+	// idempotency_window is how long idempotency keys on create requests are remembered ex: 24h (env: GRAPHIK_IDEMPOTENCY_WINDOW)
+	IdempotencyWindow string `protobuf:"bytes,17,opt,name=idempotency_window,json=idempotencyWindow,proto3" json:"idempotency_window,omitempty"`
+	// namespace_claim is the userinfo claim used to determine the namespace(tenant) of a request ex: org_id (env: GRAPHIK_NAMESPACE_CLAIM)
+	NamespaceClaim string `protobuf:"bytes,18,opt,name=namespace_claim,json=namespaceClaim,proto3" json:"namespace_claim,omitempty"`
+	// max_scan is the maximum number of keys a search, aggregation or traversal may read from the db - 0 is unlimited (env: GRAPHIK_MAX_SCAN)
+	MaxScan uint64 `protobuf:"varint,19,opt,name=max_scan,json=maxScan,proto3" json:"max_scan,omitempty"`
+	// max_evaluations is the maximum number of CEL evaluations a search, aggregation or traversal may execute - 0 is unlimited (env: GRAPHIK_MAX_EVALUATIONS)
+	MaxEvaluations uint64 `protobuf:"varint,20,opt,name=max_evaluations,json=maxEvaluations,proto3" json:"max_evaluations,omitempty"`
+	// query_timeout is the maximum duration of a search, aggregation or traversal ex: 10s - 0 is unlimited & streams are only bounded by a request's budget (env: GRAPHIK_QUERY_TIMEOUT)
+	QueryTimeout string `protobuf:"bytes,21,opt,name=query_timeout,json=queryTimeout,proto3" json:"query_timeout,omitempty"`
+	// slow_query_threshold is the duration after which a search, aggregation or traversal is written to the slow query log ex: 1s (env: GRAPHIK_SLOW_QUERY_THRESHOLD)
+	SlowQueryThreshold string `protobuf:"bytes,22,opt,name=slow_query_threshold,json=slowQueryThreshold,proto3" json:"slow_query_threshold,omitempty"`
+}
+
+func (x *Flags) Reset() {
+	*x = Flags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Flags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Flags) ProtoMessage() {}
+
+func (x *Flags) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Flags.ProtoReflect.Descriptor instead.
+func (*Flags) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *Flags) GetOpenIdDiscovery() string {
+	if x != nil {
+		return x.OpenIdDiscovery
+	}
+	return ""
+}
+
+func (x *Flags) GetStoragePath() string {
+	if x != nil {
+		return x.StoragePath
+	}
+	return ""
+}
+
+func (x *Flags) GetMetrics() bool {
+	if x != nil {
+		return x.Metrics
+	}
+	return false
+}
+
+func (x *Flags) GetAllowHeaders() []string {
+	if x != nil {
+		return x.AllowHeaders
+	}
+	return nil
+}
+
+func (x *Flags) GetAllowMethods() []string {
+	if x != nil {
+		return x.AllowMethods
+	}
+	return nil
+}
+
+func (x *Flags) GetAllowOrigins() []string {
+	if x != nil {
+		return x.AllowOrigins
+	}
+	return nil
+}
+
+func (x *Flags) GetRootUsers() []string {
+	if x != nil {
+		return x.RootUsers
+	}
+	return nil
+}
+
+func (x *Flags) GetTlsCert() string {
+	if x != nil {
+		return x.TlsCert
+	}
+	return ""
+}
+
+func (x *Flags) GetTlsKey() string {
+	if x != nil {
+		return x.TlsKey
+	}
+	return ""
+}
+
+func (x *Flags) GetPlaygroundClientId() string {
+	if x != nil {
+		return x.PlaygroundClientId
+	}
+	return ""
+}
+
+func (x *Flags) GetPlaygroundClientSecret() string {
+	if x != nil {
+		return x.PlaygroundClientSecret
+	}
+	return ""
+}
+
+func (x *Flags) GetPlaygroundRedirect() string {
+	if x != nil {
+		return x.PlaygroundRedirect
+	}
+	return ""
+}
+
+func (x *Flags) GetEncryptionKey() string {
+	if x != nil {
+		return x.EncryptionKey
+	}
+	return ""
+}
+
+func (x *Flags) GetEncryptionKeyFile() string {
+	if x != nil {
+		return x.EncryptionKeyFile
+	}
+	return ""
+}
+
+func (x *Flags) GetDecryptionKeys() []string {
+	if x != nil {
+		return x.DecryptionKeys
+	}
+	return nil
+}
+
+func (x *Flags) GetIdempotencyWindow() string {
+	if x != nil {
+		return x.IdempotencyWindow
+	}
+	return ""
+}
+
+func (x *Flags) GetNamespaceClaim() string {
+	if x != nil {
+		return x.NamespaceClaim
+	}
+	return ""
+}
+
+func (x *Flags) GetMaxScan() uint64 {
+	if x != nil {
+		return x.MaxScan
+	}
+	return 0
+}
+
+func (x *Flags) GetMaxEvaluations() uint64 {
+	if x != nil {
+		return x.MaxEvaluations
+	}
+	return 0
+}
+
+func (x *Flags) GetQueryTimeout() string {
+	if x != nil {
+		return x.QueryTimeout
+	}
+	return ""
+}
+
+func (x *Flags) GetSlowQueryThreshold() string {
+	if x != nil {
+		return x.SlowQueryThreshold
+	}
+	return ""
+}
+
+// MutationResult reports the docs/connections affected by a bulk mutation
+type MutationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// affected is the number of docs/connections that were(or would be if dry_run) mutated
+	Affected uint64 `protobuf:"varint,1,opt,name=affected,proto3" json:"affected,omitempty"`
+	// refs are the refs of the docs/connections that were(or would be if dry_run) mutated
+	Refs []*Ref `protobuf:"bytes,2,rep,name=refs,proto3" json:"refs,omitempty"`
+	// cascaded are the refs of the connections that were(or would be if dry_run) deleted along with the deleted docs
+	Cascaded []*Ref `protobuf:"bytes,3,rep,name=cascaded,proto3" json:"cascaded,omitempty"`
+	// dry_run is true if the mutation was previewed but not committed
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// docs are the docs as they are(or would be if dry_run) after an EditDocs
+	Docs *Docs `protobuf:"bytes,5,opt,name=docs,proto3" json:"docs,omitempty"`
+	// connections are the connections as they are(or would be if dry_run) after an EditConnections
+	Connections *Connections `protobuf:"bytes,6,opt,name=connections,proto3" json:"connections,omitempty"`
+}
+
+func (x *MutationResult) Reset() {
+	*x = MutationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MutationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutationResult) ProtoMessage() {}
+
+func (x *MutationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutationResult.ProtoReflect.Descriptor instead.
+func (*MutationResult) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *MutationResult) GetAffected() uint64 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+func (x *MutationResult) GetRefs() []*Ref {
+	if x != nil {
+		return x.Refs
+	}
+	return nil
+}
+
+func (x *MutationResult) GetCascaded() []*Ref {
+	if x != nil {
+		return x.Cascaded
+	}
+	return nil
+}
+
+func (x *MutationResult) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *MutationResult) GetDocs() *Docs {
+	if x != nil {
+		return x.Docs
+	}
+	return nil
+}
+
+func (x *MutationResult) GetConnections() *Connections {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+// Boolean is a simple boolean value
+type Boolean struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value bool `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Boolean) Reset() {
+	*x = Boolean{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Boolean) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Boolean) ProtoMessage() {}
+
+func (x *Boolean) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Boolean.ProtoReflect.Descriptor instead.
+func (*Boolean) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *Boolean) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+// Number is a simple double/float64 value
+type Number struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Number) Reset() {
+	*x = Number{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Number) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Number) ProtoMessage() {}
+
+func (x *Number) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Number.ProtoReflect.Descriptor instead.
+func (*Number) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Number) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// ExistsFilter is used as input into all Exists(boolean) related queries.
+type ExistsFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Gtype      string `protobuf:"bytes,1,opt,name=gtype,proto3" json:"gtype,omitempty"`
+	Expression string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	// seek to a specific key for pagination
+	Seek string `protobuf:"bytes,3,opt,name=seek,proto3" json:"seek,omitempty"`
+	// reverse the results
+	Reverse bool `protobuf:"varint,4,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	// index overrides the query planner - searching a specific EXPRESSION index
+	Index string `protobuf:"bytes,5,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *ExistsFilter) Reset() {
+	*x = ExistsFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExistsFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExistsFilter) ProtoMessage() {}
+
+func (x *ExistsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExistsFilter.ProtoReflect.Descriptor instead.
+func (*ExistsFilter) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ExistsFilter) GetGtype() string {
+	if x != nil {
+		return x.Gtype
+	}
+	return ""
+}
+
+func (x *ExistsFilter) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *ExistsFilter) GetSeek() string {
+	if x != nil {
+		return x.Seek
+	}
+	return ""
+}
+
+func (x *ExistsFilter) GetReverse() bool {
+	if x != nil {
+		return x.Reverse
+	}
+	return false
+}
+
+func (x *ExistsFilter) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+// Edit patches the attributes of a Doc or Connection
+type Edit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ref is the ref to the target doc/connection to patch
+	Ref *Ref `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	// attributes are k/v pairs used to overwrite k/v pairs on a doc/connection
+	Attributes *_struct.Struct `protobuf:"bytes,2,opt,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *Edit) Reset() {
+	*x = Edit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Edit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Edit) ProtoMessage() {}
+
+func (x *Edit) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Edit.ProtoReflect.Descriptor instead.
+func (*Edit) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *Edit) GetRef() *Ref {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+func (x *Edit) GetAttributes() *_struct.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// EditFilter is used to patch/edit docs/connections
+type EditFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// filter is used to filter docs/connections to patch
+	Filter *Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// attributes are k/v pairs used to overwrite k/v pairs on all docs/connections that pass the filter
+	Attributes *_struct.Struct `protobuf:"bytes,2,opt,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *EditFilter) Reset() {
+	*x = EditFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditFilter) ProtoMessage() {}
+
+func (x *EditFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditFilter.ProtoReflect.Descriptor instead.
+func (*EditFilter) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *EditFilter) GetFilter() *Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *EditFilter) GetAttributes() *_struct.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// DocUpsert is used to create a doc or merge attributes into it if it already exists
+type DocUpsert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ref is the ref to the doc to create/merge
+	Ref *Ref `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	// attributes are k/v pairs used to create the doc or overwrite k/v pairs on the existing doc
+	Attributes *_struct.Struct `protobuf:"bytes,2,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// expression is an optional CEL expression evaluated against the existing doc. If it fails to evaluate or evaluates false, the upsert fails with FAILED_PRECONDITION(rolling back the batch)
+	Expression string `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
+}
+
+func (x *DocUpsert) Reset() {
+	*x = DocUpsert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DocUpsert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocUpsert) ProtoMessage() {}
+
+func (x *DocUpsert) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocUpsert.ProtoReflect.Descriptor instead.
+func (*DocUpsert) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *DocUpsert) GetRef() *Ref {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+func (x *DocUpsert) GetAttributes() *_struct.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *DocUpsert) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+// DocUpserts is an array of DocUpsert
+type DocUpserts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Docs []*DocUpsert `protobuf:"bytes,1,rep,name=docs,proto3" json:"docs,omitempty"`
+}
+
+func (x *DocUpserts) Reset() {
+	*x = DocUpserts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DocUpserts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocUpserts) ProtoMessage() {}
+
+func (x *DocUpserts) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocUpserts.ProtoReflect.Descriptor instead.
+func (*DocUpserts) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DocUpserts) GetDocs() []*DocUpsert {
+	if x != nil {
+		return x.Docs
+	}
+	return nil
+}
+
+// ConnectionUpsert is used to create a connection or merge attributes into it if it already exists
+type ConnectionUpsert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ref is the ref to the connection to create/merge
+	Ref *Ref `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	// attributes are k/v pairs used to create the connection or overwrite k/v pairs on the existing connection
+	Attributes *_struct.Struct `protobuf:"bytes,2,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// directed is false if the connection is bi-directional(only applied on creation)
+	Directed bool `protobuf:"varint,3,opt,name=directed,proto3" json:"directed,omitempty"`
+	// from is the doc ref that is the source of the connection(only applied on creation)
+	From *Ref `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the doc ref that is the destination of the connection(only applied on creation)
+	To *Ref `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	// expression is an optional CEL expression evaluated against the existing connection. If it fails to evaluate or evaluates false, the upsert fails with FAILED_PRECONDITION(rolling back the batch)
+	Expression string `protobuf:"bytes,6,opt,name=expression,proto3" json:"expression,omitempty"`
+}
+
+func (x *ConnectionUpsert) Reset() {
+	*x = ConnectionUpsert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionUpsert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionUpsert) ProtoMessage() {}
+
+func (x *ConnectionUpsert) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionUpsert.ProtoReflect.Descriptor instead.
+func (*ConnectionUpsert) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ConnectionUpsert) GetRef() *Ref {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+func (x *ConnectionUpsert) GetAttributes() *_struct.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *ConnectionUpsert) GetDirected() bool {
+	if x != nil {
+		return x.Directed
+	}
+	return false
+}
+
+func (x *ConnectionUpsert) GetFrom() *Ref {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ConnectionUpsert) GetTo() *Ref {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ConnectionUpsert) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+// ConnectionUpserts is an array of ConnectionUpsert
+type ConnectionUpserts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Connections []*ConnectionUpsert `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections,omitempty"`
+}
+
+func (x *ConnectionUpserts) Reset() {
+	*x = ConnectionUpserts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionUpserts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionUpserts) ProtoMessage() {}
+
+func (x *ConnectionUpserts) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionUpserts.ProtoReflect.Descriptor instead.
+func (*ConnectionUpserts) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ConnectionUpserts) GetConnections() []*ConnectionUpsert {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+// TypeRename renames a doc/connection type
+type TypeRename struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// from is the current doc/connection type
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the new doc/connection type
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *TypeRename) Reset() {
+	*x = TypeRename{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TypeRename) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypeRename) ProtoMessage() {}
+
+func (x *TypeRename) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypeRename.ProtoReflect.Descriptor instead.
+func (*TypeRename) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *TypeRename) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *TypeRename) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+// AttributeTransform merges the map returned by a CEL expression into the attributes of every doc/connection of the given type
+type AttributeTransform struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// gtype is the doc/connection type to transform
+	Gtype string `protobuf:"bytes,1,opt,name=gtype,proto3" json:"gtype,omitempty"`
+	// expression is a CEL expression that returns a map ex: {'full_name': this.attributes.first_name + ' ' + this.attributes.last_name}
+	Expression string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+}
+
+func (x *AttributeTransform) Reset() {
+	*x = AttributeTransform{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttributeTransform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeTransform) ProtoMessage() {}
+
+func (x *AttributeTransform) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeTransform.ProtoReflect.Descriptor instead.
+func (*AttributeTransform) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *AttributeTransform) GetGtype() string {
+	if x != nil {
+		return x.Gtype
+	}
+	return ""
+}
+
+func (x *AttributeTransform) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+// Migration is a versioned schema change. All steps are applied within a single transaction in the following order:
+// doc type renames, connection type renames, doc transforms, connection transforms
+type Migration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// version uniquely identifies the migration - a version is only ever applied once
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// description is a human readable description of the migration
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// rename_docs renames doc types & rewrites the from/to of every connection referencing them
+	RenameDocs []*TypeRename `protobuf:"bytes,3,rep,name=rename_docs,json=renameDocs,proto3" json:"rename_docs,omitempty"`
+	// rename_connections renames connection types
+	RenameConnections []*TypeRename `protobuf:"bytes,4,rep,name=rename_connections,json=renameConnections,proto3" json:"rename_connections,omitempty"`
+	// transform_docs computes new attributes for every doc of a type
+	TransformDocs []*AttributeTransform `protobuf:"bytes,5,rep,name=transform_docs,json=transformDocs,proto3" json:"transform_docs,omitempty"`
+	// transform_connections computes new attributes for every connection of a type
+	TransformConnections []*AttributeTransform `protobuf:"bytes,6,rep,name=transform_connections,json=transformConnections,proto3" json:"transform_connections,omitempty"`
+}
+
+func (x *Migration) Reset() {
+	*x = Migration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Migration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Migration) ProtoMessage() {}
+
+func (x *Migration) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Migration.ProtoReflect.Descriptor instead.
+func (*Migration) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *Migration) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Migration) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Migration) GetRenameDocs() []*TypeRename {
+	if x != nil {
+		return x.RenameDocs
+	}
+	return nil
+}
+
+func (x *Migration) GetRenameConnections() []*TypeRename {
+	if x != nil {
+		return x.RenameConnections
+	}
+	return nil
+}
+
+func (x *Migration) GetTransformDocs() []*AttributeTransform {
+	if x != nil {
+		return x.TransformDocs
+	}
+	return nil
+}
+
+func (x *Migration) GetTransformConnections() []*AttributeTransform {
+	if x != nil {
+		return x.TransformConnections
+	}
+	return nil
+}
+
+// MigrationStatus is the status of a migration
+type MigrationStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// migration is the migration that was applied
+	Migration *Migration `protobuf:"bytes,1,opt,name=migration,proto3" json:"migration,omitempty"`
+	// state is the current state of the migration
+	State JobState `protobuf:"varint,2,opt,name=state,proto3,enum=api.JobState" json:"state,omitempty"`
+	// affected is the number of docs & connections rewritten by the migration
+	Affected uint64 `protobuf:"varint,3,opt,name=affected,proto3" json:"affected,omitempty"`
+	// error is the reason the migration failed, if any
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// user is the user that applied the migration
+	User *Ref `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	// started_at is the time the migration started
+	StartedAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// completed_at is the time the migration completed or failed
+	CompletedAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+}
+
+func (x *MigrationStatus) Reset() {
+	*x = MigrationStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MigrationStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrationStatus) ProtoMessage() {}
+
+func (x *MigrationStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrationStatus.ProtoReflect.Descriptor instead.
+func (*MigrationStatus) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *MigrationStatus) GetMigration() *Migration {
+	if x != nil {
+		return x.Migration
+	}
+	return nil
+}
+
+func (x *MigrationStatus) GetState() JobState {
+	if x != nil {
+		return x.State
+	}
+	return JobState_RUNNING
+}
+
+func (x *MigrationStatus) GetAffected() uint64 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+func (x *MigrationStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *MigrationStatus) GetUser() *Ref {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *MigrationStatus) GetStartedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *MigrationStatus) GetCompletedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+// MigrationStatuses is an array of MigrationStatus ordered by version
+type MigrationStatuses struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Migrations []*MigrationStatus `protobuf:"bytes,1,rep,name=migrations,proto3" json:"migrations,omitempty"`
+}
+
+func (x *MigrationStatuses) Reset() {
+	*x = MigrationStatuses{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MigrationStatuses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrationStatuses) ProtoMessage() {}
+
+func (x *MigrationStatuses) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrationStatuses.ProtoReflect.Descriptor instead.
+func (*MigrationStatuses) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *MigrationStatuses) GetMigrations() []*MigrationStatus {
+	if x != nil {
+		return x.Migrations
+	}
+	return nil
+}
+
+// Subgraph selects the docs & connections a job operates on - connections are only included if both of their docs are
+type Subgraph struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// doc_gtypes are the types of docs to include
+	DocGtypes []string `protobuf:"bytes,1,rep,name=doc_gtypes,json=docGtypes,proto3" json:"doc_gtypes,omitempty"`
+	// doc_expression is a boolean CEL expression used to filter docs
+	DocExpression string `protobuf:"bytes,2,opt,name=doc_expression,json=docExpression,proto3" json:"doc_expression,omitempty"`
+	// connection_gtypes are the types of connections to include
+	ConnectionGtypes []string `protobuf:"bytes,3,rep,name=connection_gtypes,json=connectionGtypes,proto3" json:"connection_gtypes,omitempty"`
+	// connection_expression is a boolean CEL expression used to filter connections
+	ConnectionExpression string `protobuf:"bytes,4,opt,name=connection_expression,json=connectionExpression,proto3" json:"connection_expression,omitempty"`
+}
+
+func (x *Subgraph) Reset() {
+	*x = Subgraph{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Subgraph) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subgraph) ProtoMessage() {}
+
+func (x *Subgraph) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subgraph.ProtoReflect.Descriptor instead.
+func (*Subgraph) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *Subgraph) GetDocGtypes() []string {
+	if x != nil {
+		return x.DocGtypes
+	}
+	return nil
+}
+
+func (x *Subgraph) GetDocExpression() string {
+	if x != nil {
+		return x.DocExpression
+	}
+	return ""
+}
+
+func (x *Subgraph) GetConnectionGtypes() []string {
+	if x != nil {
+		return x.ConnectionGtypes
+	}
+	return nil
+}
+
+func (x *Subgraph) GetConnectionExpression() string {
+	if x != nil {
+		return x.ConnectionExpression
+	}
+	return ""
+}
+
+// AnalyticsJob runs a graph analytics algorithm over a subgraph
+type AnalyticsJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// algorithm is the algorithm to run
+	Algorithm AnalyticsAlgorithm `protobuf:"varint,1,opt,name=algorithm,proto3,enum=api.AnalyticsAlgorithm" json:"algorithm,omitempty"`
+	// subgraph selects the docs & connections to analyze
+	Subgraph *Subgraph `protobuf:"bytes,2,opt,name=subgraph,proto3" json:"subgraph,omitempty"`
+	// direction is the direction connections are followed in - OUT(the default), IN or BOTH(undirected)
+	Direction Direction `protobuf:"varint,3,opt,name=direction,proto3,enum=api.Direction" json:"direction,omitempty"`
+	// attribute is the doc attribute results are written to(ex: pagerank). If empty, results are only available via StreamAnalyticsResults.
+	Attribute string `protobuf:"bytes,4,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	// damping is the PageRank damping factor - defaults to 0.85
+	Damping float64 `protobuf:"fixed64,5,opt,name=damping,proto3" json:"damping,omitempty"`
+	// max_iterations is the maximum number of PageRank iterations - defaults to 100
+	MaxIterations uint32 `protobuf:"varint,6,opt,name=max_iterations,json=maxIterations,proto3" json:"max_iterations,omitempty"`
+	// tolerance is the total change in rank below which PageRank has converged - defaults to 0.000001
+	Tolerance float64 `protobuf:"fixed64,7,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
+}
+
+func (x *AnalyticsJob) Reset() {
+	*x = AnalyticsJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyticsJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyticsJob) ProtoMessage() {}
+
+func (x *AnalyticsJob) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyticsJob.ProtoReflect.Descriptor instead.
+func (*AnalyticsJob) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *AnalyticsJob) GetAlgorithm() AnalyticsAlgorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return AnalyticsAlgorithm_PAGERANK
+}
+
+func (x *AnalyticsJob) GetSubgraph() *Subgraph {
+	if x != nil {
+		return x.Subgraph
+	}
+	return nil
+}
+
+func (x *AnalyticsJob) GetDirection() Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return Direction_OUT
+}
+
+func (x *AnalyticsJob) GetAttribute() string {
+	if x != nil {
+		return x.Attribute
+	}
+	return ""
+}
+
+func (x *AnalyticsJob) GetDamping() float64 {
+	if x != nil {
+		return x.Damping
+	}
+	return 0
+}
+
+func (x *AnalyticsJob) GetMaxIterations() uint32 {
+	if x != nil {
+		return x.MaxIterations
+	}
+	return 0
+}
+
+func (x *AnalyticsJob) GetTolerance() float64 {
+	if x != nil {
+		return x.Tolerance
+	}
+	return 0
+}
+
+// AnalyticsRef is the id of an analytics job
+type AnalyticsRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AnalyticsRef) Reset() {
+	*x = AnalyticsRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyticsRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyticsRef) ProtoMessage() {}
+
+func (x *AnalyticsRef) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyticsRef.ProtoReflect.Descriptor instead.
+func (*AnalyticsRef) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *AnalyticsRef) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// AnalyticsStatus is the status & progress of an analytics job
+type AnalyticsStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the id of the job
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// job is the job that's running
+	Job *AnalyticsJob `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
+	// state is the current state of the job
+	State JobState `protobuf:"varint,3,opt,name=state,proto3,enum=api.JobState" json:"state,omitempty"`
+	// phase is the current phase of the job - loading, running, writing or done
+	Phase string `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase,omitempty"`
+	// progress is the fraction(0-1) of the current phase that's complete
+	Progress float64 `protobuf:"fixed64,5,opt,name=progress,proto3" json:"progress,omitempty"`
+	// docs is the number of docs in the subgraph
+	Docs uint64 `protobuf:"varint,6,opt,name=docs,proto3" json:"docs,omitempty"`
+	// connections is the number of connections in the subgraph
+	Connections uint64 `protobuf:"varint,7,opt,name=connections,proto3" json:"connections,omitempty"`
+	// iterations is the number of iterations PageRank executed or the number of levels Louvain aggregated communities
+	Iterations uint64 `protobuf:"varint,8,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	// written is the number of docs the results have been written to
+	Written uint64 `protobuf:"varint,9,opt,name=written,proto3" json:"written,omitempty"`
+	// error is the reason the job failed, if any
+	Error string `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	// user is the user that started the job
+	User *Ref `protobuf:"bytes,11,opt,name=user,proto3" json:"user,omitempty"`
+	// started_at is the time the job started
+	StartedAt *timestamp.Timestamp `protobuf:"bytes,12,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// completed_at is the time the job completed, failed or was cancelled
+	CompletedAt *timestamp.Timestamp `protobuf:"bytes,13,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// components are the largest(up to 1000) components/communities found by a components or LOUVAIN job in descending order of size
+	Components []*Component `protobuf:"bytes,14,rep,name=components,proto3" json:"components,omitempty"`
+	// component_count is the total number of components/communities found by a components or LOUVAIN job
+	ComponentCount uint64 `protobuf:"varint,15,opt,name=component_count,json=componentCount,proto3" json:"component_count,omitempty"`
+	// modularity is the modularity(-0.5 - 1) of the communities found by a LOUVAIN job - higher is better
+	Modularity float64 `protobuf:"fixed64,16,opt,name=modularity,proto3" json:"modularity,omitempty"`
+}
+
+func (x *AnalyticsStatus) Reset() {
+	*x = AnalyticsStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyticsStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyticsStatus) ProtoMessage() {}
+
+func (x *AnalyticsStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyticsStatus.ProtoReflect.Descriptor instead.
+func (*AnalyticsStatus) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *AnalyticsStatus) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AnalyticsStatus) GetJob() *AnalyticsJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *AnalyticsStatus) GetState() JobState {
+	if x != nil {
+		return x.State
+	}
+	return JobState_RUNNING
+}
+
+func (x *AnalyticsStatus) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *AnalyticsStatus) GetProgress() float64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *AnalyticsStatus) GetDocs() uint64 {
+	if x != nil {
+		return x.Docs
+	}
+	return 0
+}
+
+func (x *AnalyticsStatus) GetConnections() uint64 {
+	if x != nil {
+		return x.Connections
+	}
+	return 0
+}
+
+func (x *AnalyticsStatus) GetIterations() uint64 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *AnalyticsStatus) GetWritten() uint64 {
+	if x != nil {
+		return x.Written
+	}
+	return 0
+}
+
+func (x *AnalyticsStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AnalyticsStatus) GetUser() *Ref {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *AnalyticsStatus) GetStartedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *AnalyticsStatus) GetCompletedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *AnalyticsStatus) GetComponents() []*Component {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *AnalyticsStatus) GetComponentCount() uint64 {
+	if x != nil {
+		return x.ComponentCount
+	}
+	return 0
+}
+
+func (x *AnalyticsStatus) GetModularity() float64 {
+	if x != nil {
+		return x.Modularity
+	}
+	return 0
+}
+
+// Component is a connected component or community found by an analytics job
+type Component struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the id docs in the component are labeled with - ids are numbered from 0 in descending order of size
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// size is the number of docs in the component
+	Size uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *Component) Reset() {
+	*x = Component{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Component) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Component) ProtoMessage() {}
+
+func (x *Component) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Component.ProtoReflect.Descriptor instead.
+func (*Component) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *Component) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Component) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// AnalyticsStatuses is an array of AnalyticsStatus ordered by start time
+type AnalyticsStatuses struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jobs []*AnalyticsStatus `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *AnalyticsStatuses) Reset() {
+	*x = AnalyticsStatuses{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyticsStatuses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyticsStatuses) ProtoMessage() {}
+
+func (x *AnalyticsStatuses) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyticsStatuses.ProtoReflect.Descriptor instead.
+func (*AnalyticsStatuses) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *AnalyticsStatuses) GetJobs() []*AnalyticsStatus {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+// AnalyticsResult is the result of an analytics job for a single doc
+type AnalyticsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ref is the ref of the doc
+	Ref *Ref `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	// value is the doc's score or the id of it's component/community
+	Value float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AnalyticsResult) Reset() {
+	*x = AnalyticsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyticsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyticsResult) ProtoMessage() {}
+
+func (x *AnalyticsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyticsResult.ProtoReflect.Descriptor instead.
+func (*AnalyticsResult) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *AnalyticsResult) GetRef() *Ref {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+func (x *AnalyticsResult) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// Pong returns PONG if the server is healthy
+type Pong struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// message returns PONG if healthy
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *Pong) Reset() {
+	*x = Pong{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pong) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pong) ProtoMessage() {}
+
+func (x *Pong) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pong.ProtoReflect.Descriptor instead.
+func (*Pong) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *Pong) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// OutboundMessage is a message to be published to a pubsub channel
+type OutboundMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// channel is the target channel to send the message to
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// data is the data to send with the message
+	Data *_struct.Struct `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *OutboundMessage) Reset() {
+	*x = OutboundMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutboundMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboundMessage) ProtoMessage() {}
+
+func (x *OutboundMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutboundMessage.ProtoReflect.Descriptor instead.
+func (*OutboundMessage) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *OutboundMessage) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *OutboundMessage) GetData() *_struct.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Message is received on PubSub subscriptions
+type Message struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// channel is the channel the message was sent to
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// data is the data sent with the message
+	Data *_struct.Struct `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// user is the sender that triggered/sent the message
+	User *Ref `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	// timestamp is when the message was sent
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Method    string               `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *Message) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *Message) GetData() *_struct.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Message) GetUser() *Ref {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Message) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Message) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+// Schema returns registered connection & doc types
+type Schema struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// connection_types are the types of connections in the graph
+	ConnectionTypes []string `protobuf:"bytes,1,rep,name=connection_types,json=connectionTypes,proto3" json:"connection_types,omitempty"`
+	// doc_types are the types of docs in the graph
+	DocTypes    []string        `protobuf:"bytes,2,rep,name=doc_types,json=docTypes,proto3" json:"doc_types,omitempty"`
+	Authorizers *Authorizers    `protobuf:"bytes,3,opt,name=authorizers,proto3" json:"authorizers,omitempty"`
+	Validators  *TypeValidators `protobuf:"bytes,4,opt,name=validators,proto3" json:"validators,omitempty"`
+	Indexes     *Indexes        `protobuf:"bytes,5,opt,name=indexes,proto3" json:"indexes,omitempty"`
+}
+
+func (x *Schema) Reset() {
+	*x = Schema{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Schema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schema) ProtoMessage() {}
+
+func (x *Schema) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schema.ProtoReflect.Descriptor instead.
+func (*Schema) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *Schema) GetConnectionTypes() []string {
+	if x != nil {
+		return x.ConnectionTypes
+	}
+	return nil
+}
+
+func (x *Schema) GetDocTypes() []string {
+	if x != nil {
+		return x.DocTypes
+	}
+	return nil
+}
+
+func (x *Schema) GetAuthorizers() *Authorizers {
+	if x != nil {
+		return x.Authorizers
+	}
+	return nil
+}
+
+func (x *Schema) GetValidators() *TypeValidators {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+func (x *Schema) GetIndexes() *Indexes {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+type ExprFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// expression is a CEL expression used to filter connections/nodes
+	Expression string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+}
+
+func (x *ExprFilter) Reset() {
+	*x = ExprFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExprFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExprFilter) ProtoMessage() {}
+
+func (x *ExprFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExprFilter.ProtoReflect.Descriptor instead.
+func (*ExprFilter) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ExprFilter) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+type Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// method is the rpc method
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// user is the user making the request
+	User *Doc `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// timestamp is when the intercept was received
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// request is the intercepted request
+	Request *_struct.Struct `protobuf:"bytes,4,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *Request) Reset() {
+	*x = Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphik_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Request) ProtoMessage() {}
+
+func (x *Request) ProtoReflect() protoreflect.Message {
+	mi := &file_graphik_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Request.ProtoReflect.Descriptor instead.
+func (*Request) Descriptor() ([]byte, []int) {
+	return file_graphik_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *Request) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Request) GetUser() *Doc {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Request) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Request) GetRequest() *_struct.Struct {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+var File_graphik_proto protoreflect.FileDescriptor
+
+var file_graphik_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x67, 0x72, 0x61, 0x70, 0x68, 0x69, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x03, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x36, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x77, 0x69, 0x74, 0x6b, 0x6f, 0x77, 0x2f,
+	0x67, 0x6f, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x51, 0x0a, 0x03, 0x52, 0x65, 0x66, 0x12, 0x26, 0x0a, 0x05, 0x67, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a,
+	0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x05, 0x67, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x67, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d,
+	0x24, 0x52, 0x03, 0x67, 0x69, 0x64, 0x22, 0x4a, 0x0a, 0x0e, 0x52, 0x65, 0x66, 0x43, 0x6f, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x67, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e,
+	0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x67, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x67,
+	0x69, 0x64, 0x22, 0x24, 0x0a, 0x04, 0x52, 0x65, 0x66, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x72, 0x65,
+	0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x66, 0x52, 0x04, 0x72, 0x65, 0x66, 0x73, 0x22, 0x62, 0x0a, 0x03, 0x44, 0x6f, 0x63, 0x12,
+	0x22, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x03,
+	0x72, 0x65, 0x66, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0xa1, 0x01, 0x0a,
+	0x0e, 0x44, 0x6f, 0x63, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x2d, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f,
+	0x72, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x37,
+	0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79,
+	0x22, 0x3a, 0x0a, 0x0f, 0x44, 0x6f, 0x63, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x43, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x22, 0x82, 0x01, 0x0a,
+	0x09, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x03, 0x64, 0x6f,
+	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f,
+	0x63, 0x52, 0x03, 0x64, 0x6f, 0x63, 0x12, 0x2f, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x6f, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x68, 0x6f, 0x70,
+	0x73, 0x22, 0x3c, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x73, 0x12,
+	0x2e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x6c, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x73, 0x22,
+	0x82, 0x02, 0x0a, 0x0a, 0x50, 0x61, 0x74, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x28,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01,
+	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x66, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x12, 0x33, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x01, 0x6b, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x18, 0x65, 0x52, 0x01, 0x6b, 0x12,
+	0x23, 0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x06, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x22, 0xc9, 0x02, 0x0a, 0x0e, 0x41, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68,
+	0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x66, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x28, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x42, 0x06, 0xe2, 0xdf, 0x1f,
+	0x02, 0x20, 0x01, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64,
+	0x6f, 0x63, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x6f, 0x63, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64,
+	0x65, 0x70, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02,
+	0x10, 0x00, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x10, 0x00, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x74, 0x68,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x62,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x22, 0x6b, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x04, 0x64, 0x6f, 0x63, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63,
+	0x52, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x12, 0x31, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x22, 0x28, 0x0a,
+	0x05, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x1f, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x74, 0x68,
+	0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x22, 0x73, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x50,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x22, 0xc3, 0x01, 0x0a,
+	0x0b, 0x45, 0x64, 0x67, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x68, 0x6f, 0x70, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x48, 0x6f, 0x70, 0x73, 0x12,
+	0x21, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x68, 0x6f, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x18, 0x0b, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x48, 0x6f,
+	0x70, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x2e, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x60, 0x01, 0x52, 0x05, 0x6e, 0x6f, 0x64,
+	0x65, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x50, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x10,
+	0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x62, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x22, 0x85, 0x02,
+	0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x2e, 0x44, 0x6f, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x6f, 0x63,
+	0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x1a, 0x41, 0x0a, 0x09, 0x44, 0x6f, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x1e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x50, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2f, 0x0a, 0x07, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x12, 0x24, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0xa9, 0x02, 0x0a, 0x0d, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x66, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x60, 0x01, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x74, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x64, 0x6f, 0x63, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x6f, 0x63, 0x45, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x10, 0x00, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x74,
+	0x68, 0x12, 0x1c, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x10, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x2c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a,
+	0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x06, 0x62, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x22, 0xab, 0x01, 0x0a, 0x15, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x69, 0x63,
+	0x61, 0x6c, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x05,
+	0x67, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f,
+	0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x05, 0x67,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x79, 0x63, 0x6c,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x18, 0x65,
+	0x52, 0x09, 0x6d, 0x61, 0x78, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x06, 0x62,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x22, 0x6d, 0x0a, 0x10, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x79, 0x63, 0x6c, 0x69, 0x63, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x63, 0x79, 0x63, 0x6c, 0x69, 0x63, 0x12, 0x1c,
+	0x0a, 0x04, 0x72, 0x65, 0x66, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x04, 0x72, 0x65, 0x66, 0x73, 0x12, 0x21, 0x0a, 0x06,
+	0x63, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x06, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x22,
+	0x41, 0x0a, 0x04, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x52,
+	0x04, 0x64, 0x6f, 0x63, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x65, 0x6b, 0x5f, 0x6e, 0x65,
+	0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x65, 0x6b, 0x4e, 0x65,
+	0x78, 0x74, 0x22, 0xcd, 0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x22, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01,
+	0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x66, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x12, 0x20, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x02,
+	0x74, 0x6f, 0x22, 0x8c, 0x02, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x03,
+	0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x66, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x06,
+	0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x37, 0x0a, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x12, 0x24, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x20, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x42, 0x06, 0xe2, 0xdf,
+	0x1f, 0x02, 0x20, 0x01, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65,
+	0x79, 0x22, 0xcb, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x66, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x22,
+	0xa7, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x4d, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x56, 0x0a, 0x16, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x5d, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x31, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x65, 0x6b, 0x5f, 0x6e, 0x65, 0x78, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x65, 0x6b, 0x4e, 0x65, 0x78, 0x74,
+	0x22, 0xc7, 0x02, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x64, 0x6f, 0x63, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x42, 0x06, 0xe2,
+	0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x06, 0x64, 0x6f, 0x63, 0x52, 0x65, 0x66, 0x12, 0x26, 0x0a,
+	0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf,
+	0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x05,
+	0x67, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x10, 0x00, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x49, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x35, 0xe2, 0xdf, 0x1f, 0x31, 0x0a, 0x2f, 0x28, 0x28, 0x5e, 0x7c, 0x2c, 0x20, 0x29,
+	0x28, 0x7c, 0x72, 0x65, 0x66, 0x2e, 0x67, 0x69, 0x64, 0x7c, 0x72, 0x65, 0x66, 0x2e, 0x67, 0x74,
+	0x79, 0x70, 0x65, 0x7c, 0x5e, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e,
+	0x28, 0x2e, 0x2a, 0x29, 0x29, 0x29, 0x2b, 0x24, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x65, 0x65, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x65,
+	0x65, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5a, 0x0a, 0x0c, 0x44, 0x65,
+	0x67, 0x72, 0x65, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x03, 0x64, 0x6f,
+	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x66, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x03, 0x64, 0x6f, 0x63, 0x12, 0x26,
+	0x0a, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2,
+	0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x30, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52,
+	0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5f, 0x0a, 0x0b, 0x47, 0x74, 0x79, 0x70, 0x65, 0x44,
+	0x65, 0x67, 0x72, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69,
+	0x6e, 0x5f, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x69, 0x6e, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x5f,
+	0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6f, 0x75,
+	0x74, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x06, 0x44, 0x65, 0x67, 0x72,
+	0x65, 0x65, 0x12, 0x1a, 0x0a, 0x03, 0x64, 0x6f, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x03, 0x64, 0x6f, 0x63, 0x12, 0x1b,
+	0x0a, 0x09, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x69, 0x6e, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6f,
+	0x75, 0x74, 0x5f, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x6f, 0x75, 0x74, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x67, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x74, 0x79, 0x70, 0x65, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x52, 0x06, 0x67, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x22, 0xd3, 0x02, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x26, 0x0a, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10,
+	0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24,
+	0x52, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x10, 0x00, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x61, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x4d, 0xe2, 0xdf, 0x1f, 0x49, 0x0a, 0x47, 0x28, 0x28, 0x5e, 0x7c, 0x2c,
+	0x20, 0x29, 0x28, 0x7c, 0x72, 0x65, 0x66, 0x2e, 0x67, 0x69, 0x64, 0x7c, 0x72, 0x65, 0x66, 0x2e,
+	0x67, 0x74, 0x79, 0x70, 0x65, 0x7c, 0x5e, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x2e, 0x28, 0x2e, 0x2a, 0x29, 0x7c, 0x5e, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x2e, 0x28,
+	0x69, 0x6e, 0x7c, 0x6f, 0x75, 0x74, 0x29, 0x28, 0x5c, 0x2e, 0x2e, 0x2a, 0x29, 0x3f, 0x29, 0x29,
+	0x2b, 0x24, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x6b,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x65, 0x65, 0x6b, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72,
+	0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x23, 0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x52, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x06, 0x42,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x61,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x61, 0x6e,
+	0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0xa1,
+	0x01, 0x0a, 0x10, 0x54, 0x65, 0x78, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32,
+	0x32, 0x35, 0x7d, 0x24, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x27, 0x0a, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xe2, 0xdf, 0x1f, 0x0d,
+	0x0a, 0x0b, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x31, 0x30, 0x30, 0x30, 0x7d, 0x24, 0x52, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x10, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x09, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x64, 0x44, 0x6f, 0x63, 0x12,
+	0x1a, 0x0a, 0x03, 0x64, 0x6f, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x52, 0x03, 0x64, 0x6f, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x22, 0x30, 0x0a, 0x0a, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x64, 0x44, 0x6f, 0x63, 0x73, 0x12,
+	0x22, 0x0a, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x64, 0x44, 0x6f, 0x63, 0x52, 0x04, 0x64,
+	0x6f, 0x63, 0x73, 0x22, 0x5e, 0x0a, 0x08, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x28, 0x0a, 0x03, 0x6c, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x16, 0xe2, 0xdf,
+	0x1f, 0x12, 0x49, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x56, 0xc0, 0x51, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x80, 0x56, 0x40, 0x52, 0x03, 0x6c, 0x61, 0x74, 0x12, 0x28, 0x0a, 0x03, 0x6c, 0x6e, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x16, 0xe2, 0xdf, 0x1f, 0x12, 0x49, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x80, 0x66, 0xc0, 0x51, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x66, 0x40, 0x52, 0x03,
+	0x6c, 0x6e, 0x67, 0x22, 0x61, 0x0a, 0x09, 0x47, 0x65, 0x6f, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73,
+	0x12, 0x2d, 0x0a, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x42,
+	0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12,
+	0x25, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42,
+	0x0d, 0xe2, 0xdf, 0x1f, 0x09, 0x31, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x06,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x5a, 0x0a, 0x06, 0x47, 0x65, 0x6f, 0x42, 0x6f, 0x78,
+	0x12, 0x27, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x06, 0xe2, 0xdf,
+	0x1f, 0x02, 0x20, 0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x27, 0x0a, 0x03, 0x6d, 0x61, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x6f,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x03, 0x6d,
+	0x61, 0x78, 0x22, 0xa1, 0x02, 0x0a, 0x09, 0x47, 0x65, 0x6f, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x26, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d,
+	0x24, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x28, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x6f, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x48, 0x00, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69,
+	0x75, 0x73, 0x12, 0x1f, 0x0a, 0x03, 0x62, 0x6f, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x6f, 0x42, 0x6f, 0x78, 0x48, 0x00, 0x52, 0x03,
+	0x62, 0x6f, 0x78, 0x12, 0x29, 0x0a, 0x07, 0x6e, 0x65, 0x61, 0x72, 0x65, 0x73, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x6e, 0x65, 0x61, 0x72, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2,
+	0xdf, 0x1f, 0x02, 0x10, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08,
+	0x74, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x08, 0x74, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x42, 0x07, 0x0a,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x3c, 0x0a, 0x06, 0x47, 0x65, 0x6f, 0x44, 0x6f, 0x63,
+	0x12, 0x1a, 0x0a, 0x03, 0x64, 0x6f, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x52, 0x03, 0x64, 0x6f, 0x63, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x22, 0x2a, 0x0a, 0x07, 0x47, 0x65, 0x6f, 0x44, 0x6f, 0x63, 0x73, 0x12,
+	0x1f, 0x0a, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x6f, 0x44, 0x6f, 0x63, 0x52, 0x04, 0x64, 0x6f, 0x63, 0x73,
+	0x22, 0xb9, 0x01, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x2e, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x64, 0x6f, 0x63,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x6f,
+	0x63, 0x73, 0x12, 0x3c, 0x0a, 0x12, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x11, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x31, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x74, 0x72, 0x61, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x4f, 0x0a, 0x10,
+	0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x22, 0x3a, 0x0a,
+	0x0c, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x59, 0x0a, 0x0c, 0x45, 0x78, 0x70,
+	0x6c, 0x61, 0x69, 0x6e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc1, 0x03, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x12, 0x35, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x6c,
+	0x61, 0x69, 0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x63, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6b, 0x65, 0x79, 0x73,
+	0x5f, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x6b, 0x65, 0x79, 0x73, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64,
+	0x6f, 0x63, 0x73, 0x5f, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x73, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x12, 0x2f,
+	0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x64, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x12,
+	0x20, 0x0a, 0x0b, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x29, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x68, 0x61, 0x73, 0x65, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70,
+	0x6c, 0x61, 0x69, 0x6e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x06, 0x70, 0x68, 0x61, 0x73, 0x65,
+	0x73, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x82, 0x02, 0x0a, 0x0d, 0x53, 0x69, 0x6d,
+	0x69, 0x6c, 0x61, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a,
+	0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x01, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x04, 0x6c, 0x69,
+	0x6b, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x66, 0x52, 0x04, 0x6c, 0x69, 0x6b, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x10, 0x00, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54,
+	0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x08, 0x74,
+	0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x65, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x65, 0x66, 0x22, 0xc7, 0x02,
+	0x0a, 0x0e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x22, 0x0a, 0x03, 0x64, 0x6f, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52,
+	0x03, 0x64, 0x6f, 0x63, 0x12, 0x26, 0x0a, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c,
+	0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x09,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x06, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52,
+	0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x5f, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68,
+	0x62, 0x6f, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x10, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52,
+	0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x22, 0x57, 0x0a, 0x0b, 0x4e, 0x65, 0x69, 0x67, 0x68,
+	0x62, 0x6f, 0x72, 0x44, 0x6f, 0x63, 0x12, 0x1a, 0x0a, 0x03, 0x64, 0x6f, 0x63, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x52, 0x03, 0x64,
+	0x6f, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x22, 0x34, 0x0a, 0x0c, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x44, 0x6f, 0x63, 0x73,
+	0x12, 0x24, 0x0a, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x44, 0x6f, 0x63,
+	0x52, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x22, 0x44, 0x0a, 0x0a, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61,
+	0x72, 0x44, 0x6f, 0x63, 0x12, 0x1a, 0x0a, 0x03, 0x64, 0x6f, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x52, 0x03, 0x64, 0x6f, 0x63,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x32, 0x0a, 0x0b,
+	0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x64,
+	0x6f, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x44, 0x6f, 0x63, 0x52, 0x04, 0x64, 0x6f, 0x63, 0x73,
+	0x22, 0xfb, 0x01, 0x0a, 0x09, 0x41, 0x67, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2b,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x06, 0xe2, 0xdf, 0x1f,
+	0x02, 0x20, 0x01, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x09, 0x61,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x09,
+	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xe2, 0xdf, 0x1f, 0x1f, 0x0a, 0x1d,
+	0x28, 0x28, 0x5e, 0x7c, 0x2c, 0x20, 0x29, 0x28, 0x7c, 0x5e, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x2e, 0x28, 0x2e, 0x2a, 0x29, 0x29, 0x29, 0x2b, 0x24, 0x52, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69,
+	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x42, 0x16, 0xe2, 0xdf, 0x1f, 0x12, 0x49, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x51, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x59, 0x40,
+	0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x22, 0xfd,
+	0x01, 0x0a, 0x08, 0x41, 0x67, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x2c, 0x0a, 0x09, 0x61,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x09,
+	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xe2, 0xdf, 0x1f, 0x1f, 0x0a, 0x1d,
+	0x28, 0x28, 0x5e, 0x7c, 0x2c, 0x20, 0x29, 0x28, 0x7c, 0x5e, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x2e, 0x28, 0x2e, 0x2a, 0x29, 0x29, 0x29, 0x2b, 0x24, 0x52, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42, 0x16, 0xe2, 0xdf, 0x1f, 0x12, 0x49, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x51, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x59, 0x40,
+	0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x2e,
+	0x0a, 0x0b, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x42, 0x0d, 0xe2, 0xdf, 0x1f, 0x09, 0x49, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x8f,
+	0x01, 0x0a, 0x0e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x67, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x2b, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x06,
+	0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x19,
+	0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x35, 0x0a, 0x0a, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x67, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x06, 0xe2, 0xdf,
+	0x1f, 0x02, 0x60, 0x01, 0x52, 0x0a, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73,
+	0x22, 0x94, 0x01, 0x0a, 0x08, 0x41, 0x67, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2c, 0x0a,
+	0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x52, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x53, 0x0a, 0x0f, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x67, 0x72, 0x61, 0x6d, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f,
+	0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x72, 0x0a, 0x08,
+	0x41, 0x67, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x29, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x67, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x22, 0x32, 0x0a, 0x09, 0x41, 0x67, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x25, 0x0a,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x67, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x22, 0xde, 0x03, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x42,
+	0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x64, 0x6f, 0x63, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x6f, 0x63, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x10, 0x00,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x49, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35, 0xe2, 0xdf, 0x1f, 0x31, 0x0a, 0x2f, 0x28, 0x28, 0x5e,
+	0x7c, 0x2c, 0x20, 0x29, 0x28, 0x7c, 0x72, 0x65, 0x66, 0x2e, 0x67, 0x69, 0x64, 0x7c, 0x72, 0x65,
+	0x66, 0x2e, 0x67, 0x74, 0x79, 0x70, 0x65, 0x7c, 0x5e, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x2e, 0x28, 0x2e, 0x2a, 0x29, 0x29, 0x29, 0x2b, 0x24, 0x52, 0x04, 0x73, 0x6f,
+	0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09,
+	0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52,
+	0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x23, 0x0a, 0x09, 0x6d, 0x61,
+	0x78, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2,
+	0xdf, 0x1f, 0x02, 0x10, 0x00, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12,
+	0x21, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x68, 0x6f, 0x70, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x10, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x48, 0x6f,
+	0x70, 0x73, 0x12, 0x23, 0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52,
+	0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xba, 0x03, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x4d, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x6f,
+	0x63, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x64, 0x6f, 0x63, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x33, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x14, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x10, 0x00, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x49, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x35, 0xe2, 0xdf, 0x1f, 0x31, 0x0a, 0x2f, 0x28, 0x28, 0x5e, 0x7c, 0x2c, 0x20,
+	0x29, 0x28, 0x7c, 0x72, 0x65, 0x66, 0x2e, 0x67, 0x69, 0x64, 0x7c, 0x72, 0x65, 0x66, 0x2e, 0x67,
+	0x74, 0x79, 0x70, 0x65, 0x7c, 0x5e, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x2e, 0x28, 0x2e, 0x2a, 0x29, 0x29, 0x29, 0x2b, 0x24, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x61, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x09, 0x61, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x23, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64,
+	0x65, 0x70, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02,
+	0x10, 0x00, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x08,
+	0x6d, 0x61, 0x78, 0x5f, 0x68, 0x6f, 0x70, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06,
+	0xe2, 0xdf, 0x1f, 0x02, 0x10, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x48, 0x6f, 0x70, 0x73, 0x12,
+	0x23, 0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x06, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xc8, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b,
+	0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a,
+	0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf,
+	0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x05,
+	0x67, 0x74, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a,
+	0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x0a, 0x65, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x64, 0x0a,
+	0x0a, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a,
+	0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b,
+	0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x40, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x72, 0x73, 0x12, 0x31, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x65, 0x72, 0x73, 0x22, 0xdf, 0x01, 0x0a, 0x0d, 0x54, 0x79, 0x70, 0x65, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b,
+	0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a,
+	0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf,
+	0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x05,
+	0x67, 0x74, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a,
+	0x0a, 0x5e, 0x2e, 0x7b, 0x30, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x0a, 0x65, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x63, 0x79, 0x63, 0x6c, 0x69, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x61, 0x63, 0x79, 0x63, 0x6c, 0x69, 0x63, 0x22, 0x44, 0x0a, 0x0e, 0x54, 0x79, 0x70, 0x65, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0xc4, 0x02,
+	0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x24, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b,
+	0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a,
+	0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf,
+	0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x05,
+	0x67, 0x74, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a,
+	0x0a, 0x5e, 0x2e, 0x7b, 0x30, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x0a, 0x65, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x06, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2f, 0x0a, 0x07, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12,
+	0x24, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x07, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x73, 0x22, 0x5a, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e,
+	0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x5a, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x1d, 0x0a, 0x04, 0x64, 0x6f,
+	0x63, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44,
+	0x6f, 0x63, 0x73, 0x52, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x12, 0x32, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xc2, 0x06,
+	0x0a, 0x05, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x70, 0x65, 0x6e, 0x5f,
+	0x69, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x49, 0x64, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x74, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6c, 0x73,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6c, 0x73, 0x4b,
+	0x65, 0x79, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x70, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x70, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x2f,
+	0x0a, 0x13, 0x70, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70, 0x6c, 0x61,
+	0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b,
+	0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0e, 0x64, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x73, 0x12,
+	0x2d, 0x0a, 0x12, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x27,
+	0x0a, 0x0f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x63, 0x61, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x63,
+	0x61, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78,
+	0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x12, 0x30, 0x0a, 0x14, 0x73, 0x6c, 0x6f, 0x77, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x73, 0x6c, 0x6f, 0x77, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x22, 0xdc, 0x01, 0x0a, 0x0e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x12, 0x1c, 0x0a, 0x04, 0x72, 0x65, 0x66, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x04, 0x72, 0x65, 0x66, 0x73, 0x12,
+	0x24, 0x0a, 0x08, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x08, 0x63, 0x61, 0x73,
+	0x63, 0x61, 0x64, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x1d,
+	0x0a, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x73, 0x52, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x12, 0x32, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x1f, 0x0a, 0x07, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x1e, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x0c, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32,
+	0x32, 0x35, 0x7d, 0x24, 0x52, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d,
+	0x24, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x65, 0x65, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x65, 0x65,
+	0x6b, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0x63, 0x0a, 0x04, 0x45, 0x64, 0x69, 0x74, 0x12, 0x22, 0x0a, 0x03, 0x72, 0x65, 0x66,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66,
+	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x37, 0x0a,
+	0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x6a, 0x0a, 0x0a, 0x45, 0x64, 0x69, 0x74, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x09, 0x44, 0x6f, 0x63, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74,
+	0x12, 0x22, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52,
+	0x03, 0x72, 0x65, 0x66, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x30, 0x0a,
+	0x0a, 0x44, 0x6f, 0x63, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x64,
+	0x6f, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x44, 0x6f, 0x63, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x52, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x22,
+	0xf3, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70,
+	0x73, 0x65, 0x72, 0x74, 0x12, 0x22, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x42, 0x06, 0xe2, 0xdf, 0x1f,
+	0x02, 0x20, 0x01, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x65, 0x66, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x20, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20,
+	0x01, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4c, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x54, 0x0a, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x24, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d,
+	0x24, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x20, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c,
+	0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x6f, 0x0a, 0x12, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12,
+	0x26, 0x0a, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10,
+	0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24,
+	0x52, 0x05, 0x67, 0x74, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xe2, 0xdf, 0x1f,
+	0x0d, 0x0a, 0x0b, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x31, 0x30, 0x30, 0x30, 0x7d, 0x24, 0x52, 0x0a,
+	0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xcf, 0x02, 0x0a, 0x09, 0x4d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x10,
+	0x00, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x0b,
+	0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x3e,
+	0x0a, 0x12, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x11, 0x72, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e,
+	0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x64, 0x6f, 0x63, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52,
+	0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x4c,
+	0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
+	0x6d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xae, 0x02, 0x0a,
+	0x0f, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x2c, 0x0a, 0x09, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x49, 0x0a,
+	0x11, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x65, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x69, 0x67,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x6d, 0x69,
+	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xc2, 0x01, 0x0a, 0x08, 0x53, 0x75, 0x62,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x12, 0x25, 0x0a, 0x0a, 0x64, 0x6f, 0x63, 0x5f, 0x67, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x60,
+	0x01, 0x52, 0x09, 0x64, 0x6f, 0x63, 0x47, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x64, 0x6f, 0x63, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x6f, 0x63, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x67, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x06,
+	0xe2, 0xdf, 0x1f, 0x02, 0x60, 0x01, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x47, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xca, 0x02,
+	0x0a, 0x0c, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x4a, 0x6f, 0x62, 0x12, 0x35,
+	0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75,
+	0x62, 0x67, 0x72, 0x61, 0x70, 0x68, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x08,
+	0x73, 0x75, 0x62, 0x67, 0x72, 0x61, 0x70, 0x68, 0x12, 0x2c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x64, 0x61, 0x6d, 0x70, 0x69, 0x6e, 0x67, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x16, 0xe2, 0xdf, 0x1f, 0x12, 0x49, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x51, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x52, 0x07, 0x64,
+	0x61, 0x6d, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
+	0x6d, 0x61, 0x78, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a,
+	0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x42, 0x0d, 0xe2, 0xdf, 0x1f, 0x09, 0x49, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52,
+	0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x30, 0x0a, 0x0c, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x66, 0x12, 0x20, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e,
+	0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x02, 0x69, 0x64, 0x22, 0xb4, 0x04, 0x0a,
+	0x0f, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x23, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x4a, 0x6f, 0x62,
+	0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x23, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68,
+	0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x6f, 0x63, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x64, 0x6f, 0x63, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x0a,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x61, 0x72,
+	0x69, 0x74, 0x79, 0x22, 0x2f, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x22, 0x3d, 0x0a, 0x11, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x6a, 0x6f, 0x62,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x04, 0x6a,
+	0x6f, 0x62, 0x73, 0x22, 0x43, 0x0a, 0x0f, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72,
+	0x65, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x20, 0x0a, 0x04, 0x50, 0x6f, 0x6e, 0x67,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x72, 0x0a, 0x0f, 0x4f, 0x75,
+	0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10,
+	0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xfc,
+	0x01, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f,
+	0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x06, 0xe2,
+	0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x66, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x12, 0x40, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x28, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c,
+	0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0xe1, 0x01,
+	0x0a, 0x06, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x12, 0x32, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x73, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x65, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x0a, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x07, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x73, 0x22, 0x2c, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0xce, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f,
+	0x0c, 0x0a, 0x0a, 0x5e, 0x2e, 0x7b, 0x31, 0x2c, 0x32, 0x32, 0x35, 0x7d, 0x24, 0x52, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x42, 0x06, 0xe2,
+	0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02,
+	0x20, 0x01, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x31, 0x0a,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2a, 0x1d, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x07, 0x0a,
+	0x03, 0x42, 0x46, 0x53, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x46, 0x53, 0x10, 0x01, 0x2a,
+	0x26, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x07, 0x0a, 0x03,
+	0x4f, 0x55, 0x54, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x08, 0x0a,
+	0x04, 0x42, 0x4f, 0x54, 0x48, 0x10, 0x02, 0x2a, 0x40, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x00,
+	0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x01, 0x12, 0x0a,
+	0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41,
+	0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x44, 0x0a, 0x0e, 0x4e, 0x65, 0x69,
+	0x67, 0x68, 0x62, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x10, 0x43,
+	0x4f, 0x4d, 0x4d, 0x4f, 0x4e, 0x5f, 0x4e, 0x45, 0x49, 0x47, 0x48, 0x42, 0x4f, 0x52, 0x53, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4a, 0x41, 0x43, 0x43, 0x41, 0x52, 0x44, 0x10, 0x01, 0x12, 0x0f,
+	0x0a, 0x0b, 0x41, 0x44, 0x41, 0x4d, 0x49, 0x43, 0x5f, 0x41, 0x44, 0x41, 0x52, 0x10, 0x02, 0x2a,
+	0x78, 0x0a, 0x12, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x41, 0x47, 0x45, 0x52, 0x41, 0x4e,
+	0x4b, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x47, 0x52, 0x45, 0x45, 0x10, 0x01, 0x12,
+	0x0f, 0x0a, 0x0b, 0x42, 0x45, 0x54, 0x57, 0x45, 0x45, 0x4e, 0x4e, 0x45, 0x53, 0x53, 0x10, 0x02,
+	0x12, 0x13, 0x0a, 0x0f, 0x57, 0x45, 0x41, 0x4b, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45,
+	0x4e, 0x54, 0x53, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x52, 0x4f, 0x4e, 0x47, 0x5f,
+	0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07,
+	0x4c, 0x4f, 0x55, 0x56, 0x41, 0x49, 0x4e, 0x10, 0x05, 0x2a, 0x83, 0x01, 0x0a, 0x09, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x4f, 0x55, 0x4e, 0x54,
+	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x55, 0x4d, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x41,
+	0x56, 0x47, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x41, 0x58, 0x10, 0x03, 0x12, 0x07, 0x0a,
+	0x03, 0x4d, 0x49, 0x4e, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x52, 0x4f, 0x44, 0x10, 0x05,
+	0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x44, 0x49, 0x53, 0x54, 0x49, 0x4e,
+	0x43, 0x54, 0x10, 0x06, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x49,
+	0x4c, 0x45, 0x10, 0x07, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x44, 0x44, 0x45, 0x56, 0x10, 0x08,
+	0x12, 0x0d, 0x0a, 0x09, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x47, 0x52, 0x41, 0x4d, 0x10, 0x09, 0x2a,
+	0x4a, 0x0a, 0x09, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x0a,
+	0x45, 0x58, 0x50, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09,
+	0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x47,
+	0x45, 0x4f, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x10, 0x03,
+	0x12, 0x09, 0x0a, 0x05, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x04, 0x2a, 0x2b, 0x0a, 0x0c, 0x56,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x0a, 0x0a, 0x06, 0x43,
+	0x4f, 0x53, 0x49, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x4f, 0x54, 0x10, 0x01,
+	0x12, 0x06, 0x0a, 0x02, 0x4c, 0x32, 0x10, 0x02, 0x32, 0x88, 0x1d, 0x0a, 0x0f, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x04,
+	0x50, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x09, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x50, 0x6f, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x00, 0x12, 0x3c, 0x0a,
+	0x0e, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x73, 0x12,
+	0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72,
+	0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0a, 0x53,
+	0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x42, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x02, 0x4d, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x22, 0x00, 0x12,
+	0x2c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x12, 0x13, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f,
+	0x72, 0x1a, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x22, 0x00, 0x12, 0x2f, 0x0a,
+	0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x14, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x44, 0x6f, 0x63, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72,
+	0x73, 0x1a, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x73, 0x22, 0x00, 0x12, 0x27,
+	0x0a, 0x09, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x44, 0x6f, 0x63, 0x12, 0x0e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x44, 0x6f, 0x63, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x44, 0x6f, 0x63, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x0a, 0x55, 0x70, 0x73, 0x65, 0x72,
+	0x74, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x55,
+	0x70, 0x73, 0x65, 0x72, 0x74, 0x73, 0x1a, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63,
+	0x73, 0x22, 0x00, 0x12, 0x1e, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x63, 0x12, 0x08, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x1a, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f,
+	0x63, 0x22, 0x00, 0x12, 0x26, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x6f, 0x63,
+	0x73, 0x12, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x09,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x73, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0a, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x65, 0x78, 0x74, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x54, 0x65, 0x78, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x1a, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x64, 0x44, 0x6f, 0x63,
+	0x73, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x47, 0x65, 0x6f,
+	0x12, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x6f, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x1a, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x6f, 0x44, 0x6f, 0x63, 0x73, 0x22, 0x00,
+	0x12, 0x37, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61,
+	0x72, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x69, 0x6d, 0x69,
+	0x6c, 0x61, 0x72, 0x44, 0x6f, 0x63, 0x73, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x10, 0x53, 0x69, 0x6d,
+	0x69, 0x6c, 0x61, 0x72, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x12, 0x13, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x1a, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f,
+	0x72, 0x44, 0x6f, 0x63, 0x73, 0x22, 0x00, 0x12, 0x27, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x1a, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x32, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x13, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x1a, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x6c, 0x73, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x72,
+	0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x0e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x31, 0x0a, 0x07, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x10,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x00, 0x12, 0x36, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4d, 0x65,
+	0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4d,
+	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72,
+	0x61, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x73, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x0c, 0x53, 0x68,
+	0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x0f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x50, 0x61, 0x74, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x0a, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x08, 0x41, 0x6c, 0x6c,
+	0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x6c, 0x6c, 0x50,
+	0x61, 0x74, 0x68, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x0a, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0x00, 0x12, 0x29, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x1a, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x69, 0x63,
+	0x61, 0x6c, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x70,
+	0x6f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x1a, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67,
+	0x69, 0x63, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x45,
+	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x75, 0x62, 0x67, 0x72, 0x61, 0x70, 0x68, 0x12, 0x12,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x1a, 0x0a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x22, 0x00,
+	0x12, 0x20, 0x0a, 0x07, 0x45, 0x64, 0x69, 0x74, 0x44, 0x6f, 0x63, 0x12, 0x09, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x1a, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63,
+	0x22, 0x00, 0x12, 0x32, 0x0a, 0x08, 0x45, 0x64, 0x69, 0x74, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x0f,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a,
+	0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x44, 0x6f, 0x63,
+	0x12, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x07, 0x44, 0x65, 0x6c, 0x44, 0x6f, 0x63, 0x73, 0x12,
+	0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x13, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x09, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x44, 0x6f, 0x63,
+	0x12, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x1a, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61,
+	0x6e, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x10, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x0c, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x00, 0x12, 0x22, 0x0a, 0x06, 0x48, 0x61,
+	0x73, 0x44, 0x6f, 0x63, 0x12, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x1a, 0x0c,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x00, 0x12, 0x29,
+	0x0a, 0x0d, 0x48, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x1a, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x10, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x11,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x1a, 0x10,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x00, 0x12, 0x3c, 0x0a, 0x10, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x1a, 0x0f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00,
+	0x12, 0x3f, 0x0a, 0x11, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x73, 0x1a, 0x10, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x00, 0x12, 0x40, 0x0a, 0x10, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a,
+	0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6e, 0x64,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x52, 0x65, 0x66, 0x1a, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0b, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x35, 0x0a,
+	0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a,
+	0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x2e, 0x0a, 0x0e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x64, 0x69,
+	0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0f, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x64,
+	0x69, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d,
+	0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12,
+	0x33, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x1a, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0f, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x12, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x1a, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x54, 0x6f, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x10, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x2d,
+	0x0a, 0x09, 0x47, 0x65, 0x74, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x12, 0x11, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x0b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a,
+	0x0d, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x0e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x67, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x0b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x00, 0x12, 0x35, 0x0a,
+	0x14, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x67, 0x67, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x12, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x44, 0x6f, 0x63, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x67, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a,
+	0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x67, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22,
+	0x00, 0x12, 0x42, 0x0a, 0x19, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x13,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x67, 0x67, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x67, 0x67, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x09, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x2d, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x11, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a,
+	0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x3a, 0x0a, 0x13, 0x50, 0x75, 0x73, 0x68, 0x44, 0x6f, 0x63, 0x43, 0x6f, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44,
+	0x6f, 0x63, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x08, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x4f, 0x0a,
+	0x1a, 0x50, 0x75, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x30,
+	0x0a, 0x08, 0x53, 0x65, 0x65, 0x64, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x08, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x44, 0x6f, 0x63, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x28, 0x01,
+	0x12, 0x3e, 0x0a, 0x0f, 0x53, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x28, 0x01,
+	0x12, 0x3d, 0x0a, 0x09, 0x52, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x31, 0x0a, 0x07, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x00, 0x12, 0x41, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x65, 0x73, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0c, 0x52, 0x75, 0x6e, 0x41, 0x6e, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x6e, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x4a, 0x6f, 0x62, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00,
+	0x12, 0x39, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x12, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x52, 0x65, 0x66, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0d, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3c,
+	0x0a, 0x0f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x12, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x52, 0x65, 0x66, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x16,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x6e, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x66, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x00, 0x30, 0x01, 0x42, 0x07, 0x5a, 0x05, 0x61, 0x70, 0x69, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_graphik_proto_rawDescOnce sync.Once
+	file_graphik_proto_rawDescData = file_graphik_proto_rawDesc
+)
+
+func file_graphik_proto_rawDescGZIP() []byte {
+	file_graphik_proto_rawDescOnce.Do(func() {
+		file_graphik_proto_rawDescData = protoimpl.X.CompressGZIP(file_graphik_proto_rawDescData)
+	})
+	return file_graphik_proto_rawDescData
+}
+
+var file_graphik_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_graphik_proto_msgTypes = make([]protoimpl.MessageInfo, 102)
+var file_graphik_proto_goTypes = []interface{}{
+	(Algorithm)(0),                 // 0: api.Algorithm
+	(Direction)(0),                 // 1: api.Direction
+	(JobState)(0),                  // 2: api.JobState
+	(NeighborMetric)(0),            // 3: api.NeighborMetric
+	(AnalyticsAlgorithm)(0),        // 4: api.AnalyticsAlgorithm
+	(Aggregate)(0),                 // 5: api.Aggregate
+	(IndexKind)(0),                 // 6: api.IndexKind
+	(VectorMetric)(0),              // 7: api.VectorMetric
+	(*Ref)(nil),                    // 8: api.Ref
+	(*RefConstructor)(nil),         // 9: api.RefConstructor
+	(*Refs)(nil),                   // 10: api.Refs
+	(*Doc)(nil),                    // 11: api.Doc
+	(*DocConstructor)(nil),         // 12: api.DocConstructor
+	(*DocConstructors)(nil),        // 13: api.DocConstructors
+	(*Traversal)(nil),              // 14: api.Traversal
+	(*Traversals)(nil),             // 15: api.Traversals
+	(*PathFilter)(nil),             // 16: api.PathFilter
+	(*AllPathsFilter)(nil),         // 17: api.AllPathsFilter
+	(*Path)(nil),                   // 18: api.Path
+	(*Paths)(nil),                  // 19: api.Paths
+	(*NodePattern)(nil),            // 20: api.NodePattern
+	(*EdgePattern)(nil),            // 21: api.EdgePattern
+	(*MatchFilter)(nil),            // 22: api.MatchFilter
+	(*Match)(nil),                  // 23: api.Match
+	(*Matches)(nil),                // 24: api.Matches
+	(*ExtractFilter)(nil),          // 25: api.ExtractFilter
+	(*TopologicalSortFilter)(nil),  // 26: api.TopologicalSortFilter
+	(*TopologicalOrder)(nil),       // 27: api.TopologicalOrder
+	(*Docs)(nil),                   // 28: api.Docs
+	(*Connection)(nil),             // 29: api.Connection
+	(*ConnectionConstructor)(nil),  // 30: api.ConnectionConstructor
+	(*SearchConnectFilter)(nil),    // 31: api.SearchConnectFilter
+	(*SearchConnectMeFilter)(nil),  // 32: api.SearchConnectMeFilter
+	(*ConnectionConstructors)(nil), // 33: api.ConnectionConstructors
+	(*Connections)(nil),            // 34: api.Connections
+	(*ConnectFilter)(nil),          // 35: api.ConnectFilter
+	(*DegreeFilter)(nil),           // 36: api.DegreeFilter
+	(*GtypeDegree)(nil),            // 37: api.GtypeDegree
+	(*Degree)(nil),                 // 38: api.Degree
+	(*Filter)(nil),                 // 39: api.Filter
+	(*Budget)(nil),                 // 40: api.Budget
+	(*TextSearchFilter)(nil),       // 41: api.TextSearchFilter
+	(*ScoredDoc)(nil),              // 42: api.ScoredDoc
+	(*ScoredDocs)(nil),             // 43: api.ScoredDocs
+	(*GeoPoint)(nil),               // 44: api.GeoPoint
+	(*GeoRadius)(nil),              // 45: api.GeoRadius
+	(*GeoBox)(nil),                 // 46: api.GeoBox
+	(*GeoFilter)(nil),              // 47: api.GeoFilter
+	(*GeoDoc)(nil),                 // 48: api.GeoDoc
+	(*GeoDocs)(nil),                // 49: api.GeoDocs
+	(*ExplainFilter)(nil),          // 50: api.ExplainFilter
+	(*ExplainCandidate)(nil),       // 51: api.ExplainCandidate
+	(*ExplainError)(nil),           // 52: api.ExplainError
+	(*ExplainPhase)(nil),           // 53: api.ExplainPhase
+	(*Explanation)(nil),            // 54: api.Explanation
+	(*SimilarFilter)(nil),          // 55: api.SimilarFilter
+	(*NeighborFilter)(nil),         // 56: api.NeighborFilter
+	(*NeighborDoc)(nil),            // 57: api.NeighborDoc
+	(*NeighborDocs)(nil),           // 58: api.NeighborDocs
+	(*SimilarDoc)(nil),             // 59: api.SimilarDoc
+	(*SimilarDocs)(nil),            // 60: api.SimilarDocs
+	(*AggFilter)(nil),              // 61: api.AggFilter
+	(*AggField)(nil),               // 62: api.AggField
+	(*GroupAggFilter)(nil),         // 63: api.GroupAggFilter
+	(*AggValue)(nil),               // 64: api.AggValue
+	(*HistogramBucket)(nil),        // 65: api.HistogramBucket
+	(*AggGroup)(nil),               // 66: api.AggGroup
+	(*AggGroups)(nil),              // 67: api.AggGroups
+	(*TraverseFilter)(nil),         // 68: api.TraverseFilter
+	(*TraverseMeFilter)(nil),       // 69: api.TraverseMeFilter
+	(*IndexConstructor)(nil),       // 70: api.IndexConstructor
+	(*Authorizer)(nil),             // 71: api.Authorizer
+	(*Authorizers)(nil),            // 72: api.Authorizers
+	(*TypeValidator)(nil),          // 73: api.TypeValidator
+	(*TypeValidators)(nil),         // 74: api.TypeValidators
+	(*Index)(nil),                  // 75: api.Index
+	(*Indexes)(nil),                // 76: api.Indexes
+	(*StreamFilter)(nil),           // 77: api.StreamFilter
+	(*Graph)(nil),                  // 78: api.Graph
+	(*Flags)(nil),                  // 79: api.Flags
+	(*MutationResult)(nil),         // 80: api.MutationResult
+	(*Boolean)(nil),                // 81: api.Boolean
+	(*Number)(nil),                 // 82: api.Number
+	(*ExistsFilter)(nil),           // 83: api.ExistsFilter
+	(*Edit)(nil),                   // 84: api.Edit
+	(*EditFilter)(nil),             // 85: api.EditFilter
+	(*DocUpsert)(nil),              // 86: api.DocUpsert
+	(*DocUpserts)(nil),             // 87: api.DocUpserts
+	(*ConnectionUpsert)(nil),       // 88: api.ConnectionUpsert
+	(*ConnectionUpserts)(nil),      // 89: api.ConnectionUpserts
+	(*TypeRename)(nil),             // 90: api.TypeRename
+	(*AttributeTransform)(nil),     // 91: api.AttributeTransform
+	(*Migration)(nil),              // 92: api.Migration
+	(*MigrationStatus)(nil),        // 93: api.MigrationStatus
+	(*MigrationStatuses)(nil),      // 94: api.MigrationStatuses
+	(*Subgraph)(nil),               // 95: api.Subgraph
+	(*AnalyticsJob)(nil),           // 96: api.AnalyticsJob
+	(*AnalyticsRef)(nil),           // 97: api.AnalyticsRef
+	(*AnalyticsStatus)(nil),        // 98: api.AnalyticsStatus
+	(*Component)(nil),              // 99: api.Component
+	(*AnalyticsStatuses)(nil),      // 100: api.AnalyticsStatuses
+	(*AnalyticsResult)(nil),        // 101: api.AnalyticsResult
+	(*Pong)(nil),                   // 102: api.Pong
+	(*OutboundMessage)(nil),        // 103: api.OutboundMessage
+	(*Message)(nil),                // 104: api.Message
+	(*Schema)(nil),                 // 105: api.Schema
+	(*ExprFilter)(nil),             // 106: api.ExprFilter
+	(*Request)(nil),                // 107: api.Request
+	nil,                            // 108: api.Match.DocsEntry
+	nil,                            // 109: api.Match.ConnectionsEntry
+	(*_struct.Struct)(nil),         // 110: google.protobuf.Struct
+	(*duration.Duration)(nil),      // 111: google.protobuf.Duration
+	(*timestamp.Timestamp)(nil),    // 112: google.protobuf.Timestamp
+	(*empty.Empty)(nil),            // 113: google.protobuf.Empty
+}
+var file_graphik_proto_depIdxs = []int32{
+	8,   // 0: api.Refs.refs:type_name -> api.Ref
+	8,   // 1: api.Doc.ref:type_name -> api.Ref
+	110, // 2: api.Doc.attributes:type_name -> google.protobuf.Struct
+	9,   // 3: api.DocConstructor.ref:type_name -> api.RefConstructor
+	110, // 4: api.DocConstructor.attributes:type_name -> google.protobuf.Struct
+	12,  // 5: api.DocConstructors.docs:type_name -> api.DocConstructor
+	11,  // 6: api.Traversal.doc:type_name -> api.Doc
+	8,   // 7: api.Traversal.traversal_path:type_name -> api.Ref
+	14,  // 8: api.Traversals.traversals:type_name -> api.Traversal
+	8,   // 9: api.PathFilter.source:type_name -> api.Ref
+	8,   // 10: api.PathFilter.target:type_name -> api.Ref
+	40,  // 11: api.PathFilter.budget:type_name -> api.Budget
+	8,   // 12: api.AllPathsFilter.source:type_name -> api.Ref
+	8,   // 13: api.AllPathsFilter.target:type_name -> api.Ref
+	40,  // 14: api.AllPathsFilter.budget:type_name -> api.Budget
+	11,  // 15: api.Path.docs:type_name -> api.Doc
+	29,  // 16: api.Path.connections:type_name -> api.Connection
+	18,  // 17: api.Paths.paths:type_name -> api.Path
+	8,   // 18: api.NodePattern.ref:type_name -> api.Ref
+	1,   // 19: api.EdgePattern.direction:type_name -> api.Direction
+	20,  // 20: api.MatchFilter.nodes:type_name -> api.NodePattern
+	21,  // 21: api.MatchFilter.edges:type_name -> api.EdgePattern
+	40,  // 22: api.MatchFilter.budget:type_name -> api.Budget
+	108, // 23: api.Match.docs:type_name -> api.Match.DocsEntry
+	109, // 24: api.Match.connections:type_name -> api.Match.ConnectionsEntry
+	23,  // 25: api.Matches.matches:type_name -> api.Match
+	8,   // 26: api.ExtractFilter.roots:type_name -> api.Ref
+	1,   // 27: api.ExtractFilter.direction:type_name -> api.Direction
+	40,  // 28: api.ExtractFilter.budget:type_name -> api.Budget
+	40,  // 29: api.TopologicalSortFilter.budget:type_name -> api.Budget
+	8,   // 30: api.TopologicalOrder.refs:type_name -> api.Ref
+	18,  // 31: api.TopologicalOrder.cycles:type_name -> api.Path
+	11,  // 32: api.Docs.docs:type_name -> api.Doc
+	8,   // 33: api.Connection.ref:type_name -> api.Ref
+	110, // 34: api.Connection.attributes:type_name -> google.protobuf.Struct
+	8,   // 35: api.Connection.from:type_name -> api.Ref
+	8,   // 36: api.Connection.to:type_name -> api.Ref
+	9,   // 37: api.ConnectionConstructor.ref:type_name -> api.RefConstructor
+	110, // 38: api.ConnectionConstructor.attributes:type_name -> google.protobuf.Struct
+	8,   // 39: api.ConnectionConstructor.from:type_name -> api.Ref
+	8,   // 40: api.ConnectionConstructor.to:type_name -> api.Ref
+	39,  // 41: api.SearchConnectFilter.filter:type_name -> api.Filter
+	110, // 42: api.SearchConnectFilter.attributes:type_name -> google.protobuf.Struct
+	8,   // 43: api.SearchConnectFilter.from:type_name -> api.Ref
+	39,  // 44: api.SearchConnectMeFilter.filter:type_name -> api.Filter
+	110, // 45: api.SearchConnectMeFilter.attributes:type_name -> google.protobuf.Struct
+	30,  // 46: api.ConnectionConstructors.connections:type_name -> api.ConnectionConstructor
+	29,  // 47: api.Connections.connections:type_name -> api.Connection
+	8,   // 48: api.ConnectFilter.doc_ref:type_name -> api.Ref
+	1,   // 49: api.ConnectFilter.direction:type_name -> api.Direction
+	8,   // 50: api.DegreeFilter.doc:type_name -> api.Ref
+	8,   // 51: api.Degree.doc:type_name -> api.Ref
+	37,  // 52: api.Degree.gtypes:type_name -> api.GtypeDegree
+	40,  // 53: api.Filter.budget:type_name -> api.Budget
+	111, // 54: api.Budget.timeout:type_name -> google.protobuf.Duration
+	11,  // 55: api.ScoredDoc.doc:type_name -> api.Doc
+	42,  // 56: api.ScoredDocs.docs:type_name -> api.ScoredDoc
+	44,  // 57: api.GeoRadius.center:type_name -> api.GeoPoint
+	44,  // 58: api.GeoBox.min:type_name -> api.GeoPoint
+	44,  // 59: api.GeoBox.max:type_name -> api.GeoPoint
+	45,  // 60: api.GeoFilter.radius:type_name -> api.GeoRadius
+	46,  // 61: api.GeoFilter.box:type_name -> api.GeoBox
+	44,  // 62: api.GeoFilter.nearest:type_name -> api.GeoPoint
+	68,  // 63: api.GeoFilter.traverse:type_name -> api.TraverseFilter
+	11,  // 64: api.GeoDoc.doc:type_name -> api.Doc
+	48,  // 65: api.GeoDocs.docs:type_name -> api.GeoDoc
+	39,  // 66: api.ExplainFilter.search_docs:type_name -> api.Filter
+	39,  // 67: api.ExplainFilter.search_connections:type_name -> api.Filter
+	68,  // 68: api.ExplainFilter.traverse:type_name -> api.TraverseFilter
+	111, // 69: api.ExplainPhase.duration:type_name -> google.protobuf.Duration
+	51,  // 70: api.Explanation.candidates:type_name -> api.ExplainCandidate
+	52,  // 71: api.Explanation.errors:type_name -> api.ExplainError
+	53,  // 72: api.Explanation.phases:type_name -> api.ExplainPhase
+	111, // 73: api.Explanation.duration:type_name -> google.protobuf.Duration
+	8,   // 74: api.SimilarFilter.like:type_name -> api.Ref
+	68,  // 75: api.SimilarFilter.traverse:type_name -> api.TraverseFilter
+	8,   // 76: api.NeighborFilter.doc:type_name -> api.Ref
+	1,   // 77: api.NeighborFilter.direction:type_name -> api.Direction
+	3,   // 78: api.NeighborFilter.metric:type_name -> api.NeighborMetric
+	40,  // 79: api.NeighborFilter.budget:type_name -> api.Budget
+	11,  // 80: api.NeighborDoc.doc:type_name -> api.Doc
+	57,  // 81: api.NeighborDocs.docs:type_name -> api.NeighborDoc
+	11,  // 82: api.SimilarDoc.doc:type_name -> api.Doc
+	59,  // 83: api.SimilarDocs.docs:type_name -> api.SimilarDoc
+	39,  // 84: api.AggFilter.filter:type_name -> api.Filter
+	5,   // 85: api.AggFilter.aggregate:type_name -> api.Aggregate
+	5,   // 86: api.AggField.aggregate:type_name -> api.Aggregate
+	39,  // 87: api.GroupAggFilter.filter:type_name -> api.Filter
+	62,  // 88: api.GroupAggFilter.aggregates:type_name -> api.AggField
+	5,   // 89: api.AggValue.aggregate:type_name -> api.Aggregate
+	65,  // 90: api.AggValue.buckets:type_name -> api.HistogramBucket
+	110, // 91: api.AggGroup.key:type_name -> google.protobuf.Struct
+	64,  // 92: api.AggGroup.values:type_name -> api.AggValue
+	66,  // 93: api.AggGroups.groups:type_name -> api.AggGroup
+	8,   // 94: api.TraverseFilter.root:type_name -> api.Ref
+	0,   // 95: api.TraverseFilter.algorithm:type_name -> api.Algorithm
+	40,  // 96: api.TraverseFilter.budget:type_name -> api.Budget
+	1,   // 97: api.TraverseFilter.direction:type_name -> api.Direction
+	0,   // 98: api.TraverseMeFilter.algorithm:type_name -> api.Algorithm
+	40,  // 99: api.TraverseMeFilter.budget:type_name -> api.Budget
+	1,   // 100: api.TraverseMeFilter.direction:type_name -> api.Direction
+	71,  // 101: api.Authorizers.authorizers:type_name -> api.Authorizer
+	73,  // 102: api.TypeValidators.validators:type_name -> api.TypeValidator
+	6,   // 103: api.Index.kind:type_name -> api.IndexKind
+	7,   // 104: api.Index.metric:type_name -> api.VectorMetric
+	75,  // 105: api.Indexes.indexes:type_name -> api.Index
+	28,  // 106: api.Graph.docs:type_name -> api.Docs
+	34,  // 107: api.Graph.connections:type_name -> api.Connections
+	8,   // 108: api.MutationResult.refs:type_name -> api.Ref
+	8,   // 109: api.MutationResult.cascaded:type_name -> api.Ref
+	28,  // 110: api.MutationResult.docs:type_name -> api.Docs
+	34,  // 111: api.MutationResult.connections:type_name -> api.Connections
+	8,   // 112: api.Edit.ref:type_name -> api.Ref
+	110, // 113: api.Edit.attributes:type_name -> google.protobuf.Struct
+	39,  // 114: api.EditFilter.filter:type_name -> api.Filter
+	110, // 115: api.EditFilter.attributes:type_name -> google.protobuf.Struct
+	8,   // 116: api.DocUpsert.ref:type_name -> api.Ref
+	110, // 117: api.DocUpsert.attributes:type_name -> google.protobuf.Struct
+	86,  // 118: api.DocUpserts.docs:type_name -> api.DocUpsert
+	8,   // 119: api.ConnectionUpsert.ref:type_name -> api.Ref
+	110, // 120: api.ConnectionUpsert.attributes:type_name -> google.protobuf.Struct
+	8,   // 121: api.ConnectionUpsert.from:type_name -> api.Ref
+	8,   // 122: api.ConnectionUpsert.to:type_name -> api.Ref
+	88,  // 123: api.ConnectionUpserts.connections:type_name -> api.ConnectionUpsert
+	90,  // 124: api.Migration.rename_docs:type_name -> api.TypeRename
+	90,  // 125: api.Migration.rename_connections:type_name -> api.TypeRename
+	91,  // 126: api.Migration.transform_docs:type_name -> api.AttributeTransform
+	91,  // 127: api.Migration.transform_connections:type_name -> api.AttributeTransform
+	92,  // 128: api.MigrationStatus.migration:type_name -> api.Migration
+	2,   // 129: api.MigrationStatus.state:type_name -> api.JobState
+	8,   // 130: api.MigrationStatus.user:type_name -> api.Ref
+	112, // 131: api.MigrationStatus.started_at:type_name -> google.protobuf.Timestamp
+	112, // 132: api.MigrationStatus.completed_at:type_name -> google.protobuf.Timestamp
+	93,  // 133: api.MigrationStatuses.migrations:type_name -> api.MigrationStatus
+	4,   // 134: api.AnalyticsJob.algorithm:type_name -> api.AnalyticsAlgorithm
+	95,  // 135: api.AnalyticsJob.subgraph:type_name -> api.Subgraph
+	1,   // 136: api.AnalyticsJob.direction:type_name -> api.Direction
+	96,  // 137: api.AnalyticsStatus.job:type_name -> api.AnalyticsJob
+	2,   // 138: api.AnalyticsStatus.state:type_name -> api.JobState
+	8,   // 139: api.AnalyticsStatus.user:type_name -> api.Ref
+	112, // 140: api.AnalyticsStatus.started_at:type_name -> google.protobuf.Timestamp
+	112, // 141: api.AnalyticsStatus.completed_at:type_name -> google.protobuf.Timestamp
+	99,  // 142: api.AnalyticsStatus.components:type_name -> api.Component
+	98,  // 143: api.AnalyticsStatuses.jobs:type_name -> api.AnalyticsStatus
+	8,   // 144: api.AnalyticsResult.ref:type_name -> api.Ref
+	110, // 145: api.OutboundMessage.data:type_name -> google.protobuf.Struct
+	110, // 146: api.Message.data:type_name -> google.protobuf.Struct
+	8,   // 147: api.Message.user:type_name -> api.Ref
+	112, // 148: api.Message.timestamp:type_name -> google.protobuf.Timestamp
+	72,  // 149: api.Schema.authorizers:type_name -> api.Authorizers
+	74,  // 150: api.Schema.validators:type_name -> api.TypeValidators
+	76,  // 151: api.Schema.indexes:type_name -> api.Indexes
+	11,  // 152: api.Request.user:type_name -> api.Doc
+	112, // 153: api.Request.timestamp:type_name -> google.protobuf.Timestamp
+	110, // 154: api.Request.request:type_name -> google.protobuf.Struct
+	11,  // 155: api.Match.DocsEntry.value:type_name -> api.Doc
+	34,  // 156: api.Match.ConnectionsEntry.value:type_name -> api.Connections
+	113, // 157: api.DatabaseService.Ping:input_type -> google.protobuf.Empty
+	113, // 158: api.DatabaseService.GetSchema:input_type -> google.protobuf.Empty
+	72,  // 159: api.DatabaseService.SetAuthorizers:input_type -> api.Authorizers
+	76,  // 160: api.DatabaseService.SetIndexes:input_type -> api.Indexes
+	74,  // 161: api.DatabaseService.SetTypeValidators:input_type -> api.TypeValidators
+	113, // 162: api.DatabaseService.Me:input_type -> google.protobuf.Empty
+	12,  // 163: api.DatabaseService.CreateDoc:input_type -> api.DocConstructor
+	13,  // 164: api.DatabaseService.CreateDocs:input_type -> api.DocConstructors
+	86,  // 165: api.DatabaseService.UpsertDoc:input_type -> api.DocUpsert
+	87,  // 166: api.DatabaseService.UpsertDocs:input_type -> api.DocUpserts
+	8,   // 167: api.DatabaseService.GetDoc:input_type -> api.Ref
+	39,  // 168: api.DatabaseService.SearchDocs:input_type -> api.Filter
+	41,  // 169: api.DatabaseService.SearchText:input_type -> api.TextSearchFilter
+	47,  // 170: api.DatabaseService.SearchGeo:input_type -> api.GeoFilter
+	55,  // 171: api.DatabaseService.SearchSimilar:input_type -> api.SimilarFilter
+	56,  // 172: api.DatabaseService.SimilarNeighbors:input_type -> api.NeighborFilter
+	39,  // 173: api.DatabaseService.StreamDocs:input_type -> api.Filter
+	68,  // 174: api.DatabaseService.Traverse:input_type -> api.TraverseFilter
+	68,  // 175: api.DatabaseService.StreamTraverse:input_type -> api.TraverseFilter
+	50,  // 176: api.DatabaseService.Explain:input_type -> api.ExplainFilter
+	69,  // 177: api.DatabaseService.TraverseMe:input_type -> api.TraverseMeFilter
+	16,  // 178: api.DatabaseService.ShortestPath:input_type -> api.PathFilter
+	17,  // 179: api.DatabaseService.AllPaths:input_type -> api.AllPathsFilter
+	22,  // 180: api.DatabaseService.Match:input_type -> api.MatchFilter
+	26,  // 181: api.DatabaseService.TopologicalSort:input_type -> api.TopologicalSortFilter
+	25,  // 182: api.DatabaseService.ExtractSubgraph:input_type -> api.ExtractFilter
+	84,  // 183: api.DatabaseService.EditDoc:input_type -> api.Edit
+	85,  // 184: api.DatabaseService.EditDocs:input_type -> api.EditFilter
+	8,   // 185: api.DatabaseService.DelDoc:input_type -> api.Ref
+	39,  // 186: api.DatabaseService.DelDocs:input_type -> api.Filter
+	83,  // 187: api.DatabaseService.ExistsDoc:input_type -> api.ExistsFilter
+	83,  // 188: api.DatabaseService.ExistsConnection:input_type -> api.ExistsFilter
+	8,   // 189: api.DatabaseService.HasDoc:input_type -> api.Ref
+	8,   // 190: api.DatabaseService.HasConnection:input_type -> api.Ref
+	30,  // 191: api.DatabaseService.CreateConnection:input_type -> api.ConnectionConstructor
+	33,  // 192: api.DatabaseService.CreateConnections:input_type -> api.ConnectionConstructors
+	88,  // 193: api.DatabaseService.UpsertConnection:input_type -> api.ConnectionUpsert
+	89,  // 194: api.DatabaseService.UpsertConnections:input_type -> api.ConnectionUpserts
+	31,  // 195: api.DatabaseService.SearchAndConnect:input_type -> api.SearchConnectFilter
+	32,  // 196: api.DatabaseService.SearchAndConnectMe:input_type -> api.SearchConnectMeFilter
+	8,   // 197: api.DatabaseService.GetConnection:input_type -> api.Ref
+	39,  // 198: api.DatabaseService.SearchConnections:input_type -> api.Filter
+	39,  // 199: api.DatabaseService.StreamConnections:input_type -> api.Filter
+	84,  // 200: api.DatabaseService.EditConnection:input_type -> api.Edit
+	85,  // 201: api.DatabaseService.EditConnections:input_type -> api.EditFilter
+	8,   // 202: api.DatabaseService.DelConnection:input_type -> api.Ref
+	39,  // 203: api.DatabaseService.DelConnections:input_type -> api.Filter
+	35,  // 204: api.DatabaseService.ConnectionsFrom:input_type -> api.ConnectFilter
+	35,  // 205: api.DatabaseService.ConnectionsTo:input_type -> api.ConnectFilter
+	36,  // 206: api.DatabaseService.GetDegree:input_type -> api.DegreeFilter
+	61,  // 207: api.DatabaseService.AggregateDocs:input_type -> api.AggFilter
+	61,  // 208: api.DatabaseService.AggregateConnections:input_type -> api.AggFilter
+	63,  // 209: api.DatabaseService.AggregateDocGroups:input_type -> api.GroupAggFilter
+	63,  // 210: api.DatabaseService.AggregateConnectionGroups:input_type -> api.GroupAggFilter
+	103, // 211: api.DatabaseService.Broadcast:input_type -> api.OutboundMessage
+	77,  // 212: api.DatabaseService.Stream:input_type -> api.StreamFilter
+	12,  // 213: api.DatabaseService.PushDocConstructors:input_type -> api.DocConstructor
+	30,  // 214: api.DatabaseService.PushConnectionConstructors:input_type -> api.ConnectionConstructor
+	11,  // 215: api.DatabaseService.SeedDocs:input_type -> api.Doc
+	29,  // 216: api.DatabaseService.SeedConnections:input_type -> api.Connection
+	113, // 217: api.DatabaseService.ReEncrypt:input_type -> google.protobuf.Empty
+	92,  // 218: api.DatabaseService.Migrate:input_type -> api.Migration
+	113, // 219: api.DatabaseService.GetMigrations:input_type -> google.protobuf.Empty
+	96,  // 220: api.DatabaseService.RunAnalytics:input_type -> api.AnalyticsJob
+	97,  // 221: api.DatabaseService.GetAnalytics:input_type -> api.AnalyticsRef
+	113, // 222: api.DatabaseService.ListAnalytics:input_type -> google.protobuf.Empty
+	97,  // 223: api.DatabaseService.CancelAnalytics:input_type -> api.AnalyticsRef
+	97,  // 224: api.DatabaseService.StreamAnalyticsResults:input_type -> api.AnalyticsRef
+	102, // 225: api.DatabaseService.Ping:output_type -> api.Pong
+	105, // 226: api.DatabaseService.GetSchema:output_type -> api.Schema
+	113, // 227: api.DatabaseService.SetAuthorizers:output_type -> google.protobuf.Empty
+	113, // 228: api.DatabaseService.SetIndexes:output_type -> google.protobuf.Empty
+	113, // 229: api.DatabaseService.SetTypeValidators:output_type -> google.protobuf.Empty
+	11,  // 230: api.DatabaseService.Me:output_type -> api.Doc
+	11,  // 231: api.DatabaseService.CreateDoc:output_type -> api.Doc
+	28,  // 232: api.DatabaseService.CreateDocs:output_type -> api.Docs
+	11,  // 233: api.DatabaseService.UpsertDoc:output_type -> api.Doc
+	28,  // 234: api.DatabaseService.UpsertDocs:output_type -> api.Docs
+	11,  // 235: api.DatabaseService.GetDoc:output_type -> api.Doc
+	28,  // 236: api.DatabaseService.SearchDocs:output_type -> api.Docs
+	43,  // 237: api.DatabaseService.SearchText:output_type -> api.ScoredDocs
+	49,  // 238: api.DatabaseService.SearchGeo:output_type -> api.GeoDocs
+	60,  // 239: api.DatabaseService.SearchSimilar:output_type -> api.SimilarDocs
+	58,  // 240: api.DatabaseService.SimilarNeighbors:output_type -> api.NeighborDocs
+	11,  // 241: api.DatabaseService.StreamDocs:output_type -> api.Doc
+	15,  // 242: api.DatabaseService.Traverse:output_type -> api.Traversals
+	14,  // 243: api.DatabaseService.StreamTraverse:output_type -> api.Traversal
+	54,  // 244: api.DatabaseService.Explain:output_type -> api.Explanation
+	15,  // 245: api.DatabaseService.TraverseMe:output_type -> api.Traversals
+	19,  // 246: api.DatabaseService.ShortestPath:output_type -> api.Paths
+	19,  // 247: api.DatabaseService.AllPaths:output_type -> api.Paths
+	24,  // 248: api.DatabaseService.Match:output_type -> api.Matches
+	27,  // 249: api.DatabaseService.TopologicalSort:output_type -> api.TopologicalOrder
+	78,  // 250: api.DatabaseService.ExtractSubgraph:output_type -> api.Graph
+	11,  // 251: api.DatabaseService.EditDoc:output_type -> api.Doc
+	80,  // 252: api.DatabaseService.EditDocs:output_type -> api.MutationResult
+	113, // 253: api.DatabaseService.DelDoc:output_type -> google.protobuf.Empty
+	80,  // 254: api.DatabaseService.DelDocs:output_type -> api.MutationResult
+	81,  // 255: api.DatabaseService.ExistsDoc:output_type -> api.Boolean
+	81,  // 256: api.DatabaseService.ExistsConnection:output_type -> api.Boolean
+	81,  // 257: api.DatabaseService.HasDoc:output_type -> api.Boolean
+	81,  // 258: api.DatabaseService.HasConnection:output_type -> api.Boolean
+	29,  // 259: api.DatabaseService.CreateConnection:output_type -> api.Connection
+	34,  // 260: api.DatabaseService.CreateConnections:output_type -> api.Connections
+	29,  // 261: api.DatabaseService.UpsertConnection:output_type -> api.Connection
+	34,  // 262: api.DatabaseService.UpsertConnections:output_type -> api.Connections
+	34,  // 263: api.DatabaseService.SearchAndConnect:output_type -> api.Connections
+	34,  // 264: api.DatabaseService.SearchAndConnectMe:output_type -> api.Connections
+	29,  // 265: api.DatabaseService.GetConnection:output_type -> api.Connection
+	34,  // 266: api.DatabaseService.SearchConnections:output_type -> api.Connections
+	29,  // 267: api.DatabaseService.StreamConnections:output_type -> api.Connection
+	29,  // 268: api.DatabaseService.EditConnection:output_type -> api.Connection
+	80,  // 269: api.DatabaseService.EditConnections:output_type -> api.MutationResult
+	113, // 270: api.DatabaseService.DelConnection:output_type -> google.protobuf.Empty
+	80,  // 271: api.DatabaseService.DelConnections:output_type -> api.MutationResult
+	34,  // 272: api.DatabaseService.ConnectionsFrom:output_type -> api.Connections
+	34,  // 273: api.DatabaseService.ConnectionsTo:output_type -> api.Connections
+	38,  // 274: api.DatabaseService.GetDegree:output_type -> api.Degree
+	82,  // 275: api.DatabaseService.AggregateDocs:output_type -> api.Number
+	82,  // 276: api.DatabaseService.AggregateConnections:output_type -> api.Number
+	67,  // 277: api.DatabaseService.AggregateDocGroups:output_type -> api.AggGroups
+	67,  // 278: api.DatabaseService.AggregateConnectionGroups:output_type -> api.AggGroups
+	113, // 279: api.DatabaseService.Broadcast:output_type -> google.protobuf.Empty
+	104, // 280: api.DatabaseService.Stream:output_type -> api.Message
+	11,  // 281: api.DatabaseService.PushDocConstructors:output_type -> api.Doc
+	29,  // 282: api.DatabaseService.PushConnectionConstructors:output_type -> api.Connection
+	113, // 283: api.DatabaseService.SeedDocs:output_type -> google.protobuf.Empty
+	113, // 284: api.DatabaseService.SeedConnections:output_type -> google.protobuf.Empty
+	113, // 285: api.DatabaseService.ReEncrypt:output_type -> google.protobuf.Empty
+	93,  // 286: api.DatabaseService.Migrate:output_type -> api.MigrationStatus
+	94,  // 287: api.DatabaseService.GetMigrations:output_type -> api.MigrationStatuses
+	98,  // 288: api.DatabaseService.RunAnalytics:output_type -> api.AnalyticsStatus
+	98,  // 289: api.DatabaseService.GetAnalytics:output_type -> api.AnalyticsStatus
+	100, // 290: api.DatabaseService.ListAnalytics:output_type -> api.AnalyticsStatuses
+	98,  // 291: api.DatabaseService.CancelAnalytics:output_type -> api.AnalyticsStatus
+	101, // 292: api.DatabaseService.StreamAnalyticsResults:output_type -> api.AnalyticsResult
+	225, // [225:293] is the sub-list for method output_type
+	157, // [157:225] is the sub-list for method input_type
+	157, // [157:157] is the sub-list for extension type_name
+	157, // [157:157] is the sub-list for extension extendee
+	0,   // [0:157] is the sub-list for field type_name
+}
+
+func init() { file_graphik_proto_init() }
+func file_graphik_proto_init() {
+	if File_graphik_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_graphik_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ref); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefConstructor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Refs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Doc); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocConstructor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocConstructors); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Traversal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Traversals); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PathFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllPathsFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Path); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Paths); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodePattern); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EdgePattern); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Match); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Matches); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtractFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopologicalSortFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopologicalOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Docs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Connection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionConstructor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchConnectFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchConnectMeFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionConstructors); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Connections); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DegreeFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GtypeDegree); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Degree); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Budget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TextSearchFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScoredDoc); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScoredDocs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoRadius); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoBox); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoDoc); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoDocs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainCandidate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainPhase); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Explanation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimilarFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NeighborFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NeighborDoc); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NeighborDocs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimilarDoc); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3705,8 +10223,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefConstructor); i {
+		file_graphik_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimilarDocs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3717,8 +10235,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Refs); i {
+		file_graphik_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3729,8 +10247,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Doc); i {
+		file_graphik_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3741,8 +10259,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocConstructor); i {
+		file_graphik_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupAggFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3753,8 +10271,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocConstructors); i {
+		file_graphik_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3765,8 +10283,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Traversal); i {
+		file_graphik_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistogramBucket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3777,8 +10295,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Traversals); i {
+		file_graphik_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggGroup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3789,8 +10307,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Docs); i {
+		file_graphik_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggGroups); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3801,8 +10319,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Connection); i {
+		file_graphik_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraverseFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3813,8 +10331,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConnectionConstructor); i {
+		file_graphik_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraverseMeFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3825,8 +10343,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchConnectFilter); i {
+		file_graphik_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexConstructor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3837,8 +10355,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchConnectMeFilter); i {
+		file_graphik_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Authorizer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3849,8 +10367,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConnectionConstructors); i {
+		file_graphik_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Authorizers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3861,8 +10379,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Connections); i {
+		file_graphik_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TypeValidator); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3873,8 +10391,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConnectFilter); i {
+		file_graphik_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TypeValidators); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3885,8 +10403,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Filter); i {
+		file_graphik_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Index); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3897,8 +10415,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AggFilter); i {
+		file_graphik_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Indexes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3909,8 +10427,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TraverseFilter); i {
+		file_graphik_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3921,8 +10439,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TraverseMeFilter); i {
+		file_graphik_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Graph); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3933,8 +10451,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IndexConstructor); i {
+		file_graphik_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Flags); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3945,8 +10463,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Authorizer); i {
+		file_graphik_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MutationResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3957,8 +10475,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Authorizers); i {
+		file_graphik_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Boolean); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3969,8 +10487,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TypeValidator); i {
+		file_graphik_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Number); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3981,8 +10499,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TypeValidators); i {
+		file_graphik_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExistsFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3993,8 +10511,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Index); i {
+		file_graphik_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Edit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4005,8 +10523,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Indexes); i {
+		file_graphik_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4017,8 +10535,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamFilter); i {
+		file_graphik_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocUpsert); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4029,8 +10547,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Graph); i {
+		file_graphik_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocUpserts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4041,8 +10559,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Flags); i {
+		file_graphik_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionUpsert); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4053,8 +10571,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Boolean); i {
+		file_graphik_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionUpserts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4065,8 +10583,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Number); i {
+		file_graphik_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TypeRename); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4077,8 +10595,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExistsFilter); i {
+		file_graphik_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttributeTransform); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4089,8 +10607,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Edit); i {
+		file_graphik_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Migration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4101,8 +10619,8 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EditFilter); i {
+		file_graphik_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigrationStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4113,7 +10631,103 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_graphik_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigrationStatuses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Subgraph); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalyticsJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalyticsRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalyticsStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Component); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalyticsStatuses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalyticsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphik_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Pong); i {
 			case 0:
 				return &v.state
@@ -4125,7 +10739,7 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_graphik_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OutboundMessage); i {
 			case 0:
 				return &v.state
@@ -4137,7 +10751,7 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_graphik_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Message); i {
 			case 0:
 				return &v.state
@@ -4149,7 +10763,7 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_graphik_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Schema); i {
 			case 0:
 				return &v.state
@@ -4161,7 +10775,7 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_graphik_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExprFilter); i {
 			case 0:
 				return &v.state
@@ -4173,7 +10787,7 @@ func file_graphik_proto_init() {
 				return nil
 			}
 		}
-		file_graphik_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_graphik_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Request); i {
 			case 0:
 				return &v.state
@@ -4186,13 +10800,23 @@ func file_graphik_proto_init() {
 			}
 		}
 	}
+	file_graphik_proto_msgTypes[39].OneofWrappers = []interface{}{
+		(*GeoFilter_Radius)(nil),
+		(*GeoFilter_Box)(nil),
+		(*GeoFilter_Nearest)(nil),
+	}
+	file_graphik_proto_msgTypes[42].OneofWrappers = []interface{}{
+		(*ExplainFilter_SearchDocs)(nil),
+		(*ExplainFilter_SearchConnections)(nil),
+		(*ExplainFilter_Traverse)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_graphik_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   41,
+			NumEnums:      8,
+			NumMessages:   102,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -4232,22 +10856,50 @@ type DatabaseServiceClient interface {
 	CreateDoc(ctx context.Context, in *DocConstructor, opts ...grpc.CallOption) (*Doc, error)
 	// CreateDocs creates a batch of docs in the graph
 	CreateDocs(ctx context.Context, in *DocConstructors, opts ...grpc.CallOption) (*Docs, error)
+	// UpsertDoc creates a doc or merges attributes into it if it already exists
+	UpsertDoc(ctx context.Context, in *DocUpsert, opts ...grpc.CallOption) (*Doc, error)
+	// UpsertDocs creates or merges a batch of docs in a single transaction
+	UpsertDocs(ctx context.Context, in *DocUpserts, opts ...grpc.CallOption) (*Docs, error)
 	// GetDoc gets a single doc in the graph
 	GetDoc(ctx context.Context, in *Ref, opts ...grpc.CallOption) (*Doc, error)
 	// SearchDocs searches the graph for docs
 	SearchDocs(ctx context.Context, in *Filter, opts ...grpc.CallOption) (*Docs, error)
+	// SearchText searches a FULL_TEXT index - returning docs ranked by relevance(BM25)
+	SearchText(ctx context.Context, in *TextSearchFilter, opts ...grpc.CallOption) (*ScoredDocs, error)
+	// SearchGeo searches a GEO index for docs within a radius, within a bounding box, or nearest to a point
+	SearchGeo(ctx context.Context, in *GeoFilter, opts ...grpc.CallOption) (*GeoDocs, error)
+	// SearchSimilar searches a VECTOR index for the docs nearest to a vector
+	SearchSimilar(ctx context.Context, in *SimilarFilter, opts ...grpc.CallOption) (*SimilarDocs, error)
+	// SimilarNeighbors ranks the docs that share neighbors with a doc by the similarity of their neighborhoods ex: people you may know, items bought together
+	SimilarNeighbors(ctx context.Context, in *NeighborFilter, opts ...grpc.CallOption) (*NeighborDocs, error)
+	// StreamDocs streams docs that pass the filter a page at a time as they are read from the graph (sort is not supported)
+	StreamDocs(ctx context.Context, in *Filter, opts ...grpc.CallOption) (DatabaseService_StreamDocsClient, error)
 	// Traverse executes a depth first search of the graph for docs
 	Traverse(ctx context.Context, in *TraverseFilter, opts ...grpc.CallOption) (*Traversals, error)
+	// StreamTraverse streams the docs found by a graph traversal once the traversal has finished
+	StreamTraverse(ctx context.Context, in *TraverseFilter, opts ...grpc.CallOption) (DatabaseService_StreamTraverseClient, error)
+	// Explain executes a search or traversal & returns how it was executed(access path, work done & timings) instead of it's results
+	Explain(ctx context.Context, in *ExplainFilter, opts ...grpc.CallOption) (*Explanation, error)
 	// TraverseMe executes a graph traversal searching for docs related to the currently logged in user
 	TraverseMe(ctx context.Context, in *TraverseMeFilter, opts ...grpc.CallOption) (*Traversals, error)
+	// ShortestPath finds the lowest cost path(s) between two docs - Dijkstra if there's a weight expression, otherwise breadth-first-search
+	ShortestPath(ctx context.Context, in *PathFilter, opts ...grpc.CallOption) (*Paths, error)
+	// AllPaths finds every loopless path between two docs(up to a max depth & max number of paths) - shorter paths are returned first
+	AllPaths(ctx context.Context, in *AllPathsFilter, opts ...grpc.CallOption) (*Paths, error)
+	// Match finds the docs & connections that match a pattern of nodes joined by edges & returns their bindings to the pattern's named variables
+	Match(ctx context.Context, in *MatchFilter, opts ...grpc.CallOption) (*Matches, error)
+	// TopologicalSort orders the docs joined by directed connections of a type so every doc comes before the docs it's connected to - the cycles that prevent an order are returned instead if there are any
+	TopologicalSort(ctx context.Context, in *TopologicalSortFilter, opts ...grpc.CallOption) (*TopologicalOrder, error)
+	// ExtractSubgraph returns the docs within a max depth of one or more root docs & the connections that were traversed to reach them
+	ExtractSubgraph(ctx context.Context, in *ExtractFilter, opts ...grpc.CallOption) (*Graph, error)
 	// EditDoc patches/edits a docs attributes
 	EditDoc(ctx context.Context, in *Edit, opts ...grpc.CallOption) (*Doc, error)
 	// EditDocs patches a batch of docs attributes that pass the patch filter
-	EditDocs(ctx context.Context, in *EditFilter, opts ...grpc.CallOption) (*Docs, error)
+	EditDocs(ctx context.Context, in *EditFilter, opts ...grpc.CallOption) (*MutationResult, error)
 	// DelDoc deletes a doc & all of it's connected connections
 	DelDoc(ctx context.Context, in *Ref, opts ...grpc.CallOption) (*empty.Empty, error)
-	// DelDocs deletes a batch of docs that pass the filter
-	DelDocs(ctx context.Context, in *Filter, opts ...grpc.CallOption) (*empty.Empty, error)
+	// DelDocs deletes a batch of docs that pass the filter & all of their connections
+	DelDocs(ctx context.Context, in *Filter, opts ...grpc.CallOption) (*MutationResult, error)
 	// ExistsDoc searches for a Doc and returns a Boolean indicating if it exists in the graph
 	ExistsDoc(ctx context.Context, in *ExistsFilter, opts ...grpc.CallOption) (*Boolean, error)
 	// ExistsConnection searches for a Connection and returns a Boolean indicating if it exists in the graph
@@ -4260,27 +10912,39 @@ type DatabaseServiceClient interface {
 	CreateConnection(ctx context.Context, in *ConnectionConstructor, opts ...grpc.CallOption) (*Connection, error)
 	// CreateConnections creates a batch of connections in the graph
 	CreateConnections(ctx context.Context, in *ConnectionConstructors, opts ...grpc.CallOption) (*Connections, error)
+	// UpsertConnection creates a connection or merges attributes into it if it already exists
+	UpsertConnection(ctx context.Context, in *ConnectionUpsert, opts ...grpc.CallOption) (*Connection, error)
+	// UpsertConnections creates or merges a batch of connections in a single transaction
+	UpsertConnections(ctx context.Context, in *ConnectionUpserts, opts ...grpc.CallOption) (*Connections, error)
 	SearchAndConnect(ctx context.Context, in *SearchConnectFilter, opts ...grpc.CallOption) (*Connections, error)
 	SearchAndConnectMe(ctx context.Context, in *SearchConnectMeFilter, opts ...grpc.CallOption) (*Connections, error)
 	// GetConnection gets a single connection in the graph
 	GetConnection(ctx context.Context, in *Ref, opts ...grpc.CallOption) (*Connection, error)
 	// SearchConnections searches the graph for connections
 	SearchConnections(ctx context.Context, in *Filter, opts ...grpc.CallOption) (*Connections, error)
+	// StreamConnections streams connections that pass the filter a page at a time as they are read from the graph (sort is not supported)
+	StreamConnections(ctx context.Context, in *Filter, opts ...grpc.CallOption) (DatabaseService_StreamConnectionsClient, error)
 	// EditConnection patches an connections attributes
 	EditConnection(ctx context.Context, in *Edit, opts ...grpc.CallOption) (*Connection, error)
 	// EditConnections patches a batch of connections attributes that pass the patch filter
-	EditConnections(ctx context.Context, in *EditFilter, opts ...grpc.CallOption) (*Connections, error)
+	EditConnections(ctx context.Context, in *EditFilter, opts ...grpc.CallOption) (*MutationResult, error)
 	// DelConnection deletes an connection from the graph
 	DelConnection(ctx context.Context, in *Ref, opts ...grpc.CallOption) (*empty.Empty, error)
 	// DelConnections deletes a batch of connections that pass the filter
-	DelConnections(ctx context.Context, in *Filter, opts ...grpc.CallOption) (*empty.Empty, error)
+	DelConnections(ctx context.Context, in *Filter, opts ...grpc.CallOption) (*MutationResult, error)
 	// ConnectionsFrom returns connections that source from the given doc ref that pass the filter
 	ConnectionsFrom(ctx context.Context, in *ConnectFilter, opts ...grpc.CallOption) (*Connections, error)
 	// ConnectionsTo returns connections that point to the given doc ref that pass the filter
 	ConnectionsTo(ctx context.Context, in *ConnectFilter, opts ...grpc.CallOption) (*Connections, error)
+	// GetDegree returns the number of connections to & from a doc - in total & per connection type
+	GetDegree(ctx context.Context, in *DegreeFilter, opts ...grpc.CallOption) (*Degree, error)
 	// AggregateDocs executes an aggregation function against the graph
 	AggregateDocs(ctx context.Context, in *AggFilter, opts ...grpc.CallOption) (*Number, error)
 	AggregateConnections(ctx context.Context, in *AggFilter, opts ...grpc.CallOption) (*Number, error)
+	// AggregateDocGroups executes one or more aggregation functions against docs that pass the filter - returning one result per group
+	AggregateDocGroups(ctx context.Context, in *GroupAggFilter, opts ...grpc.CallOption) (*AggGroups, error)
+	// AggregateConnectionGroups executes one or more aggregation functions against connections that pass the filter - returning one result per group
+	AggregateConnectionGroups(ctx context.Context, in *GroupAggFilter, opts ...grpc.CallOption) (*AggGroups, error)
 	// Broadcast broadcasts a message to a pubsub channel
 	Broadcast(ctx context.Context, in *OutboundMessage, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Stream subscribes to messages on a pubsub channel
@@ -4289,118 +10953,306 @@ type DatabaseServiceClient interface {
 	PushConnectionConstructors(ctx context.Context, opts ...grpc.CallOption) (DatabaseService_PushConnectionConstructorsClient, error)
 	SeedDocs(ctx context.Context, opts ...grpc.CallOption) (DatabaseService_SeedDocsClient, error)
 	SeedConnections(ctx context.Context, opts ...grpc.CallOption) (DatabaseService_SeedConnectionsClient, error)
+	// ReEncrypt starts a background job that re-encrypts all docs & connections with the current encryption key (root users only)
+	ReEncrypt(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Migrate starts a background job that applies a versioned schema migration (root users only). Each version is applied exactly once
+	Migrate(ctx context.Context, in *Migration, opts ...grpc.CallOption) (*MigrationStatus, error)
+	// GetMigrations returns the status of every migration that has been applied or attempted
+	GetMigrations(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*MigrationStatuses, error)
+	// RunAnalytics starts a background job that runs a graph analytics algorithm over a subgraph (root users only)
+	RunAnalytics(ctx context.Context, in *AnalyticsJob, opts ...grpc.CallOption) (*AnalyticsStatus, error)
+	// GetAnalytics returns the status & progress of an analytics job
+	GetAnalytics(ctx context.Context, in *AnalyticsRef, opts ...grpc.CallOption) (*AnalyticsStatus, error)
+	// ListAnalytics returns the status of every analytics job within the namespace
+	ListAnalytics(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*AnalyticsStatuses, error)
+	// CancelAnalytics cancels a running analytics job (root users only)
+	CancelAnalytics(ctx context.Context, in *AnalyticsRef, opts ...grpc.CallOption) (*AnalyticsStatus, error)
+	// StreamAnalyticsResults streams the results of a completed analytics job in descending order of value
+	StreamAnalyticsResults(ctx context.Context, in *AnalyticsRef, opts ...grpc.CallOption) (DatabaseService_StreamAnalyticsResultsClient, error)
+}
+
+type databaseServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDatabaseServiceClient(cc grpc.ClientConnInterface) DatabaseServiceClient {
+	return &databaseServiceClient{cc}
+}
+
+func (c *databaseServiceClient) Ping(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Pong, error) {
+	out := new(Pong)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) GetSchema(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Schema, error) {
+	out := new(Schema)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/GetSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) SetAuthorizers(ctx context.Context, in *Authorizers, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/SetAuthorizers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) SetIndexes(ctx context.Context, in *Indexes, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/SetIndexes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) SetTypeValidators(ctx context.Context, in *TypeValidators, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/SetTypeValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) Me(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Doc, error) {
+	out := new(Doc)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/Me", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) CreateDoc(ctx context.Context, in *DocConstructor, opts ...grpc.CallOption) (*Doc, error) {
+	out := new(Doc)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/CreateDoc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) CreateDocs(ctx context.Context, in *DocConstructors, opts ...grpc.CallOption) (*Docs, error) {
+	out := new(Docs)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/CreateDocs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) UpsertDoc(ctx context.Context, in *DocUpsert, opts ...grpc.CallOption) (*Doc, error) {
+	out := new(Doc)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/UpsertDoc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type databaseServiceClient struct {
-	cc grpc.ClientConnInterface
+func (c *databaseServiceClient) UpsertDocs(ctx context.Context, in *DocUpserts, opts ...grpc.CallOption) (*Docs, error) {
+	out := new(Docs)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/UpsertDocs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewDatabaseServiceClient(cc grpc.ClientConnInterface) DatabaseServiceClient {
-	return &databaseServiceClient{cc}
+func (c *databaseServiceClient) GetDoc(ctx context.Context, in *Ref, opts ...grpc.CallOption) (*Doc, error) {
+	out := new(Doc)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/GetDoc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *databaseServiceClient) Ping(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Pong, error) {
-	out := new(Pong)
-	err := c.cc.Invoke(ctx, "/api.DatabaseService/Ping", in, out, opts...)
+func (c *databaseServiceClient) SearchDocs(ctx context.Context, in *Filter, opts ...grpc.CallOption) (*Docs, error) {
+	out := new(Docs)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/SearchDocs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *databaseServiceClient) GetSchema(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Schema, error) {
-	out := new(Schema)
-	err := c.cc.Invoke(ctx, "/api.DatabaseService/GetSchema", in, out, opts...)
+func (c *databaseServiceClient) SearchText(ctx context.Context, in *TextSearchFilter, opts ...grpc.CallOption) (*ScoredDocs, error) {
+	out := new(ScoredDocs)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/SearchText", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *databaseServiceClient) SetAuthorizers(ctx context.Context, in *Authorizers, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.DatabaseService/SetAuthorizers", in, out, opts...)
+func (c *databaseServiceClient) SearchGeo(ctx context.Context, in *GeoFilter, opts ...grpc.CallOption) (*GeoDocs, error) {
+	out := new(GeoDocs)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/SearchGeo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *databaseServiceClient) SetIndexes(ctx context.Context, in *Indexes, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.DatabaseService/SetIndexes", in, out, opts...)
+func (c *databaseServiceClient) SearchSimilar(ctx context.Context, in *SimilarFilter, opts ...grpc.CallOption) (*SimilarDocs, error) {
+	out := new(SimilarDocs)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/SearchSimilar", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *databaseServiceClient) SetTypeValidators(ctx context.Context, in *TypeValidators, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.DatabaseService/SetTypeValidators", in, out, opts...)
+func (c *databaseServiceClient) SimilarNeighbors(ctx context.Context, in *NeighborFilter, opts ...grpc.CallOption) (*NeighborDocs, error) {
+	out := new(NeighborDocs)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/SimilarNeighbors", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *databaseServiceClient) Me(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Doc, error) {
-	out := new(Doc)
-	err := c.cc.Invoke(ctx, "/api.DatabaseService/Me", in, out, opts...)
+func (c *databaseServiceClient) StreamDocs(ctx context.Context, in *Filter, opts ...grpc.CallOption) (DatabaseService_StreamDocsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DatabaseService_serviceDesc.Streams[0], "/api.DatabaseService/StreamDocs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &databaseServiceStreamDocsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DatabaseService_StreamDocsClient interface {
+	Recv() (*Doc, error)
+	grpc.ClientStream
+}
+
+type databaseServiceStreamDocsClient struct {
+	grpc.ClientStream
+}
+
+func (x *databaseServiceStreamDocsClient) Recv() (*Doc, error) {
+	m := new(Doc)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *databaseServiceClient) Traverse(ctx context.Context, in *TraverseFilter, opts ...grpc.CallOption) (*Traversals, error) {
+	out := new(Traversals)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/Traverse", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *databaseServiceClient) CreateDoc(ctx context.Context, in *DocConstructor, opts ...grpc.CallOption) (*Doc, error) {
-	out := new(Doc)
-	err := c.cc.Invoke(ctx, "/api.DatabaseService/CreateDoc", in, out, opts...)
+func (c *databaseServiceClient) StreamTraverse(ctx context.Context, in *TraverseFilter, opts ...grpc.CallOption) (DatabaseService_StreamTraverseClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DatabaseService_serviceDesc.Streams[1], "/api.DatabaseService/StreamTraverse", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &databaseServiceStreamTraverseClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DatabaseService_StreamTraverseClient interface {
+	Recv() (*Traversal, error)
+	grpc.ClientStream
+}
+
+type databaseServiceStreamTraverseClient struct {
+	grpc.ClientStream
+}
+
+func (x *databaseServiceStreamTraverseClient) Recv() (*Traversal, error) {
+	m := new(Traversal)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *databaseServiceClient) Explain(ctx context.Context, in *ExplainFilter, opts ...grpc.CallOption) (*Explanation, error) {
+	out := new(Explanation)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/Explain", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *databaseServiceClient) CreateDocs(ctx context.Context, in *DocConstructors, opts ...grpc.CallOption) (*Docs, error) {
-	out := new(Docs)
-	err := c.cc.Invoke(ctx, "/api.DatabaseService/CreateDocs", in, out, opts...)
+func (c *databaseServiceClient) TraverseMe(ctx context.Context, in *TraverseMeFilter, opts ...grpc.CallOption) (*Traversals, error) {
+	out := new(Traversals)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/TraverseMe", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *databaseServiceClient) GetDoc(ctx context.Context, in *Ref, opts ...grpc.CallOption) (*Doc, error) {
-	out := new(Doc)
-	err := c.cc.Invoke(ctx, "/api.DatabaseService/GetDoc", in, out, opts...)
+func (c *databaseServiceClient) ShortestPath(ctx context.Context, in *PathFilter, opts ...grpc.CallOption) (*Paths, error) {
+	out := new(Paths)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/ShortestPath", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *databaseServiceClient) SearchDocs(ctx context.Context, in *Filter, opts ...grpc.CallOption) (*Docs, error) {
-	out := new(Docs)
-	err := c.cc.Invoke(ctx, "/api.DatabaseService/SearchDocs", in, out, opts...)
+func (c *databaseServiceClient) AllPaths(ctx context.Context, in *AllPathsFilter, opts ...grpc.CallOption) (*Paths, error) {
+	out := new(Paths)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/AllPaths", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *databaseServiceClient) Traverse(ctx context.Context, in *TraverseFilter, opts ...grpc.CallOption) (*Traversals, error) {
-	out := new(Traversals)
-	err := c.cc.Invoke(ctx, "/api.DatabaseService/Traverse", in, out, opts...)
+func (c *databaseServiceClient) Match(ctx context.Context, in *MatchFilter, opts ...grpc.CallOption) (*Matches, error) {
+	out := new(Matches)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/Match", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *databaseServiceClient) TraverseMe(ctx context.Context, in *TraverseMeFilter, opts ...grpc.CallOption) (*Traversals, error) {
-	out := new(Traversals)
-	err := c.cc.Invoke(ctx, "/api.DatabaseService/TraverseMe", in, out, opts...)
+func (c *databaseServiceClient) TopologicalSort(ctx context.Context, in *TopologicalSortFilter, opts ...grpc.CallOption) (*TopologicalOrder, error) {
+	out := new(TopologicalOrder)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/TopologicalSort", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) ExtractSubgraph(ctx context.Context, in *ExtractFilter, opts ...grpc.CallOption) (*Graph, error) {
+	out := new(Graph)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/ExtractSubgraph", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4416,8 +11268,8 @@ func (c *databaseServiceClient) EditDoc(ctx context.Context, in *Edit, opts ...g
 	return out, nil
 }
 
-func (c *databaseServiceClient) EditDocs(ctx context.Context, in *EditFilter, opts ...grpc.CallOption) (*Docs, error) {
-	out := new(Docs)
+func (c *databaseServiceClient) EditDocs(ctx context.Context, in *EditFilter, opts ...grpc.CallOption) (*MutationResult, error) {
+	out := new(MutationResult)
 	err := c.cc.Invoke(ctx, "/api.DatabaseService/EditDocs", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -4434,8 +11286,8 @@ func (c *databaseServiceClient) DelDoc(ctx context.Context, in *Ref, opts ...grp
 	return out, nil
 }
 
-func (c *databaseServiceClient) DelDocs(ctx context.Context, in *Filter, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
+func (c *databaseServiceClient) DelDocs(ctx context.Context, in *Filter, opts ...grpc.CallOption) (*MutationResult, error) {
+	out := new(MutationResult)
 	err := c.cc.Invoke(ctx, "/api.DatabaseService/DelDocs", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -4497,6 +11349,24 @@ func (c *databaseServiceClient) CreateConnections(ctx context.Context, in *Conne
 	return out, nil
 }
 
+func (c *databaseServiceClient) UpsertConnection(ctx context.Context, in *ConnectionUpsert, opts ...grpc.CallOption) (*Connection, error) {
+	out := new(Connection)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/UpsertConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) UpsertConnections(ctx context.Context, in *ConnectionUpserts, opts ...grpc.CallOption) (*Connections, error) {
+	out := new(Connections)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/UpsertConnections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *databaseServiceClient) SearchAndConnect(ctx context.Context, in *SearchConnectFilter, opts ...grpc.CallOption) (*Connections, error) {
 	out := new(Connections)
 	err := c.cc.Invoke(ctx, "/api.DatabaseService/SearchAndConnect", in, out, opts...)
@@ -4533,6 +11403,38 @@ func (c *databaseServiceClient) SearchConnections(ctx context.Context, in *Filte
 	return out, nil
 }
 
+func (c *databaseServiceClient) StreamConnections(ctx context.Context, in *Filter, opts ...grpc.CallOption) (DatabaseService_StreamConnectionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DatabaseService_serviceDesc.Streams[2], "/api.DatabaseService/StreamConnections", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &databaseServiceStreamConnectionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DatabaseService_StreamConnectionsClient interface {
+	Recv() (*Connection, error)
+	grpc.ClientStream
+}
+
+type databaseServiceStreamConnectionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *databaseServiceStreamConnectionsClient) Recv() (*Connection, error) {
+	m := new(Connection)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *databaseServiceClient) EditConnection(ctx context.Context, in *Edit, opts ...grpc.CallOption) (*Connection, error) {
 	out := new(Connection)
 	err := c.cc.Invoke(ctx, "/api.DatabaseService/EditConnection", in, out, opts...)
@@ -4542,8 +11444,8 @@ func (c *databaseServiceClient) EditConnection(ctx context.Context, in *Edit, op
 	return out, nil
 }
 
-func (c *databaseServiceClient) EditConnections(ctx context.Context, in *EditFilter, opts ...grpc.CallOption) (*Connections, error) {
-	out := new(Connections)
+func (c *databaseServiceClient) EditConnections(ctx context.Context, in *EditFilter, opts ...grpc.CallOption) (*MutationResult, error) {
+	out := new(MutationResult)
 	err := c.cc.Invoke(ctx, "/api.DatabaseService/EditConnections", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -4560,8 +11462,8 @@ func (c *databaseServiceClient) DelConnection(ctx context.Context, in *Ref, opts
 	return out, nil
 }
 
-func (c *databaseServiceClient) DelConnections(ctx context.Context, in *Filter, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
+func (c *databaseServiceClient) DelConnections(ctx context.Context, in *Filter, opts ...grpc.CallOption) (*MutationResult, error) {
+	out := new(MutationResult)
 	err := c.cc.Invoke(ctx, "/api.DatabaseService/DelConnections", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -4587,6 +11489,15 @@ func (c *databaseServiceClient) ConnectionsTo(ctx context.Context, in *ConnectFi
 	return out, nil
 }
 
+func (c *databaseServiceClient) GetDegree(ctx context.Context, in *DegreeFilter, opts ...grpc.CallOption) (*Degree, error) {
+	out := new(Degree)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/GetDegree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *databaseServiceClient) AggregateDocs(ctx context.Context, in *AggFilter, opts ...grpc.CallOption) (*Number, error) {
 	out := new(Number)
 	err := c.cc.Invoke(ctx, "/api.DatabaseService/AggregateDocs", in, out, opts...)
@@ -4605,6 +11516,24 @@ func (c *databaseServiceClient) AggregateConnections(ctx context.Context, in *Ag
 	return out, nil
 }
 
+func (c *databaseServiceClient) AggregateDocGroups(ctx context.Context, in *GroupAggFilter, opts ...grpc.CallOption) (*AggGroups, error) {
+	out := new(AggGroups)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/AggregateDocGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) AggregateConnectionGroups(ctx context.Context, in *GroupAggFilter, opts ...grpc.CallOption) (*AggGroups, error) {
+	out := new(AggGroups)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/AggregateConnectionGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *databaseServiceClient) Broadcast(ctx context.Context, in *OutboundMessage, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/api.DatabaseService/Broadcast", in, out, opts...)
@@ -4615,7 +11544,7 @@ func (c *databaseServiceClient) Broadcast(ctx context.Context, in *OutboundMessa
 }
 
 func (c *databaseServiceClient) Stream(ctx context.Context, in *StreamFilter, opts ...grpc.CallOption) (DatabaseService_StreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_DatabaseService_serviceDesc.Streams[0], "/api.DatabaseService/Stream", opts...)
+	stream, err := c.cc.NewStream(ctx, &_DatabaseService_serviceDesc.Streams[3], "/api.DatabaseService/Stream", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4647,7 +11576,7 @@ func (x *databaseServiceStreamClient) Recv() (*Message, error) {
 }
 
 func (c *databaseServiceClient) PushDocConstructors(ctx context.Context, opts ...grpc.CallOption) (DatabaseService_PushDocConstructorsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_DatabaseService_serviceDesc.Streams[1], "/api.DatabaseService/PushDocConstructors", opts...)
+	stream, err := c.cc.NewStream(ctx, &_DatabaseService_serviceDesc.Streams[4], "/api.DatabaseService/PushDocConstructors", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4678,7 +11607,7 @@ func (x *databaseServicePushDocConstructorsClient) Recv() (*Doc, error) {
 }
 
 func (c *databaseServiceClient) PushConnectionConstructors(ctx context.Context, opts ...grpc.CallOption) (DatabaseService_PushConnectionConstructorsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_DatabaseService_serviceDesc.Streams[2], "/api.DatabaseService/PushConnectionConstructors", opts...)
+	stream, err := c.cc.NewStream(ctx, &_DatabaseService_serviceDesc.Streams[5], "/api.DatabaseService/PushConnectionConstructors", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4709,7 +11638,7 @@ func (x *databaseServicePushConnectionConstructorsClient) Recv() (*Connection, e
 }
 
 func (c *databaseServiceClient) SeedDocs(ctx context.Context, opts ...grpc.CallOption) (DatabaseService_SeedDocsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_DatabaseService_serviceDesc.Streams[3], "/api.DatabaseService/SeedDocs", opts...)
+	stream, err := c.cc.NewStream(ctx, &_DatabaseService_serviceDesc.Streams[6], "/api.DatabaseService/SeedDocs", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4731,45 +11660,140 @@ func (x *databaseServiceSeedDocsClient) Send(m *Doc) error {
 	return x.ClientStream.SendMsg(m)
 }
 
-func (x *databaseServiceSeedDocsClient) CloseAndRecv() (*empty.Empty, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
+func (x *databaseServiceSeedDocsClient) CloseAndRecv() (*empty.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(empty.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *databaseServiceClient) SeedConnections(ctx context.Context, opts ...grpc.CallOption) (DatabaseService_SeedConnectionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DatabaseService_serviceDesc.Streams[7], "/api.DatabaseService/SeedConnections", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &databaseServiceSeedConnectionsClient{stream}
+	return x, nil
+}
+
+type DatabaseService_SeedConnectionsClient interface {
+	Send(*Connection) error
+	CloseAndRecv() (*empty.Empty, error)
+	grpc.ClientStream
+}
+
+type databaseServiceSeedConnectionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *databaseServiceSeedConnectionsClient) Send(m *Connection) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *databaseServiceSeedConnectionsClient) CloseAndRecv() (*empty.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(empty.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *databaseServiceClient) ReEncrypt(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/ReEncrypt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) Migrate(ctx context.Context, in *Migration, opts ...grpc.CallOption) (*MigrationStatus, error) {
+	out := new(MigrationStatus)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/Migrate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) GetMigrations(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*MigrationStatuses, error) {
+	out := new(MigrationStatuses)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/GetMigrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) RunAnalytics(ctx context.Context, in *AnalyticsJob, opts ...grpc.CallOption) (*AnalyticsStatus, error) {
+	out := new(AnalyticsStatus)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/RunAnalytics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) GetAnalytics(ctx context.Context, in *AnalyticsRef, opts ...grpc.CallOption) (*AnalyticsStatus, error) {
+	out := new(AnalyticsStatus)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/GetAnalytics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) ListAnalytics(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*AnalyticsStatuses, error) {
+	out := new(AnalyticsStatuses)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/ListAnalytics", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	m := new(empty.Empty)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+	return out, nil
+}
+
+func (c *databaseServiceClient) CancelAnalytics(ctx context.Context, in *AnalyticsRef, opts ...grpc.CallOption) (*AnalyticsStatus, error) {
+	out := new(AnalyticsStatus)
+	err := c.cc.Invoke(ctx, "/api.DatabaseService/CancelAnalytics", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *databaseServiceClient) SeedConnections(ctx context.Context, opts ...grpc.CallOption) (DatabaseService_SeedConnectionsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_DatabaseService_serviceDesc.Streams[4], "/api.DatabaseService/SeedConnections", opts...)
+func (c *databaseServiceClient) StreamAnalyticsResults(ctx context.Context, in *AnalyticsRef, opts ...grpc.CallOption) (DatabaseService_StreamAnalyticsResultsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DatabaseService_serviceDesc.Streams[8], "/api.DatabaseService/StreamAnalyticsResults", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &databaseServiceSeedConnectionsClient{stream}
+	x := &databaseServiceStreamAnalyticsResultsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
 	return x, nil
 }
 
-type DatabaseService_SeedConnectionsClient interface {
-	Send(*Connection) error
-	CloseAndRecv() (*empty.Empty, error)
+type DatabaseService_StreamAnalyticsResultsClient interface {
+	Recv() (*AnalyticsResult, error)
 	grpc.ClientStream
 }
 
-type databaseServiceSeedConnectionsClient struct {
+type databaseServiceStreamAnalyticsResultsClient struct {
 	grpc.ClientStream
 }
 
-func (x *databaseServiceSeedConnectionsClient) Send(m *Connection) error {
-	return x.ClientStream.SendMsg(m)
-}
-
-func (x *databaseServiceSeedConnectionsClient) CloseAndRecv() (*empty.Empty, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(empty.Empty)
+func (x *databaseServiceStreamAnalyticsResultsClient) Recv() (*AnalyticsResult, error) {
+	m := new(AnalyticsResult)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
@@ -4791,22 +11815,50 @@ type DatabaseServiceServer interface {
 	CreateDoc(context.Context, *DocConstructor) (*Doc, error)
 	// CreateDocs creates a batch of docs in the graph
 	CreateDocs(context.Context, *DocConstructors) (*Docs, error)
+	// UpsertDoc creates a doc or merges attributes into it if it already exists
+	UpsertDoc(context.Context, *DocUpsert) (*Doc, error)
+	// UpsertDocs creates or merges a batch of docs in a single transaction
+	UpsertDocs(context.Context, *DocUpserts) (*Docs, error)
 	// GetDoc gets a single doc in the graph
 	GetDoc(context.Context, *Ref) (*Doc, error)
 	// SearchDocs searches the graph for docs
 	SearchDocs(context.Context, *Filter) (*Docs, error)
+	// SearchText searches a FULL_TEXT index - returning docs ranked by relevance(BM25)
+	SearchText(context.Context, *TextSearchFilter) (*ScoredDocs, error)
+	// SearchGeo searches a GEO index for docs within a radius, within a bounding box, or nearest to a point
+	SearchGeo(context.Context, *GeoFilter) (*GeoDocs, error)
+	// SearchSimilar searches a VECTOR index for the docs nearest to a vector
+	SearchSimilar(context.Context, *SimilarFilter) (*SimilarDocs, error)
+	// SimilarNeighbors ranks the docs that share neighbors with a doc by the similarity of their neighborhoods ex: people you may know, items bought together
+	SimilarNeighbors(context.Context, *NeighborFilter) (*NeighborDocs, error)
+	// StreamDocs streams docs that pass the filter a page at a time as they are read from the graph (sort is not supported)
+	StreamDocs(*Filter, DatabaseService_StreamDocsServer) error
 	// Traverse executes a depth first search of the graph for docs
 	Traverse(context.Context, *TraverseFilter) (*Traversals, error)
+	// StreamTraverse streams the docs found by a graph traversal once the traversal has finished
+	StreamTraverse(*TraverseFilter, DatabaseService_StreamTraverseServer) error
+	// Explain executes a search or traversal & returns how it was executed(access path, work done & timings) instead of it's results
+	Explain(context.Context, *ExplainFilter) (*Explanation, error)
 	// TraverseMe executes a graph traversal searching for docs related to the currently logged in user
 	TraverseMe(context.Context, *TraverseMeFilter) (*Traversals, error)
+	// ShortestPath finds the lowest cost path(s) between two docs - Dijkstra if there's a weight expression, otherwise breadth-first-search
+	ShortestPath(context.Context, *PathFilter) (*Paths, error)
+	// AllPaths finds every loopless path between two docs(up to a max depth & max number of paths) - shorter paths are returned first
+	AllPaths(context.Context, *AllPathsFilter) (*Paths, error)
+	// Match finds the docs & connections that match a pattern of nodes joined by edges & returns their bindings to the pattern's named variables
+	Match(context.Context, *MatchFilter) (*Matches, error)
+	// TopologicalSort orders the docs joined by directed connections of a type so every doc comes before the docs it's connected to - the cycles that prevent an order are returned instead if there are any
+	TopologicalSort(context.Context, *TopologicalSortFilter) (*TopologicalOrder, error)
+	// ExtractSubgraph returns the docs within a max depth of one or more root docs & the connections that were traversed to reach them
+	ExtractSubgraph(context.Context, *ExtractFilter) (*Graph, error)
 	// EditDoc patches/edits a docs attributes
 	EditDoc(context.Context, *Edit) (*Doc, error)
 	// EditDocs patches a batch of docs attributes that pass the patch filter
-	EditDocs(context.Context, *EditFilter) (*Docs, error)
+	EditDocs(context.Context, *EditFilter) (*MutationResult, error)
 	// DelDoc deletes a doc & all of it's connected connections
 	DelDoc(context.Context, *Ref) (*empty.Empty, error)
-	// DelDocs deletes a batch of docs that pass the filter
-	DelDocs(context.Context, *Filter) (*empty.Empty, error)
+	// DelDocs deletes a batch of docs that pass the filter & all of their connections
+	DelDocs(context.Context, *Filter) (*MutationResult, error)
 	// ExistsDoc searches for a Doc and returns a Boolean indicating if it exists in the graph
 	ExistsDoc(context.Context, *ExistsFilter) (*Boolean, error)
 	// ExistsConnection searches for a Connection and returns a Boolean indicating if it exists in the graph
@@ -4819,27 +11871,39 @@ type DatabaseServiceServer interface {
 	CreateConnection(context.Context, *ConnectionConstructor) (*Connection, error)
 	// CreateConnections creates a batch of connections in the graph
 	CreateConnections(context.Context, *ConnectionConstructors) (*Connections, error)
+	// UpsertConnection creates a connection or merges attributes into it if it already exists
+	UpsertConnection(context.Context, *ConnectionUpsert) (*Connection, error)
+	// UpsertConnections creates or merges a batch of connections in a single transaction
+	UpsertConnections(context.Context, *ConnectionUpserts) (*Connections, error)
 	SearchAndConnect(context.Context, *SearchConnectFilter) (*Connections, error)
 	SearchAndConnectMe(context.Context, *SearchConnectMeFilter) (*Connections, error)
 	// GetConnection gets a single connection in the graph
 	GetConnection(context.Context, *Ref) (*Connection, error)
 	// SearchConnections searches the graph for connections
 	SearchConnections(context.Context, *Filter) (*Connections, error)
+	// StreamConnections streams connections that pass the filter a page at a time as they are read from the graph (sort is not supported)
+	StreamConnections(*Filter, DatabaseService_StreamConnectionsServer) error
 	// EditConnection patches an connections attributes
 	EditConnection(context.Context, *Edit) (*Connection, error)
 	// EditConnections patches a batch of connections attributes that pass the patch filter
-	EditConnections(context.Context, *EditFilter) (*Connections, error)
+	EditConnections(context.Context, *EditFilter) (*MutationResult, error)
 	// DelConnection deletes an connection from the graph
 	DelConnection(context.Context, *Ref) (*empty.Empty, error)
 	// DelConnections deletes a batch of connections that pass the filter
-	DelConnections(context.Context, *Filter) (*empty.Empty, error)
+	DelConnections(context.Context, *Filter) (*MutationResult, error)
 	// ConnectionsFrom returns connections that source from the given doc ref that pass the filter
 	ConnectionsFrom(context.Context, *ConnectFilter) (*Connections, error)
 	// ConnectionsTo returns connections that point to the given doc ref that pass the filter
 	ConnectionsTo(context.Context, *ConnectFilter) (*Connections, error)
+	// GetDegree returns the number of connections to & from a doc - in total & per connection type
+	GetDegree(context.Context, *DegreeFilter) (*Degree, error)
 	// AggregateDocs executes an aggregation function against the graph
 	AggregateDocs(context.Context, *AggFilter) (*Number, error)
 	AggregateConnections(context.Context, *AggFilter) (*Number, error)
+	// AggregateDocGroups executes one or more aggregation functions against docs that pass the filter - returning one result per group
+	AggregateDocGroups(context.Context, *GroupAggFilter) (*AggGroups, error)
+	// AggregateConnectionGroups executes one or more aggregation functions against connections that pass the filter - returning one result per group
+	AggregateConnectionGroups(context.Context, *GroupAggFilter) (*AggGroups, error)
 	// Broadcast broadcasts a message to a pubsub channel
 	Broadcast(context.Context, *OutboundMessage) (*empty.Empty, error)
 	// Stream subscribes to messages on a pubsub channel
@@ -4848,6 +11912,22 @@ type DatabaseServiceServer interface {
 	PushConnectionConstructors(DatabaseService_PushConnectionConstructorsServer) error
 	SeedDocs(DatabaseService_SeedDocsServer) error
 	SeedConnections(DatabaseService_SeedConnectionsServer) error
+	// ReEncrypt starts a background job that re-encrypts all docs & connections with the current encryption key (root users only)
+	ReEncrypt(context.Context, *empty.Empty) (*empty.Empty, error)
+	// Migrate starts a background job that applies a versioned schema migration (root users only). Each version is applied exactly once
+	Migrate(context.Context, *Migration) (*MigrationStatus, error)
+	// GetMigrations returns the status of every migration that has been applied or attempted
+	GetMigrations(context.Context, *empty.Empty) (*MigrationStatuses, error)
+	// RunAnalytics starts a background job that runs a graph analytics algorithm over a subgraph (root users only)
+	RunAnalytics(context.Context, *AnalyticsJob) (*AnalyticsStatus, error)
+	// GetAnalytics returns the status & progress of an analytics job
+	GetAnalytics(context.Context, *AnalyticsRef) (*AnalyticsStatus, error)
+	// ListAnalytics returns the status of every analytics job within the namespace
+	ListAnalytics(context.Context, *empty.Empty) (*AnalyticsStatuses, error)
+	// CancelAnalytics cancels a running analytics job (root users only)
+	CancelAnalytics(context.Context, *AnalyticsRef) (*AnalyticsStatus, error)
+	// StreamAnalyticsResults streams the results of a completed analytics job in descending order of value
+	StreamAnalyticsResults(*AnalyticsRef, DatabaseService_StreamAnalyticsResultsServer) error
 }
 
 // UnimplementedDatabaseServiceServer can be embedded to have forward compatible implementations.
@@ -4878,28 +11958,70 @@ func (*UnimplementedDatabaseServiceServer) CreateDoc(context.Context, *DocConstr
 func (*UnimplementedDatabaseServiceServer) CreateDocs(context.Context, *DocConstructors) (*Docs, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateDocs not implemented")
 }
+func (*UnimplementedDatabaseServiceServer) UpsertDoc(context.Context, *DocUpsert) (*Doc, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertDoc not implemented")
+}
+func (*UnimplementedDatabaseServiceServer) UpsertDocs(context.Context, *DocUpserts) (*Docs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertDocs not implemented")
+}
 func (*UnimplementedDatabaseServiceServer) GetDoc(context.Context, *Ref) (*Doc, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDoc not implemented")
 }
 func (*UnimplementedDatabaseServiceServer) SearchDocs(context.Context, *Filter) (*Docs, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchDocs not implemented")
 }
+func (*UnimplementedDatabaseServiceServer) SearchText(context.Context, *TextSearchFilter) (*ScoredDocs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchText not implemented")
+}
+func (*UnimplementedDatabaseServiceServer) SearchGeo(context.Context, *GeoFilter) (*GeoDocs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchGeo not implemented")
+}
+func (*UnimplementedDatabaseServiceServer) SearchSimilar(context.Context, *SimilarFilter) (*SimilarDocs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchSimilar not implemented")
+}
+func (*UnimplementedDatabaseServiceServer) SimilarNeighbors(context.Context, *NeighborFilter) (*NeighborDocs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimilarNeighbors not implemented")
+}
+func (*UnimplementedDatabaseServiceServer) StreamDocs(*Filter, DatabaseService_StreamDocsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamDocs not implemented")
+}
 func (*UnimplementedDatabaseServiceServer) Traverse(context.Context, *TraverseFilter) (*Traversals, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Traverse not implemented")
 }
+func (*UnimplementedDatabaseServiceServer) StreamTraverse(*TraverseFilter, DatabaseService_StreamTraverseServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTraverse not implemented")
+}
+func (*UnimplementedDatabaseServiceServer) Explain(context.Context, *ExplainFilter) (*Explanation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Explain not implemented")
+}
 func (*UnimplementedDatabaseServiceServer) TraverseMe(context.Context, *TraverseMeFilter) (*Traversals, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraverseMe not implemented")
 }
+func (*UnimplementedDatabaseServiceServer) ShortestPath(context.Context, *PathFilter) (*Paths, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShortestPath not implemented")
+}
+func (*UnimplementedDatabaseServiceServer) AllPaths(context.Context, *AllPathsFilter) (*Paths, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllPaths not implemented")
+}
+func (*UnimplementedDatabaseServiceServer) Match(context.Context, *MatchFilter) (*Matches, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Match not implemented")
+}
+func (*UnimplementedDatabaseServiceServer) TopologicalSort(context.Context, *TopologicalSortFilter) (*TopologicalOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopologicalSort not implemented")
+}
+func (*UnimplementedDatabaseServiceServer) ExtractSubgraph(context.Context, *ExtractFilter) (*Graph, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtractSubgraph not implemented")
+}
 func (*UnimplementedDatabaseServiceServer) EditDoc(context.Context, *Edit) (*Doc, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditDoc not implemented")
 }
-func (*UnimplementedDatabaseServiceServer) EditDocs(context.Context, *EditFilter) (*Docs, error) {
+func (*UnimplementedDatabaseServiceServer) EditDocs(context.Context, *EditFilter) (*MutationResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditDocs not implemented")
 }
 func (*UnimplementedDatabaseServiceServer) DelDoc(context.Context, *Ref) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelDoc not implemented")
 }
-func (*UnimplementedDatabaseServiceServer) DelDocs(context.Context, *Filter) (*empty.Empty, error) {
+func (*UnimplementedDatabaseServiceServer) DelDocs(context.Context, *Filter) (*MutationResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelDocs not implemented")
 }
 func (*UnimplementedDatabaseServiceServer) ExistsDoc(context.Context, *ExistsFilter) (*Boolean, error) {
@@ -4920,6 +12042,12 @@ func (*UnimplementedDatabaseServiceServer) CreateConnection(context.Context, *Co
 func (*UnimplementedDatabaseServiceServer) CreateConnections(context.Context, *ConnectionConstructors) (*Connections, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateConnections not implemented")
 }
+func (*UnimplementedDatabaseServiceServer) UpsertConnection(context.Context, *ConnectionUpsert) (*Connection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertConnection not implemented")
+}
+func (*UnimplementedDatabaseServiceServer) UpsertConnections(context.Context, *ConnectionUpserts) (*Connections, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertConnections not implemented")
+}
 func (*UnimplementedDatabaseServiceServer) SearchAndConnect(context.Context, *SearchConnectFilter) (*Connections, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchAndConnect not implemented")
 }
@@ -4932,16 +12060,19 @@ func (*UnimplementedDatabaseServiceServer) GetConnection(context.Context, *Ref)
 func (*UnimplementedDatabaseServiceServer) SearchConnections(context.Context, *Filter) (*Connections, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchConnections not implemented")
 }
+func (*UnimplementedDatabaseServiceServer) StreamConnections(*Filter, DatabaseService_StreamConnectionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamConnections not implemented")
+}
 func (*UnimplementedDatabaseServiceServer) EditConnection(context.Context, *Edit) (*Connection, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditConnection not implemented")
 }
-func (*UnimplementedDatabaseServiceServer) EditConnections(context.Context, *EditFilter) (*Connections, error) {
+func (*UnimplementedDatabaseServiceServer) EditConnections(context.Context, *EditFilter) (*MutationResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditConnections not implemented")
 }
 func (*UnimplementedDatabaseServiceServer) DelConnection(context.Context, *Ref) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelConnection not implemented")
 }
-func (*UnimplementedDatabaseServiceServer) DelConnections(context.Context, *Filter) (*empty.Empty, error) {
+func (*UnimplementedDatabaseServiceServer) DelConnections(context.Context, *Filter) (*MutationResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelConnections not implemented")
 }
 func (*UnimplementedDatabaseServiceServer) ConnectionsFrom(context.Context, *ConnectFilter) (*Connections, error) {
@@ -4950,12 +12081,21 @@ func (*UnimplementedDatabaseServiceServer) ConnectionsFrom(context.Context, *Con
 func (*UnimplementedDatabaseServiceServer) ConnectionsTo(context.Context, *ConnectFilter) (*Connections, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConnectionsTo not implemented")
 }
+func (*UnimplementedDatabaseServiceServer) GetDegree(context.Context, *DegreeFilter) (*Degree, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDegree not implemented")
+}
 func (*UnimplementedDatabaseServiceServer) AggregateDocs(context.Context, *AggFilter) (*Number, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AggregateDocs not implemented")
 }
 func (*UnimplementedDatabaseServiceServer) AggregateConnections(context.Context, *AggFilter) (*Number, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AggregateConnections not implemented")
 }
+func (*UnimplementedDatabaseServiceServer) AggregateDocGroups(context.Context, *GroupAggFilter) (*AggGroups, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateDocGroups not implemented")
+}
+func (*UnimplementedDatabaseServiceServer) AggregateConnectionGroups(context.Context, *GroupAggFilter) (*AggGroups, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateConnectionGroups not implemented")
+}
 func (*UnimplementedDatabaseServiceServer) Broadcast(context.Context, *OutboundMessage) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Broadcast not implemented")
 }
@@ -4974,6 +12114,30 @@ func (*UnimplementedDatabaseServiceServer) SeedDocs(DatabaseService_SeedDocsServ
 func (*UnimplementedDatabaseServiceServer) SeedConnections(DatabaseService_SeedConnectionsServer) error {
 	return status.Errorf(codes.Unimplemented, "method SeedConnections not implemented")
 }
+func (*UnimplementedDatabaseServiceServer) ReEncrypt(context.Context, *empty.Empty) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReEncrypt not implemented")
+}
+func (*UnimplementedDatabaseServiceServer) Migrate(context.Context, *Migration) (*MigrationStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Migrate not implemented")
+}
+func (*UnimplementedDatabaseServiceServer) GetMigrations(context.Context, *empty.Empty) (*MigrationStatuses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMigrations not implemented")
+}
+func (*UnimplementedDatabaseServiceServer) RunAnalytics(context.Context, *AnalyticsJob) (*AnalyticsStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunAnalytics not implemented")
+}
+func (*UnimplementedDatabaseServiceServer) GetAnalytics(context.Context, *AnalyticsRef) (*AnalyticsStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnalytics not implemented")
+}
+func (*UnimplementedDatabaseServiceServer) ListAnalytics(context.Context, *empty.Empty) (*AnalyticsStatuses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAnalytics not implemented")
+}
+func (*UnimplementedDatabaseServiceServer) CancelAnalytics(context.Context, *AnalyticsRef) (*AnalyticsStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAnalytics not implemented")
+}
+func (*UnimplementedDatabaseServiceServer) StreamAnalyticsResults(*AnalyticsRef, DatabaseService_StreamAnalyticsResultsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAnalyticsResults not implemented")
+}
 
 func RegisterDatabaseServiceServer(s *grpc.Server, srv DatabaseServiceServer) {
 	s.RegisterService(&_DatabaseService_serviceDesc, srv)
@@ -5123,6 +12287,42 @@ func _DatabaseService_CreateDocs_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseService_UpsertDoc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DocUpsert)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).UpsertDoc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DatabaseService/UpsertDoc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).UpsertDoc(ctx, req.(*DocUpsert))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_UpsertDocs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DocUpserts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).UpsertDocs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DatabaseService/UpsertDocs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).UpsertDocs(ctx, req.(*DocUpserts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DatabaseService_GetDoc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Ref)
 	if err := dec(in); err != nil {
@@ -5159,38 +12359,260 @@ func _DatabaseService_SearchDocs_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseService_SearchText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TextSearchFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).SearchText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DatabaseService/SearchText",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).SearchText(ctx, req.(*TextSearchFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_SearchGeo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeoFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).SearchGeo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DatabaseService/SearchGeo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).SearchGeo(ctx, req.(*GeoFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_SearchSimilar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimilarFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).SearchSimilar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DatabaseService/SearchSimilar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).SearchSimilar(ctx, req.(*SimilarFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_SimilarNeighbors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NeighborFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).SimilarNeighbors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DatabaseService/SimilarNeighbors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).SimilarNeighbors(ctx, req.(*NeighborFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_StreamDocs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Filter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DatabaseServiceServer).StreamDocs(m, &databaseServiceStreamDocsServer{stream})
+}
+
+type DatabaseService_StreamDocsServer interface {
+	Send(*Doc) error
+	grpc.ServerStream
+}
+
+type databaseServiceStreamDocsServer struct {
+	grpc.ServerStream
+}
+
+func (x *databaseServiceStreamDocsServer) Send(m *Doc) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _DatabaseService_Traverse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TraverseFilter)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DatabaseServiceServer).Traverse(ctx, in)
+		return srv.(DatabaseServiceServer).Traverse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DatabaseService/Traverse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).Traverse(ctx, req.(*TraverseFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_StreamTraverse_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TraverseFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DatabaseServiceServer).StreamTraverse(m, &databaseServiceStreamTraverseServer{stream})
+}
+
+type DatabaseService_StreamTraverseServer interface {
+	Send(*Traversal) error
+	grpc.ServerStream
+}
+
+type databaseServiceStreamTraverseServer struct {
+	grpc.ServerStream
+}
+
+func (x *databaseServiceStreamTraverseServer) Send(m *Traversal) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DatabaseService_Explain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).Explain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DatabaseService/Explain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).Explain(ctx, req.(*ExplainFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_TraverseMe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TraverseMeFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).TraverseMe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DatabaseService/TraverseMe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).TraverseMe(ctx, req.(*TraverseMeFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_ShortestPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PathFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ShortestPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DatabaseService/ShortestPath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ShortestPath(ctx, req.(*PathFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_AllPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllPathsFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).AllPaths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DatabaseService/AllPaths",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).AllPaths(ctx, req.(*AllPathsFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_Match_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).Match(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DatabaseService/Match",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).Match(ctx, req.(*MatchFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_TopologicalSort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopologicalSortFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).TopologicalSort(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.DatabaseService/Traverse",
+		FullMethod: "/api.DatabaseService/TopologicalSort",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DatabaseServiceServer).Traverse(ctx, req.(*TraverseFilter))
+		return srv.(DatabaseServiceServer).TopologicalSort(ctx, req.(*TopologicalSortFilter))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DatabaseService_TraverseMe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TraverseMeFilter)
+func _DatabaseService_ExtractSubgraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtractFilter)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DatabaseServiceServer).TraverseMe(ctx, in)
+		return srv.(DatabaseServiceServer).ExtractSubgraph(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.DatabaseService/TraverseMe",
+		FullMethod: "/api.DatabaseService/ExtractSubgraph",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DatabaseServiceServer).TraverseMe(ctx, req.(*TraverseMeFilter))
+		return srv.(DatabaseServiceServer).ExtractSubgraph(ctx, req.(*ExtractFilter))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -5375,6 +12797,42 @@ func _DatabaseService_CreateConnections_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseService_UpsertConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectionUpsert)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).UpsertConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DatabaseService/UpsertConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).UpsertConnection(ctx, req.(*ConnectionUpsert))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_UpsertConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectionUpserts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).UpsertConnections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DatabaseService/UpsertConnections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).UpsertConnections(ctx, req.(*ConnectionUpserts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DatabaseService_SearchAndConnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchConnectFilter)
 	if err := dec(in); err != nil {
@@ -5447,6 +12905,27 @@ func _DatabaseService_SearchConnections_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseService_StreamConnections_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Filter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DatabaseServiceServer).StreamConnections(m, &databaseServiceStreamConnectionsServer{stream})
+}
+
+type DatabaseService_StreamConnectionsServer interface {
+	Send(*Connection) error
+	grpc.ServerStream
+}
+
+type databaseServiceStreamConnectionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *databaseServiceStreamConnectionsServer) Send(m *Connection) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _DatabaseService_EditConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Edit)
 	if err := dec(in); err != nil {
@@ -5555,6 +13034,24 @@ func _DatabaseService_ConnectionsTo_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseService_GetDegree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DegreeFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).GetDegree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DatabaseService/GetDegree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).GetDegree(ctx, req.(*DegreeFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DatabaseService_AggregateDocs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AggFilter)
 	if err := dec(in); err != nil {
@@ -5591,6 +13088,42 @@ func _DatabaseService_AggregateConnections_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseService_AggregateDocGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupAggFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).AggregateDocGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DatabaseService/AggregateDocGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).AggregateDocGroups(ctx, req.(*GroupAggFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_AggregateConnectionGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupAggFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).AggregateConnectionGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DatabaseService/AggregateConnectionGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).AggregateConnectionGroups(ctx, req.(*GroupAggFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DatabaseService_Broadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OutboundMessage)
 	if err := dec(in); err != nil {
@@ -5734,6 +13267,153 @@ func (x *databaseServiceSeedConnectionsServer) Recv() (*Connection, error) {
 	return m, nil
 }
 
+func _DatabaseService_ReEncrypt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ReEncrypt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DatabaseService/ReEncrypt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ReEncrypt(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_Migrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Migration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).Migrate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DatabaseService/Migrate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).Migrate(ctx, req.(*Migration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_GetMigrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).GetMigrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DatabaseService/GetMigrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).GetMigrations(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_RunAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyticsJob)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).RunAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DatabaseService/RunAnalytics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).RunAnalytics(ctx, req.(*AnalyticsJob))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_GetAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyticsRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).GetAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DatabaseService/GetAnalytics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).GetAnalytics(ctx, req.(*AnalyticsRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_ListAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ListAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DatabaseService/ListAnalytics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ListAnalytics(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_CancelAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyticsRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).CancelAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DatabaseService/CancelAnalytics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).CancelAnalytics(ctx, req.(*AnalyticsRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_StreamAnalyticsResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AnalyticsRef)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DatabaseServiceServer).StreamAnalyticsResults(m, &databaseServiceStreamAnalyticsResultsServer{stream})
+}
+
+type DatabaseService_StreamAnalyticsResultsServer interface {
+	Send(*AnalyticsResult) error
+	grpc.ServerStream
+}
+
+type databaseServiceStreamAnalyticsResultsServer struct {
+	grpc.ServerStream
+}
+
+func (x *databaseServiceStreamAnalyticsResultsServer) Send(m *AnalyticsResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _DatabaseService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.DatabaseService",
 	HandlerType: (*DatabaseServiceServer)(nil),
@@ -5770,6 +13450,14 @@ var _DatabaseService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateDocs",
 			Handler:    _DatabaseService_CreateDocs_Handler,
 		},
+		{
+			MethodName: "UpsertDoc",
+			Handler:    _DatabaseService_UpsertDoc_Handler,
+		},
+		{
+			MethodName: "UpsertDocs",
+			Handler:    _DatabaseService_UpsertDocs_Handler,
+		},
 		{
 			MethodName: "GetDoc",
 			Handler:    _DatabaseService_GetDoc_Handler,
@@ -5778,14 +13466,54 @@ var _DatabaseService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SearchDocs",
 			Handler:    _DatabaseService_SearchDocs_Handler,
 		},
+		{
+			MethodName: "SearchText",
+			Handler:    _DatabaseService_SearchText_Handler,
+		},
+		{
+			MethodName: "SearchGeo",
+			Handler:    _DatabaseService_SearchGeo_Handler,
+		},
+		{
+			MethodName: "SearchSimilar",
+			Handler:    _DatabaseService_SearchSimilar_Handler,
+		},
+		{
+			MethodName: "SimilarNeighbors",
+			Handler:    _DatabaseService_SimilarNeighbors_Handler,
+		},
 		{
 			MethodName: "Traverse",
 			Handler:    _DatabaseService_Traverse_Handler,
 		},
+		{
+			MethodName: "Explain",
+			Handler:    _DatabaseService_Explain_Handler,
+		},
 		{
 			MethodName: "TraverseMe",
 			Handler:    _DatabaseService_TraverseMe_Handler,
 		},
+		{
+			MethodName: "ShortestPath",
+			Handler:    _DatabaseService_ShortestPath_Handler,
+		},
+		{
+			MethodName: "AllPaths",
+			Handler:    _DatabaseService_AllPaths_Handler,
+		},
+		{
+			MethodName: "Match",
+			Handler:    _DatabaseService_Match_Handler,
+		},
+		{
+			MethodName: "TopologicalSort",
+			Handler:    _DatabaseService_TopologicalSort_Handler,
+		},
+		{
+			MethodName: "ExtractSubgraph",
+			Handler:    _DatabaseService_ExtractSubgraph_Handler,
+		},
 		{
 			MethodName: "EditDoc",
 			Handler:    _DatabaseService_EditDoc_Handler,
@@ -5826,6 +13554,14 @@ var _DatabaseService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateConnections",
 			Handler:    _DatabaseService_CreateConnections_Handler,
 		},
+		{
+			MethodName: "UpsertConnection",
+			Handler:    _DatabaseService_UpsertConnection_Handler,
+		},
+		{
+			MethodName: "UpsertConnections",
+			Handler:    _DatabaseService_UpsertConnections_Handler,
+		},
 		{
 			MethodName: "SearchAndConnect",
 			Handler:    _DatabaseService_SearchAndConnect_Handler,
@@ -5866,6 +13602,10 @@ var _DatabaseService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConnectionsTo",
 			Handler:    _DatabaseService_ConnectionsTo_Handler,
 		},
+		{
+			MethodName: "GetDegree",
+			Handler:    _DatabaseService_GetDegree_Handler,
+		},
 		{
 			MethodName: "AggregateDocs",
 			Handler:    _DatabaseService_AggregateDocs_Handler,
@@ -5874,12 +13614,63 @@ var _DatabaseService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AggregateConnections",
 			Handler:    _DatabaseService_AggregateConnections_Handler,
 		},
+		{
+			MethodName: "AggregateDocGroups",
+			Handler:    _DatabaseService_AggregateDocGroups_Handler,
+		},
+		{
+			MethodName: "AggregateConnectionGroups",
+			Handler:    _DatabaseService_AggregateConnectionGroups_Handler,
+		},
 		{
 			MethodName: "Broadcast",
 			Handler:    _DatabaseService_Broadcast_Handler,
 		},
+		{
+			MethodName: "ReEncrypt",
+			Handler:    _DatabaseService_ReEncrypt_Handler,
+		},
+		{
+			MethodName: "Migrate",
+			Handler:    _DatabaseService_Migrate_Handler,
+		},
+		{
+			MethodName: "GetMigrations",
+			Handler:    _DatabaseService_GetMigrations_Handler,
+		},
+		{
+			MethodName: "RunAnalytics",
+			Handler:    _DatabaseService_RunAnalytics_Handler,
+		},
+		{
+			MethodName: "GetAnalytics",
+			Handler:    _DatabaseService_GetAnalytics_Handler,
+		},
+		{
+			MethodName: "ListAnalytics",
+			Handler:    _DatabaseService_ListAnalytics_Handler,
+		},
+		{
+			MethodName: "CancelAnalytics",
+			Handler:    _DatabaseService_CancelAnalytics_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamDocs",
+			Handler:       _DatabaseService_StreamDocs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamTraverse",
+			Handler:       _DatabaseService_StreamTraverse_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamConnections",
+			Handler:       _DatabaseService_StreamConnections_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Stream",
 			Handler:       _DatabaseService_Stream_Handler,
@@ -5907,6 +13698,11 @@ var _DatabaseService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _DatabaseService_SeedConnections_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "StreamAnalyticsResults",
+			Handler:       _DatabaseService_StreamAnalyticsResults_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "graphik.proto",
 }
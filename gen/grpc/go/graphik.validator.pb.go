@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
 	_ "github.com/golang/protobuf/ptypes/any"
+	_ "github.com/golang/protobuf/ptypes/duration"
 	_ "github.com/golang/protobuf/ptypes/empty"
 	_ "github.com/golang/protobuf/ptypes/struct"
 	_ "github.com/golang/protobuf/ptypes/timestamp"
@@ -119,6 +120,205 @@ func (this *Traversals) Validate() error {
 	}
 	return nil
 }
+func (this *PathFilter) Validate() error {
+	if nil == this.Source {
+		return github_com_mwitkow_go_proto_validators.FieldError("Source", fmt.Errorf("message must exist"))
+	}
+	if this.Source != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Source); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Source", err)
+		}
+	}
+	if nil == this.Target {
+		return github_com_mwitkow_go_proto_validators.FieldError("Target", fmt.Errorf("message must exist"))
+	}
+	if this.Target != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Target); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Target", err)
+		}
+	}
+	if !(this.K < 101) {
+		return github_com_mwitkow_go_proto_validators.FieldError("K", fmt.Errorf(`value '%v' must be less than '101'`, this.K))
+	}
+	if this.Budget != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Budget); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Budget", err)
+		}
+	}
+	return nil
+}
+func (this *AllPathsFilter) Validate() error {
+	if nil == this.Source {
+		return github_com_mwitkow_go_proto_validators.FieldError("Source", fmt.Errorf("message must exist"))
+	}
+	if this.Source != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Source); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Source", err)
+		}
+	}
+	if nil == this.Target {
+		return github_com_mwitkow_go_proto_validators.FieldError("Target", fmt.Errorf("message must exist"))
+	}
+	if this.Target != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Target); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Target", err)
+		}
+	}
+	if !(this.MaxDepth > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MaxDepth", fmt.Errorf(`value '%v' must be greater than '0'`, this.MaxDepth))
+	}
+	if !(this.MaxPaths > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MaxPaths", fmt.Errorf(`value '%v' must be greater than '0'`, this.MaxPaths))
+	}
+	if this.Budget != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Budget); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Budget", err)
+		}
+	}
+	return nil
+}
+func (this *Path) Validate() error {
+	for _, item := range this.Docs {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Docs", err)
+			}
+		}
+	}
+	for _, item := range this.Connections {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Connections", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *Paths) Validate() error {
+	for _, item := range this.Paths {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Paths", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *NodePattern) Validate() error {
+	if this.Ref != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Ref); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Ref", err)
+		}
+	}
+	return nil
+}
+func (this *EdgePattern) Validate() error {
+	if !(this.MaxHops < 11) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MaxHops", fmt.Errorf(`value '%v' must be less than '11'`, this.MaxHops))
+	}
+	return nil
+}
+func (this *MatchFilter) Validate() error {
+	if len(this.Nodes) < 1 {
+		return github_com_mwitkow_go_proto_validators.FieldError("Nodes", fmt.Errorf(`value '%v' must contain at least 1 elements`, this.Nodes))
+	}
+	for _, item := range this.Nodes {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Nodes", err)
+			}
+		}
+	}
+	for _, item := range this.Edges {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Edges", err)
+			}
+		}
+	}
+	if !(this.Limit > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '0'`, this.Limit))
+	}
+	if this.Budget != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Budget); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Budget", err)
+		}
+	}
+	return nil
+}
+func (this *Match) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *Matches) Validate() error {
+	for _, item := range this.Matches {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Matches", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *ExtractFilter) Validate() error {
+	if len(this.Roots) < 1 {
+		return github_com_mwitkow_go_proto_validators.FieldError("Roots", fmt.Errorf(`value '%v' must contain at least 1 elements`, this.Roots))
+	}
+	for _, item := range this.Roots {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Roots", err)
+			}
+		}
+	}
+	if !(this.MaxDepth > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MaxDepth", fmt.Errorf(`value '%v' must be greater than '0'`, this.MaxDepth))
+	}
+	if !(this.Limit > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '0'`, this.Limit))
+	}
+	if this.Budget != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Budget); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Budget", err)
+		}
+	}
+	return nil
+}
+
+var _regex_TopologicalSortFilter_Gtype = regexp.MustCompile(`^.{1,225}$`)
+
+func (this *TopologicalSortFilter) Validate() error {
+	if !_regex_TopologicalSortFilter_Gtype.MatchString(this.Gtype) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Gtype", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Gtype))
+	}
+	if !(this.MaxCycles < 101) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MaxCycles", fmt.Errorf(`value '%v' must be less than '101'`, this.MaxCycles))
+	}
+	if this.Budget != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Budget); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Budget", err)
+		}
+	}
+	return nil
+}
+func (this *TopologicalOrder) Validate() error {
+	for _, item := range this.Refs {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Refs", err)
+			}
+		}
+	}
+	for _, item := range this.Cycles {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Cycles", err)
+			}
+		}
+	}
+	return nil
+}
 func (this *Docs) Validate() error {
 	for _, item := range this.Docs {
 		if item != nil {
@@ -260,37 +460,411 @@ func (this *ConnectFilter) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("DocRef", err)
 		}
 	}
-	if !_regex_ConnectFilter_Gtype.MatchString(this.Gtype) {
-		return github_com_mwitkow_go_proto_validators.FieldError("Gtype", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Gtype))
+	if !_regex_ConnectFilter_Gtype.MatchString(this.Gtype) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Gtype", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Gtype))
+	}
+	if !(this.Limit > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '0'`, this.Limit))
+	}
+	if !_regex_ConnectFilter_Sort.MatchString(this.Sort) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Sort", fmt.Errorf(`value '%v' must be a string conforming to regex "((^|, )(|ref.gid|ref.gtype|^attributes.(.*)))+$"`, this.Sort))
+	}
+	return nil
+}
+
+var _regex_DegreeFilter_Gtype = regexp.MustCompile(`^.{0,225}$`)
+
+func (this *DegreeFilter) Validate() error {
+	if nil == this.Doc {
+		return github_com_mwitkow_go_proto_validators.FieldError("Doc", fmt.Errorf("message must exist"))
+	}
+	if this.Doc != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Doc); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Doc", err)
+		}
+	}
+	if !_regex_DegreeFilter_Gtype.MatchString(this.Gtype) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Gtype", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{0,225}$"`, this.Gtype))
+	}
+	return nil
+}
+func (this *GtypeDegree) Validate() error {
+	return nil
+}
+func (this *Degree) Validate() error {
+	if this.Doc != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Doc); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Doc", err)
+		}
+	}
+	for _, item := range this.Gtypes {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Gtypes", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_Filter_Gtype = regexp.MustCompile(`^.{1,225}$`)
+var _regex_Filter_Sort = regexp.MustCompile(`((^|, )(|ref.gid|ref.gtype|^attributes.(.*)|^degree.(in|out)(\..*)?))+$`)
+
+func (this *Filter) Validate() error {
+	if !_regex_Filter_Gtype.MatchString(this.Gtype) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Gtype", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Gtype))
+	}
+	if !(this.Limit > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '0'`, this.Limit))
+	}
+	if !_regex_Filter_Sort.MatchString(this.Sort) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Sort", fmt.Errorf(`value '%v' must be a string conforming to regex "((^|, )(|ref.gid|ref.gtype|^attributes.(.*)|^degree.(in|out)(\\..*)?))+$"`, this.Sort))
+	}
+	if this.Budget != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Budget); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Budget", err)
+		}
+	}
+	return nil
+}
+func (this *Budget) Validate() error {
+	if this.Timeout != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Timeout); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Timeout", err)
+		}
+	}
+	return nil
+}
+
+var _regex_TextSearchFilter_Index = regexp.MustCompile(`^.{1,225}$`)
+var _regex_TextSearchFilter_Query = regexp.MustCompile(`^.{1,1000}$`)
+
+func (this *TextSearchFilter) Validate() error {
+	if !_regex_TextSearchFilter_Index.MatchString(this.Index) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Index", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Index))
+	}
+	if !_regex_TextSearchFilter_Query.MatchString(this.Query) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Query", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,1000}$"`, this.Query))
+	}
+	if !(this.Limit > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '0'`, this.Limit))
+	}
+	return nil
+}
+func (this *ScoredDoc) Validate() error {
+	if this.Doc != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Doc); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Doc", err)
+		}
+	}
+	return nil
+}
+func (this *ScoredDocs) Validate() error {
+	for _, item := range this.Docs {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Docs", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *GeoPoint) Validate() error {
+	if !(this.Lat >= -90) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Lat", fmt.Errorf(`value '%v' must be greater than or equal to '-90'`, this.Lat))
+	}
+	if !(this.Lat <= 90) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Lat", fmt.Errorf(`value '%v' must be lower than or equal to '90'`, this.Lat))
+	}
+	if !(this.Lng >= -180) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Lng", fmt.Errorf(`value '%v' must be greater than or equal to '-180'`, this.Lng))
+	}
+	if !(this.Lng <= 180) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Lng", fmt.Errorf(`value '%v' must be lower than or equal to '180'`, this.Lng))
+	}
+	return nil
+}
+func (this *GeoRadius) Validate() error {
+	if nil == this.Center {
+		return github_com_mwitkow_go_proto_validators.FieldError("Center", fmt.Errorf("message must exist"))
+	}
+	if this.Center != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Center); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Center", err)
+		}
+	}
+	if !(this.Meters > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Meters", fmt.Errorf(`value '%v' must be strictly greater than '0'`, this.Meters))
+	}
+	return nil
+}
+func (this *GeoBox) Validate() error {
+	if nil == this.Min {
+		return github_com_mwitkow_go_proto_validators.FieldError("Min", fmt.Errorf("message must exist"))
+	}
+	if this.Min != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Min); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Min", err)
+		}
+	}
+	if nil == this.Max {
+		return github_com_mwitkow_go_proto_validators.FieldError("Max", fmt.Errorf("message must exist"))
+	}
+	if this.Max != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Max); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Max", err)
+		}
+	}
+	return nil
+}
+
+var _regex_GeoFilter_Index = regexp.MustCompile(`^.{1,225}$`)
+
+func (this *GeoFilter) Validate() error {
+	if !_regex_GeoFilter_Index.MatchString(this.Index) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Index", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Index))
+	}
+	if oneOfNester, ok := this.GetQuery().(*GeoFilter_Radius); ok {
+		if oneOfNester.Radius != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(oneOfNester.Radius); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Radius", err)
+			}
+		}
+	}
+	if oneOfNester, ok := this.GetQuery().(*GeoFilter_Box); ok {
+		if oneOfNester.Box != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(oneOfNester.Box); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Box", err)
+			}
+		}
+	}
+	if oneOfNester, ok := this.GetQuery().(*GeoFilter_Nearest); ok {
+		if oneOfNester.Nearest != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(oneOfNester.Nearest); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Nearest", err)
+			}
+		}
+	}
+	if !(this.Limit > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '0'`, this.Limit))
+	}
+	if this.Traverse != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Traverse); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Traverse", err)
+		}
+	}
+	return nil
+}
+func (this *GeoDoc) Validate() error {
+	if this.Doc != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Doc); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Doc", err)
+		}
+	}
+	return nil
+}
+func (this *GeoDocs) Validate() error {
+	for _, item := range this.Docs {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Docs", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *ExplainFilter) Validate() error {
+	if oneOfNester, ok := this.GetQuery().(*ExplainFilter_SearchDocs); ok {
+		if oneOfNester.SearchDocs != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(oneOfNester.SearchDocs); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("SearchDocs", err)
+			}
+		}
+	}
+	if oneOfNester, ok := this.GetQuery().(*ExplainFilter_SearchConnections); ok {
+		if oneOfNester.SearchConnections != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(oneOfNester.SearchConnections); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("SearchConnections", err)
+			}
+		}
+	}
+	if oneOfNester, ok := this.GetQuery().(*ExplainFilter_Traverse); ok {
+		if oneOfNester.Traverse != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(oneOfNester.Traverse); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Traverse", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *ExplainCandidate) Validate() error {
+	return nil
+}
+func (this *ExplainError) Validate() error {
+	return nil
+}
+func (this *ExplainPhase) Validate() error {
+	if this.Duration != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Duration); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Duration", err)
+		}
+	}
+	return nil
+}
+func (this *Explanation) Validate() error {
+	for _, item := range this.Candidates {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Candidates", err)
+			}
+		}
+	}
+	for _, item := range this.Errors {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Errors", err)
+			}
+		}
+	}
+	for _, item := range this.Phases {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Phases", err)
+			}
+		}
+	}
+	if this.Duration != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Duration); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Duration", err)
+		}
+	}
+	return nil
+}
+
+var _regex_SimilarFilter_Index = regexp.MustCompile(`^.{1,225}$`)
+
+func (this *SimilarFilter) Validate() error {
+	if !_regex_SimilarFilter_Index.MatchString(this.Index) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Index", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Index))
+	}
+	if this.Like != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Like); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Like", err)
+		}
+	}
+	if !(this.Limit > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '0'`, this.Limit))
+	}
+	if this.Traverse != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Traverse); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Traverse", err)
+		}
+	}
+	return nil
+}
+
+var _regex_NeighborFilter_Gtype = regexp.MustCompile(`^.{1,225}$`)
+
+func (this *NeighborFilter) Validate() error {
+	if nil == this.Doc {
+		return github_com_mwitkow_go_proto_validators.FieldError("Doc", fmt.Errorf("message must exist"))
+	}
+	if this.Doc != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Doc); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Doc", err)
+		}
+	}
+	if !_regex_NeighborFilter_Gtype.MatchString(this.Gtype) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Gtype", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Gtype))
+	}
+	if !(this.Limit > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '0'`, this.Limit))
+	}
+	if this.Budget != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Budget); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Budget", err)
+		}
+	}
+	return nil
+}
+func (this *NeighborDoc) Validate() error {
+	if this.Doc != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Doc); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Doc", err)
+		}
+	}
+	return nil
+}
+func (this *NeighborDocs) Validate() error {
+	for _, item := range this.Docs {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Docs", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *SimilarDoc) Validate() error {
+	if this.Doc != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Doc); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Doc", err)
+		}
+	}
+	return nil
+}
+func (this *SimilarDocs) Validate() error {
+	for _, item := range this.Docs {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Docs", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_AggFilter_Field = regexp.MustCompile(`((^|, )(|^attributes.(.*)))+$`)
+
+func (this *AggFilter) Validate() error {
+	if nil == this.Filter {
+		return github_com_mwitkow_go_proto_validators.FieldError("Filter", fmt.Errorf("message must exist"))
+	}
+	if this.Filter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Filter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
+		}
+	}
+	if !_regex_AggFilter_Field.MatchString(this.Field) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Field", fmt.Errorf(`value '%v' must be a string conforming to regex "((^|, )(|^attributes.(.*)))+$"`, this.Field))
 	}
-	if !(this.Limit > 0) {
-		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '0'`, this.Limit))
+	if !(this.Percentile >= 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Percentile", fmt.Errorf(`value '%v' must be greater than or equal to '0'`, this.Percentile))
 	}
-	if !_regex_ConnectFilter_Sort.MatchString(this.Sort) {
-		return github_com_mwitkow_go_proto_validators.FieldError("Sort", fmt.Errorf(`value '%v' must be a string conforming to regex "((^|, )(|ref.gid|ref.gtype|^attributes.(.*)))+$"`, this.Sort))
+	if !(this.Percentile <= 100) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Percentile", fmt.Errorf(`value '%v' must be lower than or equal to '100'`, this.Percentile))
 	}
 	return nil
 }
 
-var _regex_Filter_Gtype = regexp.MustCompile(`^.{1,225}$`)
-var _regex_Filter_Sort = regexp.MustCompile(`((^|, )(|ref.gid|ref.gtype|^attributes.(.*)))+$`)
+var _regex_AggField_Field = regexp.MustCompile(`((^|, )(|^attributes.(.*)))+$`)
 
-func (this *Filter) Validate() error {
-	if !_regex_Filter_Gtype.MatchString(this.Gtype) {
-		return github_com_mwitkow_go_proto_validators.FieldError("Gtype", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Gtype))
+func (this *AggField) Validate() error {
+	if !_regex_AggField_Field.MatchString(this.Field) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Field", fmt.Errorf(`value '%v' must be a string conforming to regex "((^|, )(|^attributes.(.*)))+$"`, this.Field))
 	}
-	if !(this.Limit > 0) {
-		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '0'`, this.Limit))
+	if !(this.Percentile >= 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Percentile", fmt.Errorf(`value '%v' must be greater than or equal to '0'`, this.Percentile))
 	}
-	if !_regex_Filter_Sort.MatchString(this.Sort) {
-		return github_com_mwitkow_go_proto_validators.FieldError("Sort", fmt.Errorf(`value '%v' must be a string conforming to regex "((^|, )(|ref.gid|ref.gtype|^attributes.(.*)))+$"`, this.Sort))
+	if !(this.Percentile <= 100) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Percentile", fmt.Errorf(`value '%v' must be lower than or equal to '100'`, this.Percentile))
+	}
+	if !(this.BucketSize >= 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("BucketSize", fmt.Errorf(`value '%v' must be greater than or equal to '0'`, this.BucketSize))
 	}
 	return nil
 }
-
-var _regex_AggFilter_Field = regexp.MustCompile(`((^|, )(|^attributes.(.*)))+$`)
-
-func (this *AggFilter) Validate() error {
+func (this *GroupAggFilter) Validate() error {
 	if nil == this.Filter {
 		return github_com_mwitkow_go_proto_validators.FieldError("Filter", fmt.Errorf("message must exist"))
 	}
@@ -299,8 +873,53 @@ func (this *AggFilter) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("Filter", err)
 		}
 	}
-	if !_regex_AggFilter_Field.MatchString(this.Field) {
-		return github_com_mwitkow_go_proto_validators.FieldError("Field", fmt.Errorf(`value '%v' must be a string conforming to regex "((^|, )(|^attributes.(.*)))+$"`, this.Field))
+	if len(this.Aggregates) < 1 {
+		return github_com_mwitkow_go_proto_validators.FieldError("Aggregates", fmt.Errorf(`value '%v' must contain at least 1 elements`, this.Aggregates))
+	}
+	for _, item := range this.Aggregates {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Aggregates", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *AggValue) Validate() error {
+	for _, item := range this.Buckets {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Buckets", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *HistogramBucket) Validate() error {
+	return nil
+}
+func (this *AggGroup) Validate() error {
+	if this.Key != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Key); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Key", err)
+		}
+	}
+	for _, item := range this.Values {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Values", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *AggGroups) Validate() error {
+	for _, item := range this.Groups {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Groups", err)
+			}
+		}
 	}
 	return nil
 }
@@ -328,6 +947,11 @@ func (this *TraverseFilter) Validate() error {
 	if !(this.MaxHops > 0) {
 		return github_com_mwitkow_go_proto_validators.FieldError("MaxHops", fmt.Errorf(`value '%v' must be greater than '0'`, this.MaxHops))
 	}
+	if this.Budget != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Budget); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Budget", err)
+		}
+	}
 	return nil
 }
 
@@ -346,6 +970,11 @@ func (this *TraverseMeFilter) Validate() error {
 	if !(this.MaxHops > 0) {
 		return github_com_mwitkow_go_proto_validators.FieldError("MaxHops", fmt.Errorf(`value '%v' must be greater than '0'`, this.MaxHops))
 	}
+	if this.Budget != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Budget); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Budget", err)
+		}
+	}
 	return nil
 }
 
@@ -391,7 +1020,7 @@ func (this *Authorizers) Validate() error {
 
 var _regex_TypeValidator_Name = regexp.MustCompile(`^.{1,225}$`)
 var _regex_TypeValidator_Gtype = regexp.MustCompile(`^.{1,225}$`)
-var _regex_TypeValidator_Expression = regexp.MustCompile(`^.{1,225}$`)
+var _regex_TypeValidator_Expression = regexp.MustCompile(`^.{0,225}$`)
 
 func (this *TypeValidator) Validate() error {
 	if !_regex_TypeValidator_Name.MatchString(this.Name) {
@@ -401,7 +1030,7 @@ func (this *TypeValidator) Validate() error {
 		return github_com_mwitkow_go_proto_validators.FieldError("Gtype", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Gtype))
 	}
 	if !_regex_TypeValidator_Expression.MatchString(this.Expression) {
-		return github_com_mwitkow_go_proto_validators.FieldError("Expression", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Expression))
+		return github_com_mwitkow_go_proto_validators.FieldError("Expression", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{0,225}$"`, this.Expression))
 	}
 	return nil
 }
@@ -418,7 +1047,7 @@ func (this *TypeValidators) Validate() error {
 
 var _regex_Index_Name = regexp.MustCompile(`^.{1,225}$`)
 var _regex_Index_Gtype = regexp.MustCompile(`^.{1,225}$`)
-var _regex_Index_Expression = regexp.MustCompile(`^.{1,225}$`)
+var _regex_Index_Expression = regexp.MustCompile(`^.{0,225}$`)
 
 func (this *Index) Validate() error {
 	if !_regex_Index_Name.MatchString(this.Name) {
@@ -428,7 +1057,7 @@ func (this *Index) Validate() error {
 		return github_com_mwitkow_go_proto_validators.FieldError("Gtype", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Gtype))
 	}
 	if !_regex_Index_Expression.MatchString(this.Expression) {
-		return github_com_mwitkow_go_proto_validators.FieldError("Expression", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Expression))
+		return github_com_mwitkow_go_proto_validators.FieldError("Expression", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{0,225}$"`, this.Expression))
 	}
 	return nil
 }
@@ -467,6 +1096,33 @@ func (this *Graph) Validate() error {
 func (this *Flags) Validate() error {
 	return nil
 }
+func (this *MutationResult) Validate() error {
+	for _, item := range this.Refs {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Refs", err)
+			}
+		}
+	}
+	for _, item := range this.Cascaded {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Cascaded", err)
+			}
+		}
+	}
+	if this.Docs != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Docs); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Docs", err)
+		}
+	}
+	if this.Connections != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Connections); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Connections", err)
+		}
+	}
+	return nil
+}
 func (this *Boolean) Validate() error {
 	return nil
 }
@@ -515,6 +1171,256 @@ func (this *EditFilter) Validate() error {
 	}
 	return nil
 }
+func (this *DocUpsert) Validate() error {
+	if nil == this.Ref {
+		return github_com_mwitkow_go_proto_validators.FieldError("Ref", fmt.Errorf("message must exist"))
+	}
+	if this.Ref != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Ref); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Ref", err)
+		}
+	}
+	if this.Attributes != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Attributes); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Attributes", err)
+		}
+	}
+	return nil
+}
+func (this *DocUpserts) Validate() error {
+	for _, item := range this.Docs {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Docs", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *ConnectionUpsert) Validate() error {
+	if nil == this.Ref {
+		return github_com_mwitkow_go_proto_validators.FieldError("Ref", fmt.Errorf("message must exist"))
+	}
+	if this.Ref != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Ref); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Ref", err)
+		}
+	}
+	if this.Attributes != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Attributes); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Attributes", err)
+		}
+	}
+	if nil == this.From {
+		return github_com_mwitkow_go_proto_validators.FieldError("From", fmt.Errorf("message must exist"))
+	}
+	if this.From != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.From); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("From", err)
+		}
+	}
+	if nil == this.To {
+		return github_com_mwitkow_go_proto_validators.FieldError("To", fmt.Errorf("message must exist"))
+	}
+	if this.To != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.To); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("To", err)
+		}
+	}
+	return nil
+}
+func (this *ConnectionUpserts) Validate() error {
+	for _, item := range this.Connections {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Connections", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_TypeRename_From = regexp.MustCompile(`^.{1,225}$`)
+var _regex_TypeRename_To = regexp.MustCompile(`^.{1,225}$`)
+
+func (this *TypeRename) Validate() error {
+	if !_regex_TypeRename_From.MatchString(this.From) {
+		return github_com_mwitkow_go_proto_validators.FieldError("From", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.From))
+	}
+	if !_regex_TypeRename_To.MatchString(this.To) {
+		return github_com_mwitkow_go_proto_validators.FieldError("To", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.To))
+	}
+	return nil
+}
+
+var _regex_AttributeTransform_Gtype = regexp.MustCompile(`^.{1,225}$`)
+var _regex_AttributeTransform_Expression = regexp.MustCompile(`^.{1,1000}$`)
+
+func (this *AttributeTransform) Validate() error {
+	if !_regex_AttributeTransform_Gtype.MatchString(this.Gtype) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Gtype", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Gtype))
+	}
+	if !_regex_AttributeTransform_Expression.MatchString(this.Expression) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Expression", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,1000}$"`, this.Expression))
+	}
+	return nil
+}
+func (this *Migration) Validate() error {
+	if !(this.Version > 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Version", fmt.Errorf(`value '%v' must be greater than '0'`, this.Version))
+	}
+	for _, item := range this.RenameDocs {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("RenameDocs", err)
+			}
+		}
+	}
+	for _, item := range this.RenameConnections {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("RenameConnections", err)
+			}
+		}
+	}
+	for _, item := range this.TransformDocs {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("TransformDocs", err)
+			}
+		}
+	}
+	for _, item := range this.TransformConnections {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("TransformConnections", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *MigrationStatus) Validate() error {
+	if this.Migration != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Migration); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Migration", err)
+		}
+	}
+	if this.User != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.User); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("User", err)
+		}
+	}
+	if this.StartedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.StartedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("StartedAt", err)
+		}
+	}
+	if this.CompletedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.CompletedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("CompletedAt", err)
+		}
+	}
+	return nil
+}
+func (this *MigrationStatuses) Validate() error {
+	for _, item := range this.Migrations {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Migrations", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *Subgraph) Validate() error {
+	if len(this.DocGtypes) < 1 {
+		return github_com_mwitkow_go_proto_validators.FieldError("DocGtypes", fmt.Errorf(`value '%v' must contain at least 1 elements`, this.DocGtypes))
+	}
+	if len(this.ConnectionGtypes) < 1 {
+		return github_com_mwitkow_go_proto_validators.FieldError("ConnectionGtypes", fmt.Errorf(`value '%v' must contain at least 1 elements`, this.ConnectionGtypes))
+	}
+	return nil
+}
+func (this *AnalyticsJob) Validate() error {
+	if nil == this.Subgraph {
+		return github_com_mwitkow_go_proto_validators.FieldError("Subgraph", fmt.Errorf("message must exist"))
+	}
+	if this.Subgraph != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Subgraph); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Subgraph", err)
+		}
+	}
+	if !(this.Damping >= 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Damping", fmt.Errorf(`value '%v' must be greater than or equal to '0'`, this.Damping))
+	}
+	if !(this.Damping <= 1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Damping", fmt.Errorf(`value '%v' must be lower than or equal to '1'`, this.Damping))
+	}
+	if !(this.Tolerance >= 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Tolerance", fmt.Errorf(`value '%v' must be greater than or equal to '0'`, this.Tolerance))
+	}
+	return nil
+}
+
+var _regex_AnalyticsRef_Id = regexp.MustCompile(`^.{1,225}$`)
+
+func (this *AnalyticsRef) Validate() error {
+	if !_regex_AnalyticsRef_Id.MatchString(this.Id) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must be a string conforming to regex "^.{1,225}$"`, this.Id))
+	}
+	return nil
+}
+func (this *AnalyticsStatus) Validate() error {
+	if this.Job != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Job); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Job", err)
+		}
+	}
+	if this.User != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.User); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("User", err)
+		}
+	}
+	if this.StartedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.StartedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("StartedAt", err)
+		}
+	}
+	if this.CompletedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.CompletedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("CompletedAt", err)
+		}
+	}
+	for _, item := range this.Components {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Components", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *Component) Validate() error {
+	return nil
+}
+func (this *AnalyticsStatuses) Validate() error {
+	for _, item := range this.Jobs {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Jobs", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *AnalyticsResult) Validate() error {
+	if this.Ref != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Ref); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Ref", err)
+		}
+	}
+	return nil
+}
 func (this *Pong) Validate() error {
 	return nil
 }
@@ -0,0 +1,185 @@
+// Package geo contains the geohash encoding & distance math used by geo indexes
+package geo
+
+import (
+	"math"
+	"strings"
+)
+
+const (
+	// MaxPrecision is the number of geohash characters stored for each point(~3.7cm x 1.9cm cells)
+	MaxPrecision = 12
+	// earthRadius is the mean radius of the earth in meters
+	earthRadius = 6371008.8
+	// MaxDistance is half the circumference of the earth in meters - no two points are further apart
+	MaxDistance = math.Pi * earthRadius
+	base32      = "0123456789bcdefghjkmnpqrstuvwxyz"
+)
+
+// Point is a latitude/longitude pair in degrees
+type Point struct {
+	Lat float64
+	Lng float64
+}
+
+// Valid returns true if the point's latitude & longitude are within range
+func (p Point) Valid() bool {
+	return p.Lat >= -90 && p.Lat <= 90 && p.Lng >= -180 && p.Lng <= 180
+}
+
+// Box is a bounding box defined by it's south west(Min) & north east(Max) corners
+type Box struct {
+	Min Point
+	Max Point
+}
+
+// Contains returns true if the point is within the box(inclusive)
+func (b Box) Contains(p Point) bool {
+	return p.Lat >= b.Min.Lat && p.Lat <= b.Max.Lat && p.Lng >= b.Min.Lng && p.Lng <= b.Max.Lng
+}
+
+// Encode returns the geohash of a point with the given number of characters
+func Encode(p Point, precision int) string {
+	var (
+		hash     strings.Builder
+		latRange = [2]float64{-90, 90}
+		lngRange = [2]float64{-180, 180}
+		even     = true
+		bit      int
+		ch       int
+	)
+	for hash.Len() < precision {
+		if even {
+			mid := (lngRange[0] + lngRange[1]) / 2
+			if p.Lng >= mid {
+				ch = ch<<1 | 1
+				lngRange[0] = mid
+			} else {
+				ch = ch << 1
+				lngRange[1] = mid
+			}
+		} else {
+			mid := (latRange[0] + latRange[1]) / 2
+			if p.Lat >= mid {
+				ch = ch<<1 | 1
+				latRange[0] = mid
+			} else {
+				ch = ch << 1
+				latRange[1] = mid
+			}
+		}
+		even = !even
+		bit++
+		if bit == 5 {
+			hash.WriteByte(base32[ch])
+			bit, ch = 0, 0
+		}
+	}
+	return hash.String()
+}
+
+// CellSize returns the height & width(in degrees) of a geohash cell with the given number of characters
+func CellSize(precision int) (float64, float64) {
+	bits := 5 * precision
+	lngBits := (bits + 1) / 2
+	latBits := bits / 2
+	return 180 / math.Pow(2, float64(latBits)), 360 / math.Pow(2, float64(lngBits))
+}
+
+// Cover returns the geohash cells that cover the box. The most precise cells are used such that no more than maxCells are returned.
+func Cover(box Box, maxCells int) []string {
+	for precision := MaxPrecision; precision > 1; precision-- {
+		if cells := cover(box, precision, maxCells); cells != nil {
+			return cells
+		}
+	}
+	return cover(box, 1, math.MaxInt32)
+}
+
+func cover(box Box, precision int, maxCells int) []string {
+	height, width := CellSize(precision)
+	minLat, maxLat := cellIndex(box.Min.Lat, -90, height), cellIndex(box.Max.Lat, -90, height)
+	minLng, maxLng := cellIndex(box.Min.Lng, -180, width), cellIndex(box.Max.Lng, -180, width)
+	if (maxLat-minLat+1)*(maxLng-minLng+1) > maxCells {
+		return nil
+	}
+	var cells []string
+	for lat := minLat; lat <= maxLat; lat++ {
+		for lng := minLng; lng <= maxLng; lng++ {
+			cells = append(cells, Encode(Point{
+				Lat: math.Min(-90+(float64(lat)+.5)*height, 90),
+				Lng: math.Min(-180+(float64(lng)+.5)*width, 180),
+			}, precision))
+		}
+	}
+	return cells
+}
+
+func cellIndex(value, origin, size float64) int {
+	return int(math.Floor((value - origin) / size))
+}
+
+// Distance returns the great-circle distance between two points in meters(haversine)
+func Distance(a, b Point) float64 {
+	lat1, lat2 := radians(a.Lat), radians(b.Lat)
+	dLat := lat2 - lat1
+	dLng := radians(b.Lng - a.Lng)
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(dLng/2), 2)
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// RadiusBoxes returns the bounding boxes that contain every point within radius meters of the center. Two boxes are returned
+// if the circle crosses the antimeridian.
+func RadiusBoxes(center Point, radius float64) []Box {
+	dLat := degrees(radius / earthRadius)
+	minLat, maxLat := center.Lat-dLat, center.Lat+dLat
+	if minLat <= -90 || maxLat >= 90 {
+		// the circle contains a pole so it spans every longitude
+		return []Box{{
+			Min: Point{Lat: math.Max(minLat, -90), Lng: -180},
+			Max: Point{Lat: math.Min(maxLat, 90), Lng: 180},
+		}}
+	}
+	dLng := degrees(math.Asin(math.Min(1, math.Sin(radius/earthRadius)/math.Cos(radians(center.Lat)))))
+	if radius >= MaxDistance/2 {
+		dLng = 180
+	}
+	return SplitBox(Box{
+		Min: Point{Lat: minLat, Lng: center.Lng - dLng},
+		Max: Point{Lat: maxLat, Lng: center.Lng + dLng},
+	})
+}
+
+// SplitBox splits a box that crosses the antimeridian(longitudes outside -180/180) into two boxes
+func SplitBox(box Box) []Box {
+	switch {
+	case box.Max.Lng-box.Min.Lng >= 360:
+		box.Min.Lng, box.Max.Lng = -180, 180
+		return []Box{box}
+	case box.Min.Lng < -180:
+		return []Box{
+			{Min: Point{Lat: box.Min.Lat, Lng: box.Min.Lng + 360}, Max: Point{Lat: box.Max.Lat, Lng: 180}},
+			{Min: Point{Lat: box.Min.Lat, Lng: -180}, Max: box.Max},
+		}
+	case box.Max.Lng > 180:
+		return []Box{
+			{Min: box.Min, Max: Point{Lat: box.Max.Lat, Lng: 180}},
+			{Min: Point{Lat: box.Min.Lat, Lng: -180}, Max: Point{Lat: box.Max.Lat, Lng: box.Max.Lng - 360}},
+		}
+	case box.Min.Lng > box.Max.Lng:
+		// a box given west -> east across the antimeridian ex: 170 -> -170
+		return []Box{
+			{Min: box.Min, Max: Point{Lat: box.Max.Lat, Lng: 180}},
+			{Min: Point{Lat: box.Min.Lat, Lng: -180}, Max: box.Max},
+		}
+	}
+	return []Box{box}
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func degrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
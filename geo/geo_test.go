@@ -0,0 +1,37 @@
+package geo_test
+
+import (
+	"github.com/graphikDB/graphik/geo"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestEncode(t *testing.T) {
+	if hash := geo.Encode(geo.Point{Lat: 57.64911, Lng: 10.40744}, 11); hash != "u4pruydqqvj" {
+		t.Fatalf("expected u4pruydqqvj, got %s", hash)
+	}
+}
+
+func TestDistance(t *testing.T) {
+	// new york -> london ~5570km
+	dist := geo.Distance(geo.Point{Lat: 40.7128, Lng: -74.0060}, geo.Point{Lat: 51.5074, Lng: -0.1278})
+	if math.Abs(dist-5570000) > 10000 {
+		t.Fatalf("expected ~5570km, got %v", dist)
+	}
+}
+
+func TestCover(t *testing.T) {
+	box := geo.Box{Min: geo.Point{Lat: 40.70, Lng: -74.02}, Max: geo.Point{Lat: 40.72, Lng: -74.00}}
+	cells := geo.Cover(box, 16)
+	if len(cells) == 0 || len(cells) > 16 {
+		t.Fatalf("expected 1-16 cells, got %v", len(cells))
+	}
+	hash := geo.Encode(geo.Point{Lat: 40.71, Lng: -74.01}, geo.MaxPrecision)
+	for _, cell := range cells {
+		if strings.HasPrefix(hash, cell) {
+			return
+		}
+	}
+	t.Fatalf("expected %s to be covered by %v", hash, cells)
+}
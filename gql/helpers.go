@@ -3,6 +3,9 @@ package gql
 import (
 	"github.com/graphikDB/graphik/gen/gql/go/model"
 	apipb "github.com/graphikDB/graphik/gen/grpc/go"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"sort"
+	"time"
 )
 
 func protoExists(has model.ExistsFilter) *apipb.ExistsFilter {
@@ -54,10 +57,55 @@ func protoDoc(d model.Doc) *apipb.Doc {
 }
 
 func protoDocC(d model.DocConstructor) *apipb.DocConstructor {
-	return &apipb.DocConstructor{
+	c := &apipb.DocConstructor{
 		Ref:        protoRefC(d.Ref),
 		Attributes: apipb.NewStruct(d.Attributes),
 	}
+	if d.IdempotencyKey != nil {
+		c.IdempotencyKey = *d.IdempotencyKey
+	}
+	return c
+}
+
+func protoDocUpsert(d model.DocUpsert) *apipb.DocUpsert {
+	u := &apipb.DocUpsert{
+		Ref:        protoIRef(*d.Ref),
+		Attributes: apipb.NewStruct(d.Attributes),
+	}
+	if d.Expression != nil {
+		u.Expression = *d.Expression
+	}
+	return u
+}
+
+func protoDocUpserts(us model.DocUpserts) *apipb.DocUpserts {
+	converted := &apipb.DocUpserts{}
+	for _, u := range us.Docs {
+		converted.Docs = append(converted.Docs, protoDocUpsert(*u))
+	}
+	return converted
+}
+
+func protoConnectionUpsert(d model.ConnectionUpsert) *apipb.ConnectionUpsert {
+	u := &apipb.ConnectionUpsert{
+		Ref:        protoIRef(*d.Ref),
+		Attributes: apipb.NewStruct(d.Attributes),
+		Directed:   d.Directed,
+		From:       protoIRef(*d.From),
+		To:         protoIRef(*d.To),
+	}
+	if d.Expression != nil {
+		u.Expression = *d.Expression
+	}
+	return u
+}
+
+func protoConnectionUpserts(us model.ConnectionUpserts) *apipb.ConnectionUpserts {
+	converted := &apipb.ConnectionUpserts{}
+	for _, u := range us.Connections {
+		converted.Connections = append(converted.Connections, protoConnectionUpsert(*u))
+	}
+	return converted
 }
 
 func protoEdit(e model.Edit) *apipb.Edit {
@@ -78,13 +126,17 @@ func protoConnection(d *model.Connection) *apipb.Connection {
 }
 
 func protoConnectionC(d model.ConnectionConstructor) *apipb.ConnectionConstructor {
-	return &apipb.ConnectionConstructor{
+	c := &apipb.ConnectionConstructor{
 		Ref:        protoRefC(d.Ref),
 		Attributes: apipb.NewStruct(d.Attributes),
 		Directed:   d.Directed,
 		From:       protoIRef(*d.From),
 		To:         protoIRef(*d.To),
 	}
+	if d.IdempotencyKey != nil {
+		c.IdempotencyKey = *d.IdempotencyKey
+	}
+	return c
 }
 
 func protoConnectionCs(cs model.ConnectionConstructors) *apipb.ConnectionConstructors {
@@ -128,9 +180,29 @@ func protoFilter(filter model.Filter) *apipb.Filter {
 	if filter.Reverse != nil {
 		f.Reverse = *filter.Reverse
 	}
+	if filter.DryRun != nil {
+		f.DryRun = *filter.DryRun
+	}
+	if filter.Budget != nil {
+		f.Budget = protoBudget(*filter.Budget)
+	}
 	return f
 }
 
+func protoBudget(budget model.Budget) *apipb.Budget {
+	b := &apipb.Budget{}
+	if budget.MaxScan != nil {
+		b.MaxScan = uint64(*budget.MaxScan)
+	}
+	if budget.MaxEvaluations != nil {
+		b.MaxEvaluations = uint64(*budget.MaxEvaluations)
+	}
+	if budget.TimeoutMs != nil {
+		b.Timeout = durationpb.New(time.Duration(*budget.TimeoutMs) * time.Millisecond)
+	}
+	return b
+}
+
 func protoEditFilter(filter model.EditFilter) *apipb.EditFilter {
 	return &apipb.EditFilter{
 		Filter:     protoFilter(*filter.Filter),
@@ -185,6 +257,242 @@ func gqlTraversals(d *apipb.Traversals) *model.Traversals {
 	}
 }
 
+func protoPathFilter(filter model.PathFilter) *apipb.PathFilter {
+	f := &apipb.PathFilter{
+		Source: protoIRef(*filter.Source),
+		Target: protoIRef(*filter.Target),
+	}
+	if filter.ConnectionExpression != nil {
+		f.ConnectionExpression = *filter.ConnectionExpression
+	}
+	if filter.Weight != nil {
+		f.Weight = *filter.Weight
+	}
+	if filter.Reverse != nil {
+		f.Reverse = *filter.Reverse
+	}
+	if filter.K != nil {
+		f.K = uint32(*filter.K)
+	}
+	if filter.Budget != nil {
+		f.Budget = protoBudget(*filter.Budget)
+	}
+	return f
+}
+
+func protoAllPathsFilter(filter model.AllPathsFilter) *apipb.AllPathsFilter {
+	f := &apipb.AllPathsFilter{
+		Source:   protoIRef(*filter.Source),
+		Target:   protoIRef(*filter.Target),
+		MaxDepth: uint64(filter.MaxDepth),
+		MaxPaths: uint64(filter.MaxPaths),
+	}
+	if filter.DocExpression != nil {
+		f.DocExpression = *filter.DocExpression
+	}
+	if filter.ConnectionExpression != nil {
+		f.ConnectionExpression = *filter.ConnectionExpression
+	}
+	if filter.Reverse != nil {
+		f.Reverse = *filter.Reverse
+	}
+	if filter.Budget != nil {
+		f.Budget = protoBudget(*filter.Budget)
+	}
+	return f
+}
+
+func protoMatchFilter(filter model.MatchFilter) *apipb.MatchFilter {
+	f := &apipb.MatchFilter{
+		Limit: uint64(filter.Limit),
+	}
+	for _, node := range filter.Nodes {
+		n := &apipb.NodePattern{}
+		if node.Name != nil {
+			n.Name = *node.Name
+		}
+		if node.Gtype != nil {
+			n.Gtype = *node.Gtype
+		}
+		if node.Expression != nil {
+			n.Expression = *node.Expression
+		}
+		if node.Ref != nil {
+			n.Ref = protoIRef(*node.Ref)
+		}
+		f.Nodes = append(f.Nodes, n)
+	}
+	for _, edge := range filter.Edges {
+		e := &apipb.EdgePattern{}
+		if edge.Name != nil {
+			e.Name = *edge.Name
+		}
+		if edge.Gtype != nil {
+			e.Gtype = *edge.Gtype
+		}
+		if edge.Expression != nil {
+			e.Expression = *edge.Expression
+		}
+		if edge.Direction != nil {
+			e.Direction = protoDirection(*edge.Direction)
+		}
+		if edge.MinHops != nil {
+			e.MinHops = uint32(*edge.MinHops)
+		}
+		if edge.MaxHops != nil {
+			e.MaxHops = uint32(*edge.MaxHops)
+		}
+		f.Edges = append(f.Edges, e)
+	}
+	if filter.Budget != nil {
+		f.Budget = protoBudget(*filter.Budget)
+	}
+	return f
+}
+
+// gqlMatches converts the bindings of each match to lists sorted by name
+func gqlMatches(matches *apipb.Matches) *model.Matches {
+	var converted []*model.Match
+	for _, m := range matches.GetMatches() {
+		match := &model.Match{}
+		for name, doc := range m.GetDocs() {
+			match.Docs = append(match.Docs, &model.DocBinding{Name: name, Doc: gqlDoc(doc)})
+		}
+		sort.Slice(match.Docs, func(i, j int) bool {
+			return match.Docs[i].Name < match.Docs[j].Name
+		})
+		for name, connections := range m.GetConnections() {
+			binding := &model.ConnectionBinding{Name: name}
+			for _, connection := range connections.GetConnections() {
+				binding.Connections = append(binding.Connections, gqlConnection(connection))
+			}
+			match.Connections = append(match.Connections, binding)
+		}
+		sort.Slice(match.Connections, func(i, j int) bool {
+			return match.Connections[i].Name < match.Connections[j].Name
+		})
+		converted = append(converted, match)
+	}
+	return &model.Matches{Matches: converted}
+}
+
+func gqlPaths(paths *apipb.Paths) *model.Paths {
+	var converted []*model.Path
+	for _, p := range paths.GetPaths() {
+		converted = append(converted, gqlPath(p))
+	}
+	return &model.Paths{Paths: converted}
+}
+
+func gqlPath(p *apipb.Path) *model.Path {
+	path := &model.Path{Cost: p.GetCost()}
+	for _, doc := range p.GetDocs() {
+		path.Docs = append(path.Docs, gqlDoc(doc))
+	}
+	for _, connection := range p.GetConnections() {
+		path.Connections = append(path.Connections, gqlConnection(connection))
+	}
+	return path
+}
+
+func protoTopologicalSortFilter(filter model.TopologicalSortFilter) *apipb.TopologicalSortFilter {
+	f := &apipb.TopologicalSortFilter{
+		Gtype: filter.Gtype,
+	}
+	if filter.Expression != nil {
+		f.Expression = *filter.Expression
+	}
+	if filter.MaxCycles != nil {
+		f.MaxCycles = uint32(*filter.MaxCycles)
+	}
+	if filter.Budget != nil {
+		f.Budget = protoBudget(*filter.Budget)
+	}
+	return f
+}
+
+func protoExtractFilter(filter model.ExtractFilter) *apipb.ExtractFilter {
+	f := &apipb.ExtractFilter{
+		MaxDepth: uint64(filter.MaxDepth),
+		Limit:    uint64(filter.Limit),
+	}
+	for _, root := range filter.Roots {
+		f.Roots = append(f.Roots, protoIRef(*root))
+	}
+	if filter.DocExpression != nil {
+		f.DocExpression = *filter.DocExpression
+	}
+	if filter.ConnectionExpression != nil {
+		f.ConnectionExpression = *filter.ConnectionExpression
+	}
+	if filter.Direction != nil {
+		f.Direction = protoDirection(*filter.Direction)
+	}
+	if filter.Budget != nil {
+		f.Budget = protoBudget(*filter.Budget)
+	}
+	return f
+}
+
+func protoNeighborFilter(filter model.NeighborFilter) *apipb.NeighborFilter {
+	f := &apipb.NeighborFilter{
+		Doc:   protoIRef(*filter.Doc),
+		Gtype: filter.Gtype,
+		Limit: uint64(filter.Limit),
+	}
+	if filter.Direction != nil {
+		f.Direction = protoDirection(*filter.Direction)
+	}
+	if filter.Metric != nil {
+		switch *filter.Metric {
+		case model.NeighborMetricJaccard:
+			f.Metric = apipb.NeighborMetric_JACCARD
+		case model.NeighborMetricAdamicAdar:
+			f.Metric = apipb.NeighborMetric_ADAMIC_ADAR
+		}
+	}
+	if filter.Expression != nil {
+		f.Expression = *filter.Expression
+	}
+	if filter.ExcludeNeighbors != nil {
+		f.ExcludeNeighbors = *filter.ExcludeNeighbors
+	}
+	if filter.Budget != nil {
+		f.Budget = protoBudget(*filter.Budget)
+	}
+	return f
+}
+
+func gqlNeighborDocs(docs *apipb.NeighborDocs) *model.NeighborDocs {
+	var converted []*model.NeighborDoc
+	for _, doc := range docs.GetDocs() {
+		converted = append(converted, &model.NeighborDoc{
+			Doc:    gqlDoc(doc.GetDoc()),
+			Score:  doc.GetScore(),
+			Common: int(doc.GetCommon()),
+		})
+	}
+	return &model.NeighborDocs{Docs: converted}
+}
+
+func gqlGraph(graph *apipb.Graph) *model.Graph {
+	return &model.Graph{
+		Docs:        gqlDocs(graph.GetDocs()),
+		Connections: gqlConnections(graph.GetConnections()),
+	}
+}
+
+func gqlTopologicalOrder(order *apipb.TopologicalOrder) *model.TopologicalOrder {
+	converted := &model.TopologicalOrder{Acyclic: order.GetAcyclic()}
+	for _, ref := range order.GetRefs() {
+		converted.Refs = append(converted.Refs, gqlRef(ref))
+	}
+	for _, cycle := range order.GetCycles() {
+		converted.Cycles = append(converted.Cycles, gqlPath(cycle))
+	}
+	return converted
+}
+
 func gqlConnection(d *apipb.Connection) *model.Connection {
 	return &model.Connection{
 		Ref:        gqlRef(d.GetRef()),
@@ -215,8 +523,12 @@ func gqlAuthorizer(val *apipb.Authorizer) *model.Authorizer {
 
 func gqlTypeValidator(val *apipb.TypeValidator) *model.TypeValidator {
 	return &model.TypeValidator{
-		Name:       val.GetName(),
-		Expression: val.GetExpression(),
+		Name:        val.GetName(),
+		Gtype:       val.GetGtype(),
+		Expression:  val.GetExpression(),
+		Docs:        val.GetDocs(),
+		Connections: val.GetConnections(),
+		Acyclic:     val.GetAcyclic(),
 	}
 }
 
@@ -227,6 +539,62 @@ func gqlIndex(val *apipb.Index) *model.Index {
 		Expression:  val.GetExpression(),
 		Connections: val.Connections,
 		Docs:        val.Docs,
+		Kind:        gqlIndexKind(val.GetKind()),
+		Fields:      val.GetFields(),
+		Metric:      gqlVectorMetric(val.GetMetric()),
+		Dimensions:  int(val.GetDimensions()),
+	}
+}
+
+func gqlIndexKind(kind apipb.IndexKind) model.IndexKind {
+	switch kind {
+	case apipb.IndexKind_FULL_TEXT:
+		return model.IndexKindFullText
+	case apipb.IndexKind_GEO:
+		return model.IndexKindGeo
+	case apipb.IndexKind_VECTOR:
+		return model.IndexKindVector
+	case apipb.IndexKind_VALUE:
+		return model.IndexKindValue
+	default:
+		return model.IndexKindExpression
+	}
+}
+
+func protoIndexKind(kind model.IndexKind) apipb.IndexKind {
+	switch kind {
+	case model.IndexKindFullText:
+		return apipb.IndexKind_FULL_TEXT
+	case model.IndexKindGeo:
+		return apipb.IndexKind_GEO
+	case model.IndexKindVector:
+		return apipb.IndexKind_VECTOR
+	case model.IndexKindValue:
+		return apipb.IndexKind_VALUE
+	default:
+		return apipb.IndexKind_EXPRESSION
+	}
+}
+
+func gqlVectorMetric(metric apipb.VectorMetric) model.VectorMetric {
+	switch metric {
+	case apipb.VectorMetric_DOT:
+		return model.VectorMetricDot
+	case apipb.VectorMetric_L2:
+		return model.VectorMetricL2
+	default:
+		return model.VectorMetricCosine
+	}
+}
+
+func protoVectorMetric(metric model.VectorMetric) apipb.VectorMetric {
+	switch metric {
+	case model.VectorMetricDot:
+		return apipb.VectorMetric_DOT
+	case model.VectorMetricL2:
+		return apipb.VectorMetric_L2
+	default:
+		return apipb.VectorMetric_COSINE
 	}
 }
 
@@ -276,6 +644,14 @@ func protoAggregate(a model.Aggregate) apipb.Aggregate {
 		return apipb.Aggregate_PROD
 	case model.AggregateSum:
 		return apipb.Aggregate_SUM
+	case model.AggregateCountDistinct:
+		return apipb.Aggregate_COUNT_DISTINCT
+	case model.AggregatePercentile:
+		return apipb.Aggregate_PERCENTILE
+	case model.AggregateStddev:
+		return apipb.Aggregate_STDDEV
+	case model.AggregateHistogram:
+		return apipb.Aggregate_HISTOGRAM
 	default:
 		return apipb.Aggregate_COUNT
 	}
@@ -290,9 +666,93 @@ func protoAggFilter(filter model.AggFilter) *apipb.AggFilter {
 	if filter.Field != nil {
 		f.Field = *filter.Field
 	}
+	if filter.Percentile != nil {
+		f.Percentile = *filter.Percentile
+	}
+	if filter.Approximate != nil {
+		f.Approximate = *filter.Approximate
+	}
 	return f
 }
 
+func gqlAggregate(a apipb.Aggregate) model.Aggregate {
+	switch a {
+	case apipb.Aggregate_AVG:
+		return model.AggregateAvg
+	case apipb.Aggregate_MIN:
+		return model.AggregateMin
+	case apipb.Aggregate_MAX:
+		return model.AggregateMax
+	case apipb.Aggregate_PROD:
+		return model.AggregateProd
+	case apipb.Aggregate_SUM:
+		return model.AggregateSum
+	case apipb.Aggregate_COUNT_DISTINCT:
+		return model.AggregateCountDistinct
+	case apipb.Aggregate_PERCENTILE:
+		return model.AggregatePercentile
+	case apipb.Aggregate_STDDEV:
+		return model.AggregateStddev
+	case apipb.Aggregate_HISTOGRAM:
+		return model.AggregateHistogram
+	default:
+		return model.AggregateCount
+	}
+}
+
+func protoGroupAggFilter(filter model.GroupAggFilter) *apipb.GroupAggFilter {
+	f := &apipb.GroupAggFilter{
+		Filter:  protoFilter(*filter.Filter),
+		GroupBy: filter.GroupBy,
+	}
+	for _, agg := range filter.Aggregates {
+		field := &apipb.AggField{
+			Aggregate: protoAggregate(agg.Aggregate),
+		}
+		if agg.Field != nil {
+			field.Field = *agg.Field
+		}
+		if agg.Percentile != nil {
+			field.Percentile = *agg.Percentile
+		}
+		if agg.Approximate != nil {
+			field.Approximate = *agg.Approximate
+		}
+		if agg.BucketSize != nil {
+			field.BucketSize = *agg.BucketSize
+		}
+		f.Aggregates = append(f.Aggregates, field)
+	}
+	return f
+}
+
+func gqlAggGroups(groups *apipb.AggGroups) *model.AggGroups {
+	var gs []*model.AggGroup
+	for _, group := range groups.GetGroups() {
+		g := &model.AggGroup{
+			Key:   group.GetKey().AsMap(),
+			Count: int(group.GetCount()),
+		}
+		for _, val := range group.GetValues() {
+			v := &model.AggValue{
+				Aggregate: gqlAggregate(val.GetAggregate()),
+				Field:     val.GetField(),
+				Value:     val.GetValue(),
+			}
+			for _, bucket := range val.GetBuckets() {
+				v.Buckets = append(v.Buckets, &model.HistogramBucket{
+					Lower: bucket.GetLower(),
+					Upper: bucket.GetUpper(),
+					Count: int(bucket.GetCount()),
+				})
+			}
+			g.Values = append(g.Values, v)
+		}
+		gs = append(gs, g)
+	}
+	return &model.AggGroups{Groups: gs}
+}
+
 func protoStreamFilter(filter model.StreamFilter) *apipb.StreamFilter {
 	c := &apipb.StreamFilter{
 		Channel: filter.Channel,
@@ -319,6 +779,16 @@ func gqlAlgorithm(algorithm apipb.Algorithm) model.Algorithm {
 	return model.AlgorithmBfs
 }
 
+func protoDirection(direction model.Direction) apipb.Direction {
+	switch direction {
+	case model.DirectionIn:
+		return apipb.Direction_IN
+	case model.DirectionBoth:
+		return apipb.Direction_BOTH
+	}
+	return apipb.Direction_OUT
+}
+
 func protoTraverseFilter(filter model.TraverseFilter) *apipb.TraverseFilter {
 	c := &apipb.TraverseFilter{
 		Root:     protoIRef(*filter.Root),
@@ -341,6 +811,12 @@ func protoTraverseFilter(filter model.TraverseFilter) *apipb.TraverseFilter {
 	if filter.Reverse != nil {
 		c.Reverse = *filter.Reverse
 	}
+	if filter.Budget != nil {
+		c.Budget = protoBudget(*filter.Budget)
+	}
+	if filter.Direction != nil {
+		c.Direction = protoDirection(*filter.Direction)
+	}
 	return c
 }
 
@@ -370,6 +846,12 @@ func protoTraverseMeFilter(filter model.TraverseMeFilter) *apipb.TraverseMeFilte
 	if filter.Reverse != nil {
 		c.Reverse = *filter.Reverse
 	}
+	if filter.Budget != nil {
+		c.Budget = protoBudget(*filter.Budget)
+	}
+	if filter.Direction != nil {
+		c.Direction = protoDirection(*filter.Direction)
+	}
 	return c
 }
 
@@ -395,6 +877,9 @@ func protoConnectionFilter(filter model.ConnectFilter) *apipb.ConnectFilter {
 	if filter.Reverse != nil {
 		f.Reverse = *filter.Reverse
 	}
+	if filter.Direction != nil {
+		f.Direction = protoDirection(*filter.Direction)
+	}
 	return f
 }
 
@@ -415,13 +900,179 @@ func gqlRefs(ps *apipb.Refs) *model.Refs {
 }
 
 func protoIndex(index *model.IndexInput) *apipb.Index {
-	return &apipb.Index{
+	i := &apipb.Index{
 		Name:        index.Name,
 		Gtype:       index.Gtype,
-		Expression:  index.Expression,
 		Docs:        index.Docs,
 		Connections: index.Connections,
+		Fields:      index.Fields,
+	}
+	if index.Expression != nil {
+		i.Expression = *index.Expression
+	}
+	if index.Kind != nil {
+		i.Kind = protoIndexKind(*index.Kind)
+	}
+	if index.Metric != nil {
+		i.Metric = protoVectorMetric(*index.Metric)
+	}
+	if index.Dimensions != nil {
+		i.Dimensions = uint32(*index.Dimensions)
+	}
+	return i
+}
+
+func protoTextSearchFilter(filter model.TextSearchFilter) *apipb.TextSearchFilter {
+	f := &apipb.TextSearchFilter{
+		Index: filter.Index,
+		Query: filter.Query,
+		Limit: uint64(filter.Limit),
+	}
+	if filter.Expression != nil {
+		f.Expression = *filter.Expression
 	}
+	return f
+}
+
+func protoGeoPoint(point *model.GeoPointInput) *apipb.GeoPoint {
+	return &apipb.GeoPoint{
+		Lat: point.Lat,
+		Lng: point.Lng,
+	}
+}
+
+func protoGeoFilter(filter model.GeoFilter) *apipb.GeoFilter {
+	f := &apipb.GeoFilter{
+		Index: filter.Index,
+		Limit: uint64(filter.Limit),
+	}
+	switch {
+	case filter.Radius != nil:
+		f.Query = &apipb.GeoFilter_Radius{Radius: &apipb.GeoRadius{
+			Center: protoGeoPoint(filter.Radius.Center),
+			Meters: filter.Radius.Meters,
+		}}
+	case filter.Box != nil:
+		f.Query = &apipb.GeoFilter_Box{Box: &apipb.GeoBox{
+			Min: protoGeoPoint(filter.Box.Min),
+			Max: protoGeoPoint(filter.Box.Max),
+		}}
+	case filter.Nearest != nil:
+		f.Query = &apipb.GeoFilter_Nearest{Nearest: protoGeoPoint(filter.Nearest)}
+	}
+	if filter.Expression != nil {
+		f.Expression = *filter.Expression
+	}
+	if filter.Traverse != nil {
+		f.Traverse = protoTraverseFilter(*filter.Traverse)
+	}
+	return f
+}
+
+func gqlGeoDocs(docs *apipb.GeoDocs) *model.GeoDocs {
+	var geoDocs []*model.GeoDoc
+	for _, doc := range docs.GetDocs() {
+		geoDocs = append(geoDocs, &model.GeoDoc{
+			Doc:    gqlDoc(doc.GetDoc()),
+			Meters: doc.GetMeters(),
+		})
+	}
+	return &model.GeoDocs{Docs: geoDocs}
+}
+
+func protoSimilarFilter(filter model.SimilarFilter) *apipb.SimilarFilter {
+	f := &apipb.SimilarFilter{
+		Index:  filter.Index,
+		Vector: filter.Vector,
+		Limit:  uint64(filter.Limit),
+	}
+	if filter.Like != nil {
+		f.Like = protoIRef(*filter.Like)
+	}
+	if filter.Expression != nil {
+		f.Expression = *filter.Expression
+	}
+	if filter.Traverse != nil {
+		f.Traverse = protoTraverseFilter(*filter.Traverse)
+	}
+	if filter.Exact != nil {
+		f.Exact = *filter.Exact
+	}
+	if filter.Ef != nil {
+		f.Ef = uint32(*filter.Ef)
+	}
+	return f
+}
+
+func protoExplainFilter(filter model.ExplainFilter) *apipb.ExplainFilter {
+	switch {
+	case filter.SearchDocs != nil:
+		return &apipb.ExplainFilter{Query: &apipb.ExplainFilter_SearchDocs{SearchDocs: protoFilter(*filter.SearchDocs)}}
+	case filter.SearchConnections != nil:
+		return &apipb.ExplainFilter{Query: &apipb.ExplainFilter_SearchConnections{SearchConnections: protoFilter(*filter.SearchConnections)}}
+	case filter.Traverse != nil:
+		return &apipb.ExplainFilter{Query: &apipb.ExplainFilter_Traverse{Traverse: protoTraverseFilter(*filter.Traverse)}}
+	}
+	return &apipb.ExplainFilter{}
+}
+
+func gqlExplanation(explanation *apipb.Explanation) *model.Explanation {
+	var candidates []*model.ExplainCandidate
+	for _, c := range explanation.GetCandidates() {
+		candidates = append(candidates, &model.ExplainCandidate{
+			AccessPath: c.GetAccessPath(),
+			Estimate:   int(c.GetEstimate()),
+		})
+	}
+	var errs []*model.ExplainError
+	for _, e := range explanation.GetErrors() {
+		errs = append(errs, &model.ExplainError{
+			Error: e.GetError(),
+			Count: int(e.GetCount()),
+		})
+	}
+	var phases []*model.ExplainPhase
+	for _, p := range explanation.GetPhases() {
+		phases = append(phases, &model.ExplainPhase{
+			Name:     p.GetName(),
+			Duration: p.GetDuration().AsDuration().String(),
+		})
+	}
+	return &model.Explanation{
+		AccessPath:         explanation.GetAccessPath(),
+		Estimate:           int(explanation.GetEstimate()),
+		Candidates:         candidates,
+		KeysScanned:        int(explanation.GetKeysScanned()),
+		DocsDecoded:        int(explanation.GetDocsDecoded()),
+		ConnectionsDecoded: int(explanation.GetConnectionsDecoded()),
+		Evaluations:        int(explanation.GetEvaluations()),
+		Errors:             errs,
+		Results:            int(explanation.GetResults()),
+		Phases:             phases,
+		Duration:           explanation.GetDuration().AsDuration().String(),
+	}
+}
+
+func gqlSimilarDocs(docs *apipb.SimilarDocs) *model.SimilarDocs {
+	var similar []*model.SimilarDoc
+	for _, doc := range docs.GetDocs() {
+		similar = append(similar, &model.SimilarDoc{
+			Doc:      gqlDoc(doc.GetDoc()),
+			Distance: doc.GetDistance(),
+		})
+	}
+	return &model.SimilarDocs{Docs: similar}
+}
+
+func gqlScoredDocs(docs *apipb.ScoredDocs) *model.ScoredDocs {
+	var scored []*model.ScoredDoc
+	for _, doc := range docs.GetDocs() {
+		scored = append(scored, &model.ScoredDoc{
+			Doc:   gqlDoc(doc.GetDoc()),
+			Score: doc.GetScore(),
+		})
+	}
+	return &model.ScoredDocs{Docs: scored}
 }
 
 func protoAuthorizer(auth *model.AuthorizerInput) *apipb.Authorizer {
@@ -432,8 +1083,274 @@ func protoAuthorizer(auth *model.AuthorizerInput) *apipb.Authorizer {
 }
 
 func protoTypeValidator(validator *model.TypeValidatorInput) *apipb.TypeValidator {
-	return &apipb.TypeValidator{
-		Name:       validator.Name,
-		Expression: validator.Expression,
+	v := &apipb.TypeValidator{
+		Name:        validator.Name,
+		Gtype:       validator.Gtype,
+		Docs:        validator.Docs,
+		Connections: validator.Connections,
+	}
+	if validator.Expression != nil {
+		v.Expression = *validator.Expression
+	}
+	if validator.Acyclic != nil {
+		v.Acyclic = *validator.Acyclic
+	}
+	return v
+}
+
+func protoMigration(migration model.MigrationInput) *apipb.Migration {
+	m := &apipb.Migration{
+		Version: uint64(migration.Version),
+	}
+	if migration.Description != nil {
+		m.Description = *migration.Description
+	}
+	for _, rename := range migration.RenameDocs {
+		m.RenameDocs = append(m.RenameDocs, &apipb.TypeRename{From: rename.From, To: rename.To})
+	}
+	for _, rename := range migration.RenameConnections {
+		m.RenameConnections = append(m.RenameConnections, &apipb.TypeRename{From: rename.From, To: rename.To})
+	}
+	for _, transform := range migration.TransformDocs {
+		m.TransformDocs = append(m.TransformDocs, &apipb.AttributeTransform{Gtype: transform.Gtype, Expression: transform.Expression})
+	}
+	for _, transform := range migration.TransformConnections {
+		m.TransformConnections = append(m.TransformConnections, &apipb.AttributeTransform{Gtype: transform.Gtype, Expression: transform.Expression})
+	}
+	return m
+}
+
+func gqlMigration(migration *apipb.Migration) *model.Migration {
+	m := &model.Migration{
+		Version:     int(migration.GetVersion()),
+		Description: &migration.Description,
+	}
+	for _, rename := range migration.GetRenameDocs() {
+		m.RenameDocs = append(m.RenameDocs, &model.TypeRename{From: rename.GetFrom(), To: rename.GetTo()})
+	}
+	for _, rename := range migration.GetRenameConnections() {
+		m.RenameConnections = append(m.RenameConnections, &model.TypeRename{From: rename.GetFrom(), To: rename.GetTo()})
+	}
+	for _, transform := range migration.GetTransformDocs() {
+		m.TransformDocs = append(m.TransformDocs, &model.AttributeTransform{Gtype: transform.GetGtype(), Expression: transform.GetExpression()})
+	}
+	for _, transform := range migration.GetTransformConnections() {
+		m.TransformConnections = append(m.TransformConnections, &model.AttributeTransform{Gtype: transform.GetGtype(), Expression: transform.GetExpression()})
+	}
+	return m
+}
+
+func gqlJobState(state apipb.JobState) model.JobState {
+	switch state {
+	case apipb.JobState_COMPLETE:
+		return model.JobStateComplete
+	case apipb.JobState_FAILED:
+		return model.JobStateFailed
+	case apipb.JobState_CANCELLED:
+		return model.JobStateCancelled
+	}
+	return model.JobStateRunning
+}
+
+func gqlMigrationStatus(s *apipb.MigrationStatus) *model.MigrationStatus {
+	status := &model.MigrationStatus{
+		Migration: gqlMigration(s.GetMigration()),
+		State:     gqlJobState(s.GetState()),
+		Affected:  int(s.GetAffected()),
+		Error:     &s.Error,
+		User:      gqlRef(s.GetUser()),
+	}
+	if s.GetStartedAt() != nil {
+		startedAt := s.GetStartedAt().AsTime()
+		status.StartedAt = &startedAt
+	}
+	if s.GetCompletedAt() != nil {
+		completedAt := s.GetCompletedAt().AsTime()
+		status.CompletedAt = &completedAt
+	}
+	return status
+}
+
+func gqlMigrationStatuses(s *apipb.MigrationStatuses) *model.MigrationStatuses {
+	var statuses []*model.MigrationStatus
+	for _, migration := range s.GetMigrations() {
+		statuses = append(statuses, gqlMigrationStatus(migration))
+	}
+	return &model.MigrationStatuses{Migrations: statuses}
+}
+
+func protoAnalyticsJob(job model.AnalyticsJobInput) *apipb.AnalyticsJob {
+	j := &apipb.AnalyticsJob{
+		Algorithm: protoAnalyticsAlgorithm(job.Algorithm),
+		Subgraph: &apipb.Subgraph{
+			DocGtypes:        job.Subgraph.DocGtypes,
+			ConnectionGtypes: job.Subgraph.ConnectionGtypes,
+		},
+	}
+	if job.Subgraph.DocExpression != nil {
+		j.Subgraph.DocExpression = *job.Subgraph.DocExpression
+	}
+	if job.Subgraph.ConnectionExpression != nil {
+		j.Subgraph.ConnectionExpression = *job.Subgraph.ConnectionExpression
+	}
+	if job.Direction != nil {
+		j.Direction = protoDirection(*job.Direction)
+	}
+	if job.Attribute != nil {
+		j.Attribute = *job.Attribute
+	}
+	if job.Damping != nil {
+		j.Damping = *job.Damping
+	}
+	if job.MaxIterations != nil {
+		j.MaxIterations = uint32(*job.MaxIterations)
+	}
+	if job.Tolerance != nil {
+		j.Tolerance = *job.Tolerance
+	}
+	return j
+}
+
+func protoAnalyticsAlgorithm(algorithm model.AnalyticsAlgorithm) apipb.AnalyticsAlgorithm {
+	switch algorithm {
+	case model.AnalyticsAlgorithmDegree:
+		return apipb.AnalyticsAlgorithm_DEGREE
+	case model.AnalyticsAlgorithmBetweenness:
+		return apipb.AnalyticsAlgorithm_BETWEENNESS
+	case model.AnalyticsAlgorithmWeakComponents:
+		return apipb.AnalyticsAlgorithm_WEAK_COMPONENTS
+	case model.AnalyticsAlgorithmStrongComponents:
+		return apipb.AnalyticsAlgorithm_STRONG_COMPONENTS
+	case model.AnalyticsAlgorithmLouvain:
+		return apipb.AnalyticsAlgorithm_LOUVAIN
+	}
+	return apipb.AnalyticsAlgorithm_PAGERANK
+}
+
+func gqlAnalyticsAlgorithm(algorithm apipb.AnalyticsAlgorithm) model.AnalyticsAlgorithm {
+	switch algorithm {
+	case apipb.AnalyticsAlgorithm_DEGREE:
+		return model.AnalyticsAlgorithmDegree
+	case apipb.AnalyticsAlgorithm_BETWEENNESS:
+		return model.AnalyticsAlgorithmBetweenness
+	case apipb.AnalyticsAlgorithm_WEAK_COMPONENTS:
+		return model.AnalyticsAlgorithmWeakComponents
+	case apipb.AnalyticsAlgorithm_STRONG_COMPONENTS:
+		return model.AnalyticsAlgorithmStrongComponents
+	case apipb.AnalyticsAlgorithm_LOUVAIN:
+		return model.AnalyticsAlgorithmLouvain
+	}
+	return model.AnalyticsAlgorithmPagerank
+}
+
+func gqlDirection(direction apipb.Direction) model.Direction {
+	switch direction {
+	case apipb.Direction_IN:
+		return model.DirectionIn
+	case apipb.Direction_BOTH:
+		return model.DirectionBoth
+	}
+	return model.DirectionOut
+}
+
+func gqlAnalyticsStatus(s *apipb.AnalyticsStatus) *model.AnalyticsStatus {
+	job := s.GetJob()
+	status := &model.AnalyticsStatus{
+		ID: s.GetId(),
+		Job: &model.AnalyticsJob{
+			Algorithm: gqlAnalyticsAlgorithm(job.GetAlgorithm()),
+			Subgraph: &model.Subgraph{
+				DocGtypes:            job.GetSubgraph().GetDocGtypes(),
+				DocExpression:        &job.GetSubgraph().DocExpression,
+				ConnectionGtypes:     job.GetSubgraph().GetConnectionGtypes(),
+				ConnectionExpression: &job.GetSubgraph().ConnectionExpression,
+			},
+			Direction:     gqlDirection(job.GetDirection()),
+			Attribute:     &job.Attribute,
+			Damping:       job.GetDamping(),
+			MaxIterations: int(job.GetMaxIterations()),
+			Tolerance:     job.GetTolerance(),
+		},
+		State:          gqlJobState(s.GetState()),
+		Phase:          s.GetPhase(),
+		Progress:       s.GetProgress(),
+		Docs:           int(s.GetDocs()),
+		Connections:    int(s.GetConnections()),
+		Iterations:     int(s.GetIterations()),
+		Written:        int(s.GetWritten()),
+		Error:          &s.Error,
+		User:           gqlRef(s.GetUser()),
+		ComponentCount: int(s.GetComponentCount()),
+		Modularity:     s.GetModularity(),
+	}
+	for _, component := range s.GetComponents() {
+		status.Components = append(status.Components, &model.Component{
+			ID:   int(component.GetId()),
+			Size: int(component.GetSize()),
+		})
+	}
+	if s.GetStartedAt() != nil {
+		startedAt := s.GetStartedAt().AsTime()
+		status.StartedAt = &startedAt
+	}
+	if s.GetCompletedAt() != nil {
+		completedAt := s.GetCompletedAt().AsTime()
+		status.CompletedAt = &completedAt
+	}
+	return status
+}
+
+func gqlAnalyticsStatuses(s *apipb.AnalyticsStatuses) *model.AnalyticsStatuses {
+	var statuses []*model.AnalyticsStatus
+	for _, job := range s.GetJobs() {
+		statuses = append(statuses, gqlAnalyticsStatus(job))
+	}
+	return &model.AnalyticsStatuses{Jobs: statuses}
+}
+
+func gqlMutationResult(result *apipb.MutationResult) *model.MutationResult {
+	r := &model.MutationResult{
+		Affected: int(result.GetAffected()),
+		DryRun:   result.GetDryRun(),
+	}
+	for _, ref := range result.GetRefs() {
+		r.Refs = append(r.Refs, gqlRef(ref))
+	}
+	for _, ref := range result.GetCascaded() {
+		r.Cascaded = append(r.Cascaded, gqlRef(ref))
+	}
+	if result.GetDocs() != nil {
+		r.Docs = gqlDocs(result.GetDocs())
+	}
+	if result.GetConnections() != nil {
+		r.Connections = gqlConnections(result.GetConnections())
+	}
+	return r
+}
+
+func protoDegreeFilter(filter model.DegreeFilter) *apipb.DegreeFilter {
+	f := &apipb.DegreeFilter{
+		Doc: protoIRef(*filter.Doc),
+	}
+	if filter.Gtype != nil {
+		f.Gtype = *filter.Gtype
+	}
+	return f
+}
+
+func gqlDegree(degree *apipb.Degree) *model.Degree {
+	var gtypes []*model.GtypeDegree
+	for _, d := range degree.GetGtypes() {
+		gtypes = append(gtypes, &model.GtypeDegree{
+			Gtype:     d.GetGtype(),
+			InDegree:  int(d.GetInDegree()),
+			OutDegree: int(d.GetOutDegree()),
+		})
+	}
+	return &model.Degree{
+		Doc:       gqlRef(degree.GetDoc()),
+		InDegree:  int(degree.GetInDegree()),
+		OutDegree: int(degree.GetOutDegree()),
+		Gtypes:    gtypes,
 	}
 }
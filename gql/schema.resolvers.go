@@ -5,6 +5,7 @@ package gql
 
 import (
 	"context"
+	"io"
 
 	"github.com/99designs/gqlgen/graphql"
 	generated1 "github.com/graphikDB/graphik/gen/gql/go/generated"
@@ -45,6 +46,34 @@ func (r *mutationResolver) CreateDocs(ctx context.Context, input model.DocConstr
 	return gqlDocs(docs), nil
 }
 
+func (r *mutationResolver) UpsertDoc(ctx context.Context, input model.DocUpsert) (*model.Doc, error) {
+	res, err := r.client.UpsertDoc(ctx, protoDocUpsert(input))
+	if err != nil {
+		return nil, &gqlerror.Error{
+			Message: err.Error(),
+			Path:    graphql.GetPath(ctx),
+			Extensions: map[string]interface{}{
+				"code": status.Code(err).String(),
+			},
+		}
+	}
+	return gqlDoc(res), nil
+}
+
+func (r *mutationResolver) UpsertDocs(ctx context.Context, input model.DocUpserts) (*model.Docs, error) {
+	res, err := r.client.UpsertDocs(ctx, protoDocUpserts(input))
+	if err != nil {
+		return nil, &gqlerror.Error{
+			Message: err.Error(),
+			Path:    graphql.GetPath(ctx),
+			Extensions: map[string]interface{}{
+				"code": status.Code(err).String(),
+			},
+		}
+	}
+	return gqlDocs(res), nil
+}
+
 func (r *mutationResolver) EditDoc(ctx context.Context, input model.Edit) (*model.Doc, error) {
 	res, err := r.client.EditDoc(ctx, protoEdit(input))
 	if err != nil {
@@ -59,8 +88,8 @@ func (r *mutationResolver) EditDoc(ctx context.Context, input model.Edit) (*mode
 	return gqlDoc(res), nil
 }
 
-func (r *mutationResolver) EditDocs(ctx context.Context, input model.EditFilter) (*model.Docs, error) {
-	docs, err := r.client.EditDocs(ctx, protoEditFilter(input))
+func (r *mutationResolver) EditDocs(ctx context.Context, input model.EditFilter) (*model.MutationResult, error) {
+	res, err := r.client.EditDocs(ctx, protoEditFilter(input))
 	if err != nil {
 		return nil, &gqlerror.Error{
 			Message: err.Error(),
@@ -70,7 +99,7 @@ func (r *mutationResolver) EditDocs(ctx context.Context, input model.EditFilter)
 			},
 		}
 	}
-	return gqlDocs(docs), nil
+	return gqlMutationResult(res), nil
 }
 
 func (r *mutationResolver) DelDoc(ctx context.Context, input model.RefInput) (*emptypb.Empty, error) {
@@ -87,7 +116,7 @@ func (r *mutationResolver) DelDoc(ctx context.Context, input model.RefInput) (*e
 	}
 }
 
-func (r *mutationResolver) DelDocs(ctx context.Context, input model.Filter) (*emptypb.Empty, error) {
+func (r *mutationResolver) DelDocs(ctx context.Context, input model.Filter) (*model.MutationResult, error) {
 	if e, err := r.client.DelDocs(ctx, protoFilter(input)); err != nil {
 		return nil, &gqlerror.Error{
 			Message: err.Error(),
@@ -97,7 +126,7 @@ func (r *mutationResolver) DelDocs(ctx context.Context, input model.Filter) (*em
 			},
 		}
 	} else {
-		return e, nil
+		return gqlMutationResult(e), nil
 	}
 }
 
@@ -129,6 +158,34 @@ func (r *mutationResolver) CreateConnections(ctx context.Context, input model.Co
 	return gqlConnections(connections), nil
 }
 
+func (r *mutationResolver) UpsertConnection(ctx context.Context, input model.ConnectionUpsert) (*model.Connection, error) {
+	res, err := r.client.UpsertConnection(ctx, protoConnectionUpsert(input))
+	if err != nil {
+		return nil, &gqlerror.Error{
+			Message: err.Error(),
+			Path:    graphql.GetPath(ctx),
+			Extensions: map[string]interface{}{
+				"code": status.Code(err).String(),
+			},
+		}
+	}
+	return gqlConnection(res), nil
+}
+
+func (r *mutationResolver) UpsertConnections(ctx context.Context, input model.ConnectionUpserts) (*model.Connections, error) {
+	res, err := r.client.UpsertConnections(ctx, protoConnectionUpserts(input))
+	if err != nil {
+		return nil, &gqlerror.Error{
+			Message: err.Error(),
+			Path:    graphql.GetPath(ctx),
+			Extensions: map[string]interface{}{
+				"code": status.Code(err).String(),
+			},
+		}
+	}
+	return gqlConnections(res), nil
+}
+
 func (r *mutationResolver) EditConnection(ctx context.Context, input model.Edit) (*model.Connection, error) {
 	res, err := r.client.EditConnection(ctx, protoEdit(input))
 	if err != nil {
@@ -143,8 +200,8 @@ func (r *mutationResolver) EditConnection(ctx context.Context, input model.Edit)
 	return gqlConnection(res), nil
 }
 
-func (r *mutationResolver) EditConnections(ctx context.Context, input model.EditFilter) (*model.Connections, error) {
-	connections, err := r.client.EditConnections(ctx, protoEditFilter(input))
+func (r *mutationResolver) EditConnections(ctx context.Context, input model.EditFilter) (*model.MutationResult, error) {
+	res, err := r.client.EditConnections(ctx, protoEditFilter(input))
 	if err != nil {
 		return nil, &gqlerror.Error{
 			Message: err.Error(),
@@ -154,7 +211,7 @@ func (r *mutationResolver) EditConnections(ctx context.Context, input model.Edit
 			},
 		}
 	}
-	return gqlConnections(connections), nil
+	return gqlMutationResult(res), nil
 }
 
 func (r *mutationResolver) DelConnection(ctx context.Context, input model.RefInput) (*emptypb.Empty, error) {
@@ -171,7 +228,7 @@ func (r *mutationResolver) DelConnection(ctx context.Context, input model.RefInp
 	}
 }
 
-func (r *mutationResolver) DelConnections(ctx context.Context, input model.Filter) (*emptypb.Empty, error) {
+func (r *mutationResolver) DelConnections(ctx context.Context, input model.Filter) (*model.MutationResult, error) {
 	if e, err := r.client.DelConnections(ctx, protoFilter(input)); err != nil {
 		return nil, &gqlerror.Error{
 			Message: err.Error(),
@@ -181,7 +238,7 @@ func (r *mutationResolver) DelConnections(ctx context.Context, input model.Filte
 			},
 		}
 	} else {
-		return e, nil
+		return gqlMutationResult(e), nil
 	}
 }
 
@@ -262,6 +319,48 @@ func (r *mutationResolver) SetTypeValidators(ctx context.Context, input model.Ty
 	}
 }
 
+func (r *mutationResolver) Migrate(ctx context.Context, input model.MigrationInput) (*model.MigrationStatus, error) {
+	res, err := r.client.Migrate(ctx, protoMigration(input))
+	if err != nil {
+		return nil, &gqlerror.Error{
+			Message: err.Error(),
+			Path:    graphql.GetPath(ctx),
+			Extensions: map[string]interface{}{
+				"code": status.Code(err).String(),
+			},
+		}
+	}
+	return gqlMigrationStatus(res), nil
+}
+
+func (r *mutationResolver) RunAnalytics(ctx context.Context, input model.AnalyticsJobInput) (*model.AnalyticsStatus, error) {
+	res, err := r.client.RunAnalytics(ctx, protoAnalyticsJob(input))
+	if err != nil {
+		return nil, &gqlerror.Error{
+			Message: err.Error(),
+			Path:    graphql.GetPath(ctx),
+			Extensions: map[string]interface{}{
+				"code": status.Code(err).String(),
+			},
+		}
+	}
+	return gqlAnalyticsStatus(res), nil
+}
+
+func (r *mutationResolver) CancelAnalytics(ctx context.Context, where model.AnalyticsRefInput) (*model.AnalyticsStatus, error) {
+	res, err := r.client.CancelAnalytics(ctx, &apipb.AnalyticsRef{Id: where.ID})
+	if err != nil {
+		return nil, &gqlerror.Error{
+			Message: err.Error(),
+			Path:    graphql.GetPath(ctx),
+			Extensions: map[string]interface{}{
+				"code": status.Code(err).String(),
+			},
+		}
+	}
+	return gqlAnalyticsStatus(res), nil
+}
+
 func (r *mutationResolver) SearchAndConnect(ctx context.Context, where model.SearchConnectFilter) (*model.Connections, error) {
 	connections, err := r.client.SearchAndConnect(ctx, &apipb.SearchConnectFilter{
 		Filter:     protoFilter(*where.Filter),
@@ -329,6 +428,73 @@ func (r *queryResolver) GetSchema(ctx context.Context, where *emptypb.Empty) (*m
 	return gqlSchema(res), nil
 }
 
+func (r *queryResolver) GetMigrations(ctx context.Context, where *emptypb.Empty) (*model.MigrationStatuses, error) {
+	res, err := r.client.GetMigrations(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, &gqlerror.Error{
+			Message: err.Error(),
+			Path:    graphql.GetPath(ctx),
+			Extensions: map[string]interface{}{
+				"code": status.Code(err).String(),
+			},
+		}
+	}
+	return gqlMigrationStatuses(res), nil
+}
+
+func (r *queryResolver) GetAnalytics(ctx context.Context, where model.AnalyticsRefInput) (*model.AnalyticsStatus, error) {
+	res, err := r.client.GetAnalytics(ctx, &apipb.AnalyticsRef{Id: where.ID})
+	if err != nil {
+		return nil, &gqlerror.Error{
+			Message: err.Error(),
+			Path:    graphql.GetPath(ctx),
+			Extensions: map[string]interface{}{
+				"code": status.Code(err).String(),
+			},
+		}
+	}
+	return gqlAnalyticsStatus(res), nil
+}
+
+func (r *queryResolver) ListAnalytics(ctx context.Context, where *emptypb.Empty) (*model.AnalyticsStatuses, error) {
+	res, err := r.client.ListAnalytics(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, &gqlerror.Error{
+			Message: err.Error(),
+			Path:    graphql.GetPath(ctx),
+			Extensions: map[string]interface{}{
+				"code": status.Code(err).String(),
+			},
+		}
+	}
+	return gqlAnalyticsStatuses(res), nil
+}
+
+func (r *queryResolver) GetAnalyticsResults(ctx context.Context, where model.AnalyticsRefInput) (*model.AnalyticsResults, error) {
+	results := &model.AnalyticsResults{}
+	stream, err := r.client.StreamAnalyticsResults(ctx, &apipb.AnalyticsRef{Id: where.ID})
+	for err == nil {
+		var result *apipb.AnalyticsResult
+		result, err = stream.Recv()
+		if err == nil {
+			results.Results = append(results.Results, &model.AnalyticsResult{
+				Ref:   gqlRef(result.GetRef()),
+				Value: result.GetValue(),
+			})
+		}
+	}
+	if err != io.EOF {
+		return nil, &gqlerror.Error{
+			Message: err.Error(),
+			Path:    graphql.GetPath(ctx),
+			Extensions: map[string]interface{}{
+				"code": status.Code(err).String(),
+			},
+		}
+	}
+	return results, nil
+}
+
 func (r *queryResolver) Me(ctx context.Context, where *emptypb.Empty) (*model.Doc, error) {
 	res, err := r.client.Me(ctx, &emptypb.Empty{})
 	if err != nil {
@@ -371,6 +537,62 @@ func (r *queryResolver) SearchDocs(ctx context.Context, where model.Filter) (*mo
 	return gqlDocs(res), nil
 }
 
+func (r *queryResolver) SearchText(ctx context.Context, where model.TextSearchFilter) (*model.ScoredDocs, error) {
+	res, err := r.client.SearchText(ctx, protoTextSearchFilter(where))
+	if err != nil {
+		return nil, &gqlerror.Error{
+			Message: err.Error(),
+			Path:    graphql.GetPath(ctx),
+			Extensions: map[string]interface{}{
+				"code": status.Code(err).String(),
+			},
+		}
+	}
+	return gqlScoredDocs(res), nil
+}
+
+func (r *queryResolver) SearchGeo(ctx context.Context, where model.GeoFilter) (*model.GeoDocs, error) {
+	res, err := r.client.SearchGeo(ctx, protoGeoFilter(where))
+	if err != nil {
+		return nil, &gqlerror.Error{
+			Message: err.Error(),
+			Path:    graphql.GetPath(ctx),
+			Extensions: map[string]interface{}{
+				"code": status.Code(err).String(),
+			},
+		}
+	}
+	return gqlGeoDocs(res), nil
+}
+
+func (r *queryResolver) SearchSimilar(ctx context.Context, where model.SimilarFilter) (*model.SimilarDocs, error) {
+	res, err := r.client.SearchSimilar(ctx, protoSimilarFilter(where))
+	if err != nil {
+		return nil, &gqlerror.Error{
+			Message: err.Error(),
+			Path:    graphql.GetPath(ctx),
+			Extensions: map[string]interface{}{
+				"code": status.Code(err).String(),
+			},
+		}
+	}
+	return gqlSimilarDocs(res), nil
+}
+
+func (r *queryResolver) SimilarNeighbors(ctx context.Context, where model.NeighborFilter) (*model.NeighborDocs, error) {
+	res, err := r.client.SimilarNeighbors(ctx, protoNeighborFilter(where))
+	if err != nil {
+		return nil, &gqlerror.Error{
+			Message: err.Error(),
+			Path:    graphql.GetPath(ctx),
+			Extensions: map[string]interface{}{
+				"code": status.Code(err).String(),
+			},
+		}
+	}
+	return gqlNeighborDocs(res), nil
+}
+
 func (r *queryResolver) Traverse(ctx context.Context, where model.TraverseFilter) (*model.Traversals, error) {
 	res, err := r.client.Traverse(ctx, protoTraverseFilter(where))
 	if err != nil {
@@ -386,6 +608,20 @@ func (r *queryResolver) Traverse(ctx context.Context, where model.TraverseFilter
 	return gqlTraversals(res), nil
 }
 
+func (r *queryResolver) Explain(ctx context.Context, where model.ExplainFilter) (*model.Explanation, error) {
+	res, err := r.client.Explain(ctx, protoExplainFilter(where))
+	if err != nil {
+		return nil, &gqlerror.Error{
+			Message: err.Error(),
+			Path:    graphql.GetPath(ctx),
+			Extensions: map[string]interface{}{
+				"code": status.Code(err).String(),
+			},
+		}
+	}
+	return gqlExplanation(res), nil
+}
+
 func (r *queryResolver) TraverseMe(ctx context.Context, where model.TraverseMeFilter) (*model.Traversals, error) {
 	res, err := r.client.TraverseMe(ctx, protoTraverseMeFilter(where))
 	if err != nil {
@@ -400,6 +636,76 @@ func (r *queryResolver) TraverseMe(ctx context.Context, where model.TraverseMeFi
 	return gqlTraversals(res), nil
 }
 
+func (r *queryResolver) ShortestPath(ctx context.Context, where model.PathFilter) (*model.Paths, error) {
+	res, err := r.client.ShortestPath(ctx, protoPathFilter(where))
+	if err != nil {
+		return nil, &gqlerror.Error{
+			Message: err.Error(),
+			Path:    graphql.GetPath(ctx),
+			Extensions: map[string]interface{}{
+				"code": status.Code(err).String(),
+			},
+		}
+	}
+	return gqlPaths(res), nil
+}
+
+func (r *queryResolver) AllPaths(ctx context.Context, where model.AllPathsFilter) (*model.Paths, error) {
+	res, err := r.client.AllPaths(ctx, protoAllPathsFilter(where))
+	if err != nil {
+		return nil, &gqlerror.Error{
+			Message: err.Error(),
+			Path:    graphql.GetPath(ctx),
+			Extensions: map[string]interface{}{
+				"code": status.Code(err).String(),
+			},
+		}
+	}
+	return gqlPaths(res), nil
+}
+
+func (r *queryResolver) Match(ctx context.Context, where model.MatchFilter) (*model.Matches, error) {
+	res, err := r.client.Match(ctx, protoMatchFilter(where))
+	if err != nil {
+		return nil, &gqlerror.Error{
+			Message: err.Error(),
+			Path:    graphql.GetPath(ctx),
+			Extensions: map[string]interface{}{
+				"code": status.Code(err).String(),
+			},
+		}
+	}
+	return gqlMatches(res), nil
+}
+
+func (r *queryResolver) TopologicalSort(ctx context.Context, where model.TopologicalSortFilter) (*model.TopologicalOrder, error) {
+	res, err := r.client.TopologicalSort(ctx, protoTopologicalSortFilter(where))
+	if err != nil {
+		return nil, &gqlerror.Error{
+			Message: err.Error(),
+			Path:    graphql.GetPath(ctx),
+			Extensions: map[string]interface{}{
+				"code": status.Code(err).String(),
+			},
+		}
+	}
+	return gqlTopologicalOrder(res), nil
+}
+
+func (r *queryResolver) ExtractSubgraph(ctx context.Context, where model.ExtractFilter) (*model.Graph, error) {
+	res, err := r.client.ExtractSubgraph(ctx, protoExtractFilter(where))
+	if err != nil {
+		return nil, &gqlerror.Error{
+			Message: err.Error(),
+			Path:    graphql.GetPath(ctx),
+			Extensions: map[string]interface{}{
+				"code": status.Code(err).String(),
+			},
+		}
+	}
+	return gqlGraph(res), nil
+}
+
 func (r *queryResolver) GetConnection(ctx context.Context, where model.RefInput) (*model.Connection, error) {
 	res, err := r.client.GetConnection(ctx, protoIRef(where))
 	if err != nil {
@@ -512,6 +818,20 @@ func (r *queryResolver) ConnectionsTo(ctx context.Context, where model.ConnectFi
 	return gqlConnections(res), nil
 }
 
+func (r *queryResolver) GetDegree(ctx context.Context, where model.DegreeFilter) (*model.Degree, error) {
+	res, err := r.client.GetDegree(ctx, protoDegreeFilter(where))
+	if err != nil {
+		return nil, &gqlerror.Error{
+			Message: err.Error(),
+			Path:    graphql.GetPath(ctx),
+			Extensions: map[string]interface{}{
+				"code": status.Code(err).String(),
+			},
+		}
+	}
+	return gqlDegree(res), nil
+}
+
 func (r *queryResolver) AggregateDocs(ctx context.Context, where model.AggFilter) (float64, error) {
 	res, err := r.client.AggregateDocs(ctx, protoAggFilter(where))
 	if err != nil {
@@ -540,6 +860,34 @@ func (r *queryResolver) AggregateConnections(ctx context.Context, where model.Ag
 	return res.GetValue(), nil
 }
 
+func (r *queryResolver) AggregateDocGroups(ctx context.Context, where model.GroupAggFilter) (*model.AggGroups, error) {
+	res, err := r.client.AggregateDocGroups(ctx, protoGroupAggFilter(where))
+	if err != nil {
+		return nil, &gqlerror.Error{
+			Message: err.Error(),
+			Path:    graphql.GetPath(ctx),
+			Extensions: map[string]interface{}{
+				"code": status.Code(err).String(),
+			},
+		}
+	}
+	return gqlAggGroups(res), nil
+}
+
+func (r *queryResolver) AggregateConnectionGroups(ctx context.Context, where model.GroupAggFilter) (*model.AggGroups, error) {
+	res, err := r.client.AggregateConnectionGroups(ctx, protoGroupAggFilter(where))
+	if err != nil {
+		return nil, &gqlerror.Error{
+			Message: err.Error(),
+			Path:    graphql.GetPath(ctx),
+			Extensions: map[string]interface{}{
+				"code": status.Code(err).String(),
+			},
+		}
+	}
+	return gqlAggGroups(res), nil
+}
+
 func (r *subscriptionResolver) Stream(ctx context.Context, where model.StreamFilter) (<-chan *model.Message, error) {
 	ch := make(chan *model.Message)
 	stream, err := r.client.Stream(ctx, protoStreamFilter(where))
@@ -10,6 +10,7 @@ import (
 	"golang.org/x/oauth2"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"io"
 )
 
 type Options struct {
@@ -99,6 +100,17 @@ func toContext(ctx context.Context, tokenSource oauth2.TokenSource) (context.Con
 	), nil
 }
 
+// WithIdempotencyKey returns a context that sets an idempotency key on CreateDoc(s)/CreateConnection(s) requests.
+// Retried requests with the same key return the originally created object instead of creating a duplicate.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "x-graphik-idempotency-key", key)
+}
+
+// WithNamespace returns a context that executes requests within the given namespace. It's only honored for root users.
+func WithNamespace(ctx context.Context, namespace string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "x-graphik-namespace", namespace)
+}
+
 // Me returns a Doc of the currently logged in user
 func (c *Client) Me(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*apipb.Doc, error) {
 	return c.graph.Me(ctx, in, opts...)
@@ -114,6 +126,16 @@ func (c *Client) CreateDocs(ctx context.Context, in *apipb.DocConstructors, opts
 	return c.graph.CreateDocs(ctx, in, opts...)
 }
 
+// UpsertDoc creates a doc or merges attributes into it if it already exists
+func (c *Client) UpsertDoc(ctx context.Context, in *apipb.DocUpsert, opts ...grpc.CallOption) (*apipb.Doc, error) {
+	return c.graph.UpsertDoc(ctx, in, opts...)
+}
+
+// UpsertDocs creates or merges 1-many documents in a single transaction
+func (c *Client) UpsertDocs(ctx context.Context, in *apipb.DocUpserts, opts ...grpc.CallOption) (*apipb.Docs, error) {
+	return c.graph.UpsertDocs(ctx, in, opts...)
+}
+
 // GetDoc gets a doc at the given ref
 func (c *Client) GetDoc(ctx context.Context, in *apipb.Ref, opts ...grpc.CallOption) (*apipb.Doc, error) {
 	return c.graph.GetDoc(ctx, in, opts...)
@@ -124,13 +146,60 @@ func (c *Client) SearchDocs(ctx context.Context, in *apipb.Filter, opts ...grpc.
 	return c.graph.SearchDocs(ctx, in, opts...)
 }
 
+// StreamDocs streams docs that pass the filter to the handler as they are read from the graph. Returning false from the handler cancels the stream.
+func (c *Client) StreamDocs(ctx context.Context, in *apipb.Filter, handler func(doc *apipb.Doc) bool, opts ...grpc.CallOption) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.graph.StreamDocs(ctx, in, opts...)
+	if err != nil {
+		return err
+	}
+	for {
+		doc, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if !handler(doc) {
+			return nil
+		}
+	}
+}
+
+// SearchText searches a full-text index - returning docs ranked by relevance
+func (c *Client) SearchText(ctx context.Context, in *apipb.TextSearchFilter, opts ...grpc.CallOption) (*apipb.ScoredDocs, error) {
+	return c.graph.SearchText(ctx, in, opts...)
+}
+
+// SearchGeo searches a geo index for docs within a radius, within a bounding box, or nearest to a point
+func (c *Client) SearchGeo(ctx context.Context, in *apipb.GeoFilter, opts ...grpc.CallOption) (*apipb.GeoDocs, error) {
+	return c.graph.SearchGeo(ctx, in, opts...)
+}
+
+// SearchSimilar searches a vector index for the docs nearest to a vector
+func (c *Client) SearchSimilar(ctx context.Context, in *apipb.SimilarFilter, opts ...grpc.CallOption) (*apipb.SimilarDocs, error) {
+	return c.graph.SearchSimilar(ctx, in, opts...)
+}
+
+// SimilarNeighbors ranks the docs that share neighbors with a doc by the similarity of their neighborhoods ex: people you may know
+func (c *Client) SimilarNeighbors(ctx context.Context, in *apipb.NeighborFilter, opts ...grpc.CallOption) (*apipb.NeighborDocs, error) {
+	return c.graph.SimilarNeighbors(ctx, in, opts...)
+}
+
+// Explain executes a search or traversal & reports how it was executed(access path, work done, timings)
+func (c *Client) Explain(ctx context.Context, in *apipb.ExplainFilter, opts ...grpc.CallOption) (*apipb.Explanation, error) {
+	return c.graph.Explain(ctx, in, opts...)
+}
+
 // EditDoc edites a single doc in the graph
 func (c *Client) EditDoc(ctx context.Context, in *apipb.Edit, opts ...grpc.CallOption) (*apipb.Doc, error) {
 	return c.graph.EditDoc(ctx, in, opts...)
 }
 
-// EditDocs edites 0-many docs in the graph
-func (c *Client) EditDocs(ctx context.Context, in *apipb.EditFilter, opts ...grpc.CallOption) (*apipb.Docs, error) {
+// EditDocs edites 0-many docs in the graph. Set filter.dry_run to preview the edit without committing it
+func (c *Client) EditDocs(ctx context.Context, in *apipb.EditFilter, opts ...grpc.CallOption) (*apipb.MutationResult, error) {
 	return c.graph.EditDocs(ctx, in, opts...)
 }
 
@@ -144,6 +213,16 @@ func (c *Client) CreateConnections(ctx context.Context, in *apipb.ConnectionCons
 	return c.graph.CreateConnections(ctx, in, opts...)
 }
 
+// UpsertConnection creates a connection or merges attributes into it if it already exists
+func (c *Client) UpsertConnection(ctx context.Context, in *apipb.ConnectionUpsert, opts ...grpc.CallOption) (*apipb.Connection, error) {
+	return c.graph.UpsertConnection(ctx, in, opts...)
+}
+
+// UpsertConnections creates or merges 1-many connections in a single transaction
+func (c *Client) UpsertConnections(ctx context.Context, in *apipb.ConnectionUpserts, opts ...grpc.CallOption) (*apipb.Connections, error) {
+	return c.graph.UpsertConnections(ctx, in, opts...)
+}
+
 // GetConnection gets a connection at the given ref
 func (c *Client) GetConnection(ctx context.Context, in *apipb.Ref, opts ...grpc.CallOption) (*apipb.Connection, error) {
 	return c.graph.GetConnection(ctx, in, opts...)
@@ -154,13 +233,35 @@ func (c *Client) SearchConnections(ctx context.Context, in *apipb.Filter, opts .
 	return c.graph.SearchConnections(ctx, in, opts...)
 }
 
+// StreamConnections streams connections that pass the filter to the handler as they are read from the graph. Returning false from the handler cancels the stream.
+func (c *Client) StreamConnections(ctx context.Context, in *apipb.Filter, handler func(connection *apipb.Connection) bool, opts ...grpc.CallOption) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.graph.StreamConnections(ctx, in, opts...)
+	if err != nil {
+		return err
+	}
+	for {
+		connection, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if !handler(connection) {
+			return nil
+		}
+	}
+}
+
 // EditConnection edites a single connection in the graph
 func (c *Client) EditConnection(ctx context.Context, in *apipb.Edit, opts ...grpc.CallOption) (*apipb.Connection, error) {
 	return c.graph.EditConnection(ctx, in, opts...)
 }
 
-// EditConnections edites 0-many connections in the graph
-func (c *Client) EditConnections(ctx context.Context, in *apipb.EditFilter, opts ...grpc.CallOption) (*apipb.Connections, error) {
+// EditConnections edites 0-many connections in the graph. Set filter.dry_run to preview the edit without committing it
+func (c *Client) EditConnections(ctx context.Context, in *apipb.EditFilter, opts ...grpc.CallOption) (*apipb.MutationResult, error) {
 	return c.graph.EditConnections(ctx, in, opts...)
 }
 
@@ -174,6 +275,11 @@ func (c *Client) ConnectionsTo(ctx context.Context, in *apipb.ConnectFilter, opt
 	return c.graph.ConnectionsTo(ctx, in, opts...)
 }
 
+// GetDegree returns the number of connections to & from a doc - in total & per connection type
+func (c *Client) GetDegree(ctx context.Context, in *apipb.DegreeFilter, opts ...grpc.CallOption) (*apipb.Degree, error) {
+	return c.graph.GetDegree(ctx, in, opts...)
+}
+
 // Broadcast broadcasts a message to a pubsub channel
 func (c *Client) Broadcast(ctx context.Context, in *apipb.OutboundMessage, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return c.graph.Broadcast(ctx, in, opts...)
@@ -317,13 +423,61 @@ func (c *Client) Traverse(ctx context.Context, in *apipb.TraverseFilter, opts ..
 	return c.graph.Traverse(ctx, in, opts...)
 }
 
+// ShortestPath finds the lowest cost path(s) between two docs
+func (c *Client) ShortestPath(ctx context.Context, in *apipb.PathFilter, opts ...grpc.CallOption) (*apipb.Paths, error) {
+	return c.graph.ShortestPath(ctx, in, opts...)
+}
+
+// AllPaths finds every loopless path between two docs(up to a max depth & max number of paths)
+func (c *Client) AllPaths(ctx context.Context, in *apipb.AllPathsFilter, opts ...grpc.CallOption) (*apipb.Paths, error) {
+	return c.graph.AllPaths(ctx, in, opts...)
+}
+
+// Match finds the docs & connections that match a pattern of nodes joined by edges
+func (c *Client) Match(ctx context.Context, in *apipb.MatchFilter, opts ...grpc.CallOption) (*apipb.Matches, error) {
+	return c.graph.Match(ctx, in, opts...)
+}
+
+// TopologicalSort orders the docs joined by directed connections of a type so every doc comes before the docs it's connected to.
+// The cycles that prevent an order are returned instead if there are any.
+func (c *Client) TopologicalSort(ctx context.Context, in *apipb.TopologicalSortFilter, opts ...grpc.CallOption) (*apipb.TopologicalOrder, error) {
+	return c.graph.TopologicalSort(ctx, in, opts...)
+}
+
+// ExtractSubgraph returns the docs within a max depth of one or more root docs & the connections that were traversed to reach them
+func (c *Client) ExtractSubgraph(ctx context.Context, in *apipb.ExtractFilter, opts ...grpc.CallOption) (*apipb.Graph, error) {
+	return c.graph.ExtractSubgraph(ctx, in, opts...)
+}
+
+// StreamTraverse streams traversals that pass the filter to the handler as they are read from the graph. Returning false from the handler cancels the stream.
+func (c *Client) StreamTraverse(ctx context.Context, in *apipb.TraverseFilter, handler func(traversal *apipb.Traversal) bool, opts ...grpc.CallOption) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.graph.StreamTraverse(ctx, in, opts...)
+	if err != nil {
+		return err
+	}
+	for {
+		traversal, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if !handler(traversal) {
+			return nil
+		}
+	}
+}
+
 // DelDoc deletes a doc by reference
 func (c *Client) DelDoc(ctx context.Context, in *apipb.Ref, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return c.graph.DelDoc(ctx, in, opts...)
 }
 
-// DelDocs deletes 0-many docs that pass a Filter
-func (c *Client) DelDocs(ctx context.Context, in *apipb.Filter, opts ...grpc.CallOption) (*empty.Empty, error) {
+// DelDocs deletes 0-many docs that pass a Filter & all of their connections. Set filter.dry_run to preview the deletion without committing it
+func (c *Client) DelDocs(ctx context.Context, in *apipb.Filter, opts ...grpc.CallOption) (*apipb.MutationResult, error) {
 	return c.graph.DelDocs(ctx, in, opts...)
 }
 
@@ -352,8 +506,8 @@ func (c *Client) DelConnection(ctx context.Context, in *apipb.Ref, opts ...grpc.
 	return c.graph.DelConnection(ctx, in, opts...)
 }
 
-// DelConnections deletes 0-many connections that pass a Filter
-func (c *Client) DelConnections(ctx context.Context, in *apipb.Filter, opts ...grpc.CallOption) (*empty.Empty, error) {
+// DelConnections deletes 0-many connections that pass a Filter. Set filter.dry_run to preview the deletion without committing it
+func (c *Client) DelConnections(ctx context.Context, in *apipb.Filter, opts ...grpc.CallOption) (*apipb.MutationResult, error) {
 	return c.graph.DelConnections(ctx, in, opts...)
 }
 
@@ -366,3 +520,70 @@ func (c *Client) AggregateDocs(ctx context.Context, in *apipb.AggFilter, opts ..
 func (c *Client) AggregateConnections(ctx context.Context, in *apipb.AggFilter, opts ...grpc.CallOption) (*apipb.Number, error) {
 	return c.graph.AggregateConnections(ctx, in, opts...)
 }
+
+// AggregateDocGroups executes one or more aggregation functions against groups of documents
+func (c *Client) AggregateDocGroups(ctx context.Context, in *apipb.GroupAggFilter, opts ...grpc.CallOption) (*apipb.AggGroups, error) {
+	return c.graph.AggregateDocGroups(ctx, in, opts...)
+}
+
+// AggregateConnectionGroups executes one or more aggregation functions against groups of connections
+func (c *Client) AggregateConnectionGroups(ctx context.Context, in *apipb.GroupAggFilter, opts ...grpc.CallOption) (*apipb.AggGroups, error) {
+	return c.graph.AggregateConnectionGroups(ctx, in, opts...)
+}
+
+// ReEncrypt starts a background job that re-encrypts all docs & connections with the servers current encryption key (root users only)
+func (c *Client) ReEncrypt(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return c.graph.ReEncrypt(ctx, in, opts...)
+}
+
+// Migrate starts a background job that applies a versioned schema migration (root users only). Each version is applied exactly once
+func (c *Client) Migrate(ctx context.Context, in *apipb.Migration, opts ...grpc.CallOption) (*apipb.MigrationStatus, error) {
+	return c.graph.Migrate(ctx, in, opts...)
+}
+
+// GetMigrations returns the status of every migration that has been applied or attempted
+func (c *Client) GetMigrations(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*apipb.MigrationStatuses, error) {
+	return c.graph.GetMigrations(ctx, in, opts...)
+}
+
+// RunAnalytics starts a background job that runs a graph analytics algorithm over a subgraph (root users only)
+func (c *Client) RunAnalytics(ctx context.Context, in *apipb.AnalyticsJob, opts ...grpc.CallOption) (*apipb.AnalyticsStatus, error) {
+	return c.graph.RunAnalytics(ctx, in, opts...)
+}
+
+// GetAnalytics returns the status & progress of an analytics job
+func (c *Client) GetAnalytics(ctx context.Context, in *apipb.AnalyticsRef, opts ...grpc.CallOption) (*apipb.AnalyticsStatus, error) {
+	return c.graph.GetAnalytics(ctx, in, opts...)
+}
+
+// ListAnalytics returns the status of every analytics job within the namespace
+func (c *Client) ListAnalytics(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*apipb.AnalyticsStatuses, error) {
+	return c.graph.ListAnalytics(ctx, in, opts...)
+}
+
+// CancelAnalytics cancels a running analytics job (root users only)
+func (c *Client) CancelAnalytics(ctx context.Context, in *apipb.AnalyticsRef, opts ...grpc.CallOption) (*apipb.AnalyticsStatus, error) {
+	return c.graph.CancelAnalytics(ctx, in, opts...)
+}
+
+// StreamAnalyticsResults streams the results of a completed analytics job to the handler in descending order of value. Returning false from the handler cancels the stream.
+func (c *Client) StreamAnalyticsResults(ctx context.Context, in *apipb.AnalyticsRef, handler func(result *apipb.AnalyticsResult) bool, opts ...grpc.CallOption) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.graph.StreamAnalyticsResults(ctx, in, opts...)
+	if err != nil {
+		return err
+	}
+	for {
+		result, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if !handler(result) {
+			return nil
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"os"
+	"strconv"
 	"strings"
 )
 
@@ -51,6 +52,15 @@ func BoolEnvOr(key string, defaul bool) bool {
 	return defaul
 }
 
+func Uint64EnvOr(key string, defaul uint64) uint64 {
+	if value := os.Getenv(key); value != "" {
+		if val, err := strconv.ParseUint(value, 10, 64); err == nil {
+			return val
+		}
+	}
+	return defaul
+}
+
 func Hash(val []byte) string {
 	h := sha1.New()
 	h.Write(val)
@@ -52,6 +52,15 @@ func init() {
 	pflag.CommandLine.StringVar(&global.PlaygroundClientId, "playground-client-id", helpers.EnvOr("GRAPHIK_PLAYGROUND_CLIENT_ID", ""), "playground oauth client id (env: GRAPHIK_PLAYGROUND_CLIENT_ID)")
 	pflag.CommandLine.StringVar(&global.PlaygroundClientSecret, "playground-client-secret", helpers.EnvOr("GRAPHIK_PLAYGROUND_CLIENT_SECRET", ""), "playground oauth client secret (env: GRAPHIK_PLAYGROUND_CLIENT_SECRET)")
 	pflag.CommandLine.StringVar(&global.PlaygroundRedirect, "playground-redirect", helpers.EnvOr("GRAPHIK_PLAYGROUND_REDIRECT", ""), "playground oauth redirect (env: GRAPHIK_PLAYGROUND_REDIRECT)")
+	pflag.CommandLine.StringVar(&global.EncryptionKey, "encryption-key", helpers.EnvOr("GRAPHIK_ENCRYPTION_KEY", ""), "base64 encoded AES key used to encrypt docs & connections at rest - full-text, geo, vector & value indexes are refused while it's set (env: GRAPHIK_ENCRYPTION_KEY)")
+	pflag.CommandLine.StringVar(&global.EncryptionKeyFile, "encryption-key-file", helpers.EnvOr("GRAPHIK_ENCRYPTION_KEY_FILE", ""), "path to a file containing a base64 encoded AES key used to encrypt docs & connections at rest - full-text, geo, vector & value indexes are refused while it's set (env: GRAPHIK_ENCRYPTION_KEY_FILE)")
+	pflag.CommandLine.StringSliceVar(&global.DecryptionKeys, "decryption-keys", helpers.StringSliceEnvOr("GRAPHIK_DECRYPTION_KEYS", nil), "previous base64 encoded AES keys used to decrypt values after a key rotation (env: GRAPHIK_DECRYPTION_KEYS)")
+	pflag.CommandLine.StringVar(&global.IdempotencyWindow, "idempotency-window", helpers.EnvOr("GRAPHIK_IDEMPOTENCY_WINDOW", "24h"), "how long idempotency keys on create requests are remembered (env: GRAPHIK_IDEMPOTENCY_WINDOW)")
+	pflag.CommandLine.StringVar(&global.NamespaceClaim, "namespace-claim", helpers.EnvOr("GRAPHIK_NAMESPACE_CLAIM", ""), "userinfo claim used to determine the namespace(tenant) of a request ex: org_id (env: GRAPHIK_NAMESPACE_CLAIM)")
+	pflag.CommandLine.Uint64Var(&global.MaxScan, "max-scan", helpers.Uint64EnvOr("GRAPHIK_MAX_SCAN", 0), "maximum number of keys a search, aggregation or traversal may read - 0 is unlimited (env: GRAPHIK_MAX_SCAN)")
+	pflag.CommandLine.Uint64Var(&global.MaxEvaluations, "max-evaluations", helpers.Uint64EnvOr("GRAPHIK_MAX_EVALUATIONS", 0), "maximum number of CEL evaluations a search, aggregation or traversal may execute - 0 is unlimited (env: GRAPHIK_MAX_EVALUATIONS)")
+	pflag.CommandLine.StringVar(&global.QueryTimeout, "query-timeout", helpers.EnvOr("GRAPHIK_QUERY_TIMEOUT", "0"), "maximum duration of a search, aggregation or traversal - 0 is unlimited (env: GRAPHIK_QUERY_TIMEOUT)")
+	pflag.CommandLine.StringVar(&global.SlowQueryThreshold, "slow-query-threshold", helpers.EnvOr("GRAPHIK_SLOW_QUERY_THRESHOLD", "1s"), "duration after which a search, aggregation or traversal is written to the slow query log - 0 disables it (env: GRAPHIK_SLOW_QUERY_THRESHOLD)")
 	pflag.Parse()
 }
 
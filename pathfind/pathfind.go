@@ -0,0 +1,301 @@
+// Package pathfind contains the path finding algorithms(breadth first search, Dijkstra, Yen's k shortest paths & path
+// enumeration) used by ShortestPath & AllPaths. It's independent of storage - the graph is explored through a Neighbors func.
+package pathfind
+
+import (
+	"container/heap"
+	"errors"
+	"sort"
+	"strings"
+)
+
+// ErrNegativeWeight is returned when an edge has a negative weight(Dijkstra requires non-negative weights)
+var ErrNegativeWeight = errors.New("edge weights must be >= 0")
+
+// Edge is a directed edge to a node
+type Edge struct {
+	// ID identifies the edge - nodes may be connected by more than one edge
+	ID string
+	// To is the node the edge leads to
+	To string
+	// Weight is the cost of traversing the edge
+	Weight float64
+}
+
+// Neighbors returns the edges leaving a node
+type Neighbors func(node string) ([]Edge, error)
+
+// Path is a path from a source node to a target node
+type Path struct {
+	// Nodes are the nodes of the path in order, starting with the source & ending with the target
+	Nodes []string
+	// Edges are the edges between the nodes in order - len(Edges) == len(Nodes) - 1
+	Edges []Edge
+	// Cost is the sum of the weights of the edges
+	Cost float64
+}
+
+func (p *Path) key() string {
+	ids := make([]string, len(p.Edges))
+	for i, e := range p.Edges {
+		ids[i] = e.ID
+	}
+	return strings.Join(ids, "\x00")
+}
+
+// Shortest returns the lowest cost path from source to target or nil if the target is unreachable. If weighted is false
+// every edge is assumed to have the same weight & a breadth first search is used instead of Dijkstra's algorithm.
+func Shortest(source, target string, weighted bool, neighbors Neighbors) (*Path, error) {
+	return shortest(source, target, weighted, neighbors, nil, nil)
+}
+
+// KShortest returns up to k loopless paths from source to target in order of cost using Yen's algorithm
+func KShortest(source, target string, k int, weighted bool, neighbors Neighbors) ([]*Path, error) {
+	first, err := shortest(source, target, weighted, neighbors, nil, nil)
+	if err != nil || first == nil {
+		return nil, err
+	}
+	var (
+		paths      = []*Path{first}
+		candidates []*Path
+		seen       = map[string]struct{}{first.key(): {}}
+	)
+	for len(paths) < k {
+		last := paths[len(paths)-1]
+		for i := range last.Edges {
+			// the root of the path is kept & a new spur is found from it's last node
+			removedEdges := map[string]struct{}{}
+			for _, p := range paths {
+				if len(p.Edges) > i && sameEdges(p.Edges[:i], last.Edges[:i]) {
+					removedEdges[p.Edges[i].ID] = struct{}{}
+				}
+			}
+			removedNodes := map[string]struct{}{}
+			for _, node := range last.Nodes[:i] {
+				removedNodes[node] = struct{}{}
+			}
+			spur, err := shortest(last.Nodes[i], target, weighted, neighbors, removedNodes, removedEdges)
+			if err != nil {
+				return nil, err
+			}
+			if spur == nil {
+				continue
+			}
+			candidate := &Path{
+				Nodes: append(append([]string{}, last.Nodes[:i]...), spur.Nodes...),
+				Edges: append(append([]Edge{}, last.Edges[:i]...), spur.Edges...),
+			}
+			for _, e := range candidate.Edges {
+				candidate.Cost += e.Weight
+			}
+			if _, ok := seen[candidate.key()]; ok {
+				continue
+			}
+			seen[candidate.key()] = struct{}{}
+			candidates = append(candidates, candidate)
+		}
+		if len(candidates) == 0 {
+			break
+		}
+		sort.SliceStable(candidates, func(i, j int) bool {
+			if candidates[i].Cost == candidates[j].Cost {
+				return len(candidates[i].Edges) < len(candidates[j].Edges)
+			}
+			return candidates[i].Cost < candidates[j].Cost
+		})
+		paths = append(paths, candidates[0])
+		candidates = candidates[1:]
+	}
+	return paths, nil
+}
+
+// All returns up to max loopless paths from source to target with at most maxDepth edges. Paths are found by an
+// iterative deepening depth first search, so shorter paths(fewer edges) are returned first.
+func All(source, target string, maxDepth, max int, neighbors Neighbors) ([]*Path, error) {
+	if source == target {
+		return []*Path{{Nodes: []string{source}}}, nil
+	}
+	var (
+		paths  []*Path
+		nodes  = []string{source}
+		edges  []Edge
+		onPath = map[string]struct{}{source: {}}
+		walk   func(node string, depth int) error
+	)
+	walk = func(node string, depth int) error {
+		if node == target {
+			if len(edges) == depth {
+				path := &Path{
+					Nodes: append([]string{}, nodes...),
+					Edges: append([]Edge{}, edges...),
+				}
+				for _, e := range edges {
+					path.Cost += e.Weight
+				}
+				paths = append(paths, path)
+			}
+			return nil
+		}
+		if len(edges) == depth {
+			return nil
+		}
+		next, err := neighbors(node)
+		if err != nil {
+			return err
+		}
+		for _, e := range next {
+			if len(paths) >= max {
+				return nil
+			}
+			if _, ok := onPath[e.To]; ok {
+				continue
+			}
+			onPath[e.To] = struct{}{}
+			nodes = append(nodes, e.To)
+			edges = append(edges, e)
+			if err := walk(e.To, depth); err != nil {
+				return err
+			}
+			delete(onPath, e.To)
+			nodes = nodes[:len(nodes)-1]
+			edges = edges[:len(edges)-1]
+		}
+		return nil
+	}
+	for depth := 1; depth <= maxDepth && len(paths) < max; depth++ {
+		if err := walk(source, depth); err != nil {
+			return nil, err
+		}
+	}
+	return paths, nil
+}
+
+func sameEdges(a, b []Edge) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].ID != b[i].ID {
+			return false
+		}
+	}
+	return true
+}
+
+// step is how a node was reached
+type step struct {
+	from string
+	edge Edge
+	cost float64
+}
+
+func shortest(source, target string, weighted bool, neighbors Neighbors, removedNodes, removedEdges map[string]struct{}) (*Path, error) {
+	steps := map[string]step{source: {}}
+	if source == target {
+		return build(source, target, steps), nil
+	}
+	skip := func(e Edge) bool {
+		_, removedNode := removedNodes[e.To]
+		_, removedEdge := removedEdges[e.ID]
+		return removedNode || removedEdge
+	}
+	if !weighted {
+		queue := []string{source}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+			edges, err := neighbors(node)
+			if err != nil {
+				return nil, err
+			}
+			for _, e := range edges {
+				if _, ok := steps[e.To]; ok || skip(e) {
+					continue
+				}
+				steps[e.To] = step{from: node, edge: e, cost: steps[node].cost + e.Weight}
+				if e.To == target {
+					return build(source, target, steps), nil
+				}
+				queue = append(queue, e.To)
+			}
+		}
+		return nil, nil
+	}
+	var (
+		queue = &nodeHeap{{node: source}}
+		done  = map[string]struct{}{}
+	)
+	for queue.Len() > 0 {
+		current := heap.Pop(queue).(queued)
+		if _, ok := done[current.node]; ok {
+			continue
+		}
+		if current.node == target {
+			return build(source, target, steps), nil
+		}
+		done[current.node] = struct{}{}
+		edges, err := neighbors(current.node)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range edges {
+			if e.Weight < 0 {
+				return nil, ErrNegativeWeight
+			}
+			if _, ok := done[e.To]; ok || skip(e) {
+				continue
+			}
+			cost := current.cost + e.Weight
+			if prev, ok := steps[e.To]; ok && prev.cost <= cost {
+				continue
+			}
+			steps[e.To] = step{from: current.node, edge: e, cost: cost}
+			heap.Push(queue, queued{node: e.To, cost: cost})
+		}
+	}
+	return nil, nil
+}
+
+// build walks the steps back from the target to the source
+func build(source, target string, steps map[string]step) *Path {
+	path := &Path{Nodes: []string{target}, Cost: steps[target].cost}
+	for node := target; node != source; node = steps[node].from {
+		path.Nodes = append(path.Nodes, steps[node].from)
+		path.Edges = append(path.Edges, steps[node].edge)
+	}
+	for i, j := 0, len(path.Nodes)-1; i < j; i, j = i+1, j-1 {
+		path.Nodes[i], path.Nodes[j] = path.Nodes[j], path.Nodes[i]
+	}
+	for i, j := 0, len(path.Edges)-1; i < j; i, j = i+1, j-1 {
+		path.Edges[i], path.Edges[j] = path.Edges[j], path.Edges[i]
+	}
+	return path
+}
+
+type queued struct {
+	node string
+	cost float64
+}
+
+// nodeHeap is a min heap of nodes ordered by cost(then node for determinism)
+type nodeHeap []queued
+
+func (h nodeHeap) Len() int { return len(h) }
+
+func (h nodeHeap) Less(i, j int) bool {
+	if h[i].cost == h[j].cost {
+		return h[i].node < h[j].node
+	}
+	return h[i].cost < h[j].cost
+}
+
+func (h nodeHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *nodeHeap) Push(x interface{}) { *h = append(*h, x.(queued)) }
+
+func (h *nodeHeap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
@@ -0,0 +1,105 @@
+package pathfind_test
+
+import (
+	"github.com/graphikDB/graphik/pathfind"
+	"strings"
+	"testing"
+)
+
+// graph is the example graph from https://en.wikipedia.org/wiki/Yen%27s_algorithm
+func graph() pathfind.Neighbors {
+	edges := map[string][]pathfind.Edge{
+		"C": {{ID: "CD", To: "D", Weight: 3}, {ID: "CE", To: "E", Weight: 2}},
+		"D": {{ID: "DF", To: "F", Weight: 4}},
+		"E": {{ID: "ED", To: "D", Weight: 1}, {ID: "EF", To: "F", Weight: 2}, {ID: "EG", To: "G", Weight: 3}},
+		"F": {{ID: "FG", To: "G", Weight: 2}, {ID: "FH", To: "H", Weight: 1}},
+		"G": {{ID: "GH", To: "H", Weight: 2}},
+	}
+	return func(node string) ([]pathfind.Edge, error) {
+		return edges[node], nil
+	}
+}
+
+func TestShortest(t *testing.T) {
+	path, err := pathfind.Shortest("C", "H", true, graph())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(path.Nodes, ""); got != "CEFH" || path.Cost != 5 {
+		t.Fatalf("expected CEFH(5), got %s(%v)", got, path.Cost)
+	}
+	if len(path.Edges) != 3 || path.Edges[0].ID != "CE" {
+		t.Fatalf("unexpected edges: %v", path.Edges)
+	}
+	// unweighted - fewest hops
+	path, err = pathfind.Shortest("C", "G", false, graph())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(path.Nodes, ""); got != "CEG" {
+		t.Fatalf("expected CEG, got %s", got)
+	}
+	path, err = pathfind.Shortest("H", "C", true, graph())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != nil {
+		t.Fatalf("expected no path, got %v", path.Nodes)
+	}
+}
+
+func TestKShortest(t *testing.T) {
+	paths, err := pathfind.KShortest("C", "H", 3, true, graph())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"CEFH", "CEGH", "CDFH"}
+	costs := []float64{5, 7, 8}
+	if len(paths) != len(expected) {
+		t.Fatalf("expected %v paths, got %v", len(expected), len(paths))
+	}
+	for i, path := range paths {
+		if got := strings.Join(path.Nodes, ""); got != expected[i] || path.Cost != costs[i] {
+			t.Fatalf("path %v: expected %s(%v), got %s(%v)", i, expected[i], costs[i], got, path.Cost)
+		}
+	}
+}
+
+func TestNegativeWeight(t *testing.T) {
+	_, err := pathfind.Shortest("A", "B", true, func(node string) ([]pathfind.Edge, error) {
+		return []pathfind.Edge{{ID: "AB", To: "B", Weight: -1}}, nil
+	})
+	if err != pathfind.ErrNegativeWeight {
+		t.Fatalf("expected ErrNegativeWeight, got %v", err)
+	}
+}
+
+func TestAll(t *testing.T) {
+	paths, err := pathfind.All("C", "H", 3, 100, graph())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, path := range paths {
+		got = append(got, strings.Join(path.Nodes, ""))
+	}
+	// shorter paths first
+	expected := "CDFH,CEFH,CEGH"
+	if strings.Join(got, ",") != expected {
+		t.Fatalf("expected %s, got %s", expected, strings.Join(got, ","))
+	}
+	paths, err = pathfind.All("C", "H", 10, 100, graph())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) != 7 {
+		t.Fatalf("expected 7 paths, got %v", len(paths))
+	}
+	paths, err = pathfind.All("C", "H", 10, 2, graph())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) != 2 {
+		t.Fatalf("expected 2 paths, got %v", len(paths))
+	}
+}
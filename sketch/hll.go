@@ -0,0 +1,84 @@
+package sketch
+
+import (
+	"hash/fnv"
+	"math"
+	"math/bits"
+)
+
+const (
+	// hllPrecision is the number of hash bits used to select a register(2^14 registers ~ 0.8% standard error)
+	hllPrecision = 14
+	hllRegisters = 1 << hllPrecision
+)
+
+// HyperLogLog approximates the number of distinct values added to it using a fixed 16KB of memory
+type HyperLogLog struct {
+	registers []uint8
+}
+
+// NewHyperLogLog returns an empty HyperLogLog
+func NewHyperLogLog() *HyperLogLog {
+	return &HyperLogLog{
+		registers: make([]uint8, hllRegisters),
+	}
+}
+
+// Add adds a value to the HyperLogLog
+func (h *HyperLogLog) Add(value []byte) {
+	hash := hash64(value)
+	index := hash >> (64 - hllPrecision)
+	rank := uint8(bits.LeadingZeros64(hash<<hllPrecision|1<<(hllPrecision-1))) + 1
+	if rank > h.registers[index] {
+		h.registers[index] = rank
+	}
+}
+
+// AddString adds a string value to the HyperLogLog
+func (h *HyperLogLog) AddString(value string) {
+	h.Add([]byte(value))
+}
+
+// Count returns the approximate number of distinct values added to the HyperLogLog
+func (h *HyperLogLog) Count() uint64 {
+	var (
+		sum   float64
+		zeros int
+	)
+	for _, r := range h.registers {
+		sum += 1 / float64(uint64(1)<<r)
+		if r == 0 {
+			zeros++
+		}
+	}
+	m := float64(hllRegisters)
+	alpha := 0.7213 / (1 + 1.079/m)
+	estimate := alpha * m * m / sum
+	// small range correction - linear counting is far more accurate while registers are still empty
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(estimate + 0.5)
+}
+
+// Merge merges another HyperLogLog into h so h approximates the distinct values added to either
+func (h *HyperLogLog) Merge(other *HyperLogLog) {
+	for i, r := range other.registers {
+		if r > h.registers[i] {
+			h.registers[i] = r
+		}
+	}
+}
+
+// hash64 is FNV-1a followed by a 64 bit finalizer(splitmix64) so similar values are spread across registers
+func hash64(value []byte) uint64 {
+	hasher := fnv.New64a()
+	hasher.Write(value)
+	x := hasher.Sum64()
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,19 @@
+package sketch_test
+
+import (
+	"fmt"
+	"github.com/graphikDB/graphik/sketch"
+	"math"
+	"testing"
+)
+
+func TestHyperLogLog(t *testing.T) {
+	hll := sketch.NewHyperLogLog()
+	for i := 0; i < 100000; i++ {
+		hll.AddString(fmt.Sprintf("value-%v", i%50000))
+	}
+	count := float64(hll.Count())
+	if math.Abs(count-50000)/50000 > 0.03 {
+		t.Fatalf("expected ~50000 distinct values, got %v", count)
+	}
+}
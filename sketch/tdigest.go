@@ -0,0 +1,129 @@
+package sketch
+
+import (
+	"math"
+	"sort"
+)
+
+// DefaultCompression is the t-digest compression used when a compression <= 0 is given. Higher values are more accurate
+// but use more memory.
+const DefaultCompression = 100
+
+type centroid struct {
+	mean   float64
+	weight float64
+}
+
+// TDigest approximates quantiles(percentiles, medians) of the values added to it using bounded memory. It's most accurate
+// at the tails(ex: p99) which is where accuracy matters most.
+type TDigest struct {
+	compression float64
+	centroids   []centroid
+	buffer      []float64
+	count       float64
+	min         float64
+	max         float64
+}
+
+// NewTDigest returns an empty TDigest
+func NewTDigest(compression float64) *TDigest {
+	if compression <= 0 {
+		compression = DefaultCompression
+	}
+	return &TDigest{
+		compression: compression,
+		min:         math.Inf(1),
+		max:         math.Inf(-1),
+	}
+}
+
+// Add adds a value to the TDigest
+func (t *TDigest) Add(value float64) {
+	if math.IsNaN(value) {
+		return
+	}
+	t.buffer = append(t.buffer, value)
+	t.count++
+	if value < t.min {
+		t.min = value
+	}
+	if value > t.max {
+		t.max = value
+	}
+	if len(t.buffer) >= int(10*t.compression) {
+		t.compress()
+	}
+}
+
+// Count returns the number of values added to the TDigest
+func (t *TDigest) Count() uint64 {
+	return uint64(t.count)
+}
+
+// Quantile returns the approximate value at quantile q(0-1) ex: Quantile(.99) is the 99th percentile
+func (t *TDigest) Quantile(q float64) float64 {
+	t.compress()
+	if len(t.centroids) == 0 {
+		return 0
+	}
+	switch {
+	case q <= 0:
+		return t.min
+	case q >= 1:
+		return t.max
+	case len(t.centroids) == 1:
+		return t.centroids[0].mean
+	}
+	target := q * t.count
+	first := t.centroids[0]
+	if target < first.weight/2 {
+		return t.min + (first.mean-t.min)*target/(first.weight/2)
+	}
+	var cumulative float64
+	for i := 0; i < len(t.centroids)-1; i++ {
+		left, right := t.centroids[i], t.centroids[i+1]
+		leftCenter := cumulative + left.weight/2
+		rightCenter := cumulative + left.weight + right.weight/2
+		if target < rightCenter {
+			return left.mean + (right.mean-left.mean)*(target-leftCenter)/(rightCenter-leftCenter)
+		}
+		cumulative += left.weight
+	}
+	last := t.centroids[len(t.centroids)-1]
+	lastCenter := t.count - last.weight/2
+	if last.weight/2 == 0 {
+		return t.max
+	}
+	return last.mean + (t.max-last.mean)*(target-lastCenter)/(last.weight/2)
+}
+
+// compress merges buffered values into the centroids. A centroid may only grow while it's within the size bound for
+// it's quantile so centroids near the tails stay small.
+func (t *TDigest) compress() {
+	if len(t.buffer) == 0 {
+		return
+	}
+	all := t.centroids
+	for _, value := range t.buffer {
+		all = append(all, centroid{mean: value, weight: 1})
+	}
+	t.buffer = t.buffer[:0]
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].mean < all[j].mean
+	})
+	merged := []centroid{all[0]}
+	var cumulative float64
+	for _, c := range all[1:] {
+		current := &merged[len(merged)-1]
+		q := (cumulative + (current.weight+c.weight)/2) / t.count
+		limit := 4 * t.count * q * (1 - q) / t.compression
+		if current.weight+c.weight <= limit {
+			current.mean += (c.mean - current.mean) * c.weight / (current.weight + c.weight)
+			current.weight += c.weight
+			continue
+		}
+		cumulative += current.weight
+		merged = append(merged, c)
+	}
+	t.centroids = merged
+}
@@ -0,0 +1,21 @@
+package sketch_test
+
+import (
+	"github.com/graphikDB/graphik/sketch"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestTDigest(t *testing.T) {
+	digest := sketch.NewTDigest(0)
+	for _, i := range rand.Perm(100000) {
+		digest.Add(float64(i))
+	}
+	for _, q := range []float64{.5, .9, .99} {
+		expected := q * 100000
+		if val := digest.Quantile(q); math.Abs(val-expected)/expected > 0.01 {
+			t.Fatalf("expected quantile %v to be ~%v, got %v", q, expected, val)
+		}
+	}
+}
@@ -0,0 +1,312 @@
+package vector
+
+import (
+	"container/heap"
+	"math"
+	"math/rand"
+)
+
+// Store persists the nodes & links of an HNSW graph
+type Store interface {
+	// Node returns the vector & level of a node. ok is false if the node doesn't exist.
+	Node(id string) (vec []float32, level int, ok bool, err error)
+	SetNode(id string, vec []float32, level int) error
+	DelNode(id string) error
+	// Neighbors returns the ids of the node's neighbors at a level
+	Neighbors(id string, level int) ([]string, error)
+	SetNeighbors(id string, level int, neighbors []string) error
+	// Entry returns the graph's entry point. ok is false if the graph is empty.
+	Entry() (id string, level int, ok bool, err error)
+	// SetEntry sets the graph's entry point. An empty id clears the entry point.
+	SetEntry(id string, level int) error
+	// Any returns any node in the graph other than exclude. ok is false if there are no other nodes.
+	Any(exclude string) (id string, level int, ok bool, err error)
+}
+
+// HNSW is a hierarchical navigable small world graph used for approximate nearest neighbor search
+type HNSW struct {
+	Metric Metric
+	// M is the number of neighbors each node links to(2*M on the bottom level)
+	M int
+	// EfConstruction is the size of the candidate list used when inserting nodes - higher values build a more accurate graph
+	EfConstruction int
+	Store          Store
+}
+
+// NewHNSW returns an HNSW graph with sensible defaults(M=16, EfConstruction=100)
+func NewHNSW(metric Metric, store Store) *HNSW {
+	return &HNSW{
+		Metric:         metric,
+		M:              16,
+		EfConstruction: 100,
+		Store:          store,
+	}
+}
+
+func (h *HNSW) maxNeighbors(level int) int {
+	if level == 0 {
+		return 2 * h.M
+	}
+	return h.M
+}
+
+func (h *HNSW) randomLevel() int {
+	return int(math.Floor(-math.Log(1-rand.Float64()) / math.Log(float64(h.M))))
+}
+
+// Insert adds a node to the graph. The node must not already exist(delete it first).
+func (h *HNSW) Insert(id string, vec []float32) error {
+	level := h.randomLevel()
+	if err := h.Store.SetNode(id, vec, level); err != nil {
+		return err
+	}
+	entry, maxLevel, ok, err := h.Store.Entry()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return h.Store.SetEntry(id, level)
+	}
+	entryPoints := []string{entry}
+	for l := maxLevel; l > level; l-- {
+		closest, err := h.searchLayer(vec, entryPoints, 1, l)
+		if err != nil {
+			return err
+		}
+		if len(closest) > 0 {
+			entryPoints = []string{closest[0].ID}
+		}
+	}
+	for l := minInt(level, maxLevel); l >= 0; l-- {
+		candidates, err := h.searchLayer(vec, entryPoints, h.EfConstruction, l)
+		if err != nil {
+			return err
+		}
+		var neighbors []string
+		for _, candidate := range candidates {
+			if len(neighbors) >= h.maxNeighbors(l) {
+				break
+			}
+			neighbors = append(neighbors, candidate.ID)
+		}
+		if err := h.Store.SetNeighbors(id, l, neighbors); err != nil {
+			return err
+		}
+		for _, neighbor := range neighbors {
+			links, err := h.Store.Neighbors(neighbor, l)
+			if err != nil {
+				return err
+			}
+			if err := h.link(neighbor, l, append(links, id)); err != nil {
+				return err
+			}
+		}
+		entryPoints = entryPoints[:0]
+		for _, candidate := range candidates {
+			entryPoints = append(entryPoints, candidate.ID)
+		}
+	}
+	if level > maxLevel {
+		return h.Store.SetEntry(id, level)
+	}
+	return nil
+}
+
+// link sets a node's neighbors - keeping the closest if there are too many
+func (h *HNSW) link(id string, level int, links []string) error {
+	if len(links) <= h.maxNeighbors(level) {
+		return h.Store.SetNeighbors(id, level, links)
+	}
+	vec, _, ok, err := h.Store.Node(id)
+	if err != nil || !ok {
+		return err
+	}
+	top := NewTopK(h.maxNeighbors(level))
+	for _, link := range links {
+		linkVec, _, ok, err := h.Store.Node(link)
+		if err != nil {
+			return err
+		}
+		if ok {
+			top.Add(Result{ID: link, Distance: Distance(h.Metric, vec, linkVec)})
+		}
+	}
+	links = links[:0]
+	for _, result := range top.Results() {
+		links = append(links, result.ID)
+	}
+	return h.Store.SetNeighbors(id, level, links)
+}
+
+// Delete removes a node from the graph. The node's neighbors are relinked to each other so the graph stays connected.
+func (h *HNSW) Delete(id string) error {
+	_, level, ok, err := h.Store.Node(id)
+	if err != nil || !ok {
+		return err
+	}
+	var replacement string
+	replacementLevel := -1
+	for l := level; l >= 0; l-- {
+		neighbors, err := h.Store.Neighbors(id, l)
+		if err != nil {
+			return err
+		}
+		for _, neighbor := range neighbors {
+			links, err := h.Store.Neighbors(neighbor, l)
+			if err != nil {
+				return err
+			}
+			relinked := make([]string, 0, len(links)+len(neighbors))
+			seen := map[string]struct{}{neighbor: {}, id: {}}
+			for _, link := range append(links, neighbors...) {
+				if _, ok := seen[link]; !ok {
+					seen[link] = struct{}{}
+					relinked = append(relinked, link)
+				}
+			}
+			if err := h.link(neighbor, l, relinked); err != nil {
+				return err
+			}
+			if replacementLevel < l {
+				replacement, replacementLevel = neighbor, l
+			}
+		}
+		if err := h.Store.SetNeighbors(id, l, nil); err != nil {
+			return err
+		}
+	}
+	if err := h.Store.DelNode(id); err != nil {
+		return err
+	}
+	entry, _, _, err := h.Store.Entry()
+	if err != nil || entry != id {
+		return err
+	}
+	if replacement == "" {
+		var ok bool
+		replacement, replacementLevel, ok, err = h.Store.Any(id)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return h.Store.SetEntry("", 0)
+		}
+	}
+	_, replacementLevel, _, err = h.Store.Node(replacement)
+	if err != nil {
+		return err
+	}
+	return h.Store.SetEntry(replacement, replacementLevel)
+}
+
+// Search returns the k nearest nodes that pass the filter(closest first). ef is the size of the candidate list - higher
+// values are more accurate. The filter may be nil.
+func (h *HNSW) Search(query []float32, k int, ef int, filter func(id string) bool) ([]Result, error) {
+	entry, maxLevel, ok, err := h.Store.Entry()
+	if err != nil || !ok {
+		return nil, err
+	}
+	entryPoints := []string{entry}
+	for l := maxLevel; l > 0; l-- {
+		closest, err := h.searchLayer(query, entryPoints, 1, l)
+		if err != nil {
+			return nil, err
+		}
+		if len(closest) > 0 {
+			entryPoints = []string{closest[0].ID}
+		}
+	}
+	candidates, err := h.searchLayer(query, entryPoints, maxInt(ef, k), 0)
+	if err != nil {
+		return nil, err
+	}
+	var results []Result
+	for _, candidate := range candidates {
+		if len(results) >= k {
+			break
+		}
+		if filter == nil || filter(candidate.ID) {
+			results = append(results, candidate)
+		}
+	}
+	return results, nil
+}
+
+// searchLayer returns the ef nearest nodes to the query on a single level(closest first)
+func (h *HNSW) searchLayer(query []float32, entryPoints []string, ef int, level int) ([]Result, error) {
+	visited := map[string]struct{}{}
+	candidates := &candidateHeap{}
+	results := NewTopK(ef)
+	for _, id := range entryPoints {
+		vec, _, ok, err := h.Store.Node(id)
+		if err != nil {
+			return nil, err
+		}
+		visited[id] = struct{}{}
+		if !ok {
+			continue
+		}
+		result := Result{ID: id, Distance: Distance(h.Metric, query, vec)}
+		heap.Push(candidates, result)
+		results.Add(result)
+	}
+	for candidates.Len() > 0 {
+		current := heap.Pop(candidates).(Result)
+		found := results.Results()
+		if len(found) >= ef && current.Distance > found[len(found)-1].Distance {
+			break
+		}
+		neighbors, err := h.Store.Neighbors(current.ID, level)
+		if err != nil {
+			return nil, err
+		}
+		for _, neighbor := range neighbors {
+			if _, ok := visited[neighbor]; ok {
+				continue
+			}
+			visited[neighbor] = struct{}{}
+			vec, _, ok, err := h.Store.Node(neighbor)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			result := Result{ID: neighbor, Distance: Distance(h.Metric, query, vec)}
+			found := results.Results()
+			if len(found) < ef || result.Distance < found[len(found)-1].Distance {
+				heap.Push(candidates, result)
+				results.Add(result)
+			}
+		}
+	}
+	return results.Results(), nil
+}
+
+// candidateHeap is a min heap of results ordered by distance
+type candidateHeap []Result
+
+func (c candidateHeap) Len() int            { return len(c) }
+func (c candidateHeap) Less(i, j int) bool  { return c[i].Distance < c[j].Distance }
+func (c candidateHeap) Swap(i, j int)       { c[i], c[j] = c[j], c[i] }
+func (c *candidateHeap) Push(x interface{}) { *c = append(*c, x.(Result)) }
+func (c *candidateHeap) Pop() interface{} {
+	old := *c
+	result := old[len(old)-1]
+	*c = old[:len(old)-1]
+	return result
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,123 @@
+package vector_test
+
+import (
+	"fmt"
+	"github.com/graphikDB/graphik/vector"
+	"math/rand"
+	"testing"
+)
+
+type memStore struct {
+	nodes      map[string][]float32
+	levels     map[string]int
+	links      map[string][]string
+	entry      string
+	entryLevel int
+}
+
+func (m *memStore) Node(id string) ([]float32, int, bool, error) {
+	vec, ok := m.nodes[id]
+	return vec, m.levels[id], ok, nil
+}
+
+func (m *memStore) SetNode(id string, vec []float32, level int) error {
+	m.nodes[id], m.levels[id] = vec, level
+	return nil
+}
+
+func (m *memStore) DelNode(id string) error {
+	delete(m.nodes, id)
+	delete(m.levels, id)
+	return nil
+}
+
+func (m *memStore) Neighbors(id string, level int) ([]string, error) {
+	return append([]string{}, m.links[fmt.Sprint(id, level)]...), nil
+}
+
+func (m *memStore) SetNeighbors(id string, level int, neighbors []string) error {
+	m.links[fmt.Sprint(id, level)] = append([]string{}, neighbors...)
+	return nil
+}
+
+func (m *memStore) Entry() (string, int, bool, error) {
+	return m.entry, m.entryLevel, m.entry != "", nil
+}
+
+func (m *memStore) SetEntry(id string, level int) error {
+	m.entry, m.entryLevel = id, level
+	return nil
+}
+
+func (m *memStore) Any(exclude string) (string, int, bool, error) {
+	for id, level := range m.levels {
+		if id != exclude {
+			return id, level, true, nil
+		}
+	}
+	return "", 0, false, nil
+}
+
+func randomVector(dims int) []float32 {
+	vec := make([]float32, dims)
+	for i := range vec {
+		vec[i] = rand.Float32()
+	}
+	return vec
+}
+
+func TestHNSW(t *testing.T) {
+	store := &memStore{nodes: map[string][]float32{}, levels: map[string]int{}, links: map[string][]string{}}
+	h := vector.NewHNSW(vector.L2, store)
+	for i := 0; i < 2000; i++ {
+		if err := h.Insert(fmt.Sprint(i), randomVector(16)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < 500; i++ {
+		if err := h.Delete(fmt.Sprint(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var hits, total int
+	for q := 0; q < 20; q++ {
+		query := randomVector(16)
+		exact := vector.NewTopK(10)
+		for id, vec := range store.nodes {
+			exact.Add(vector.Result{ID: id, Distance: vector.Distance(vector.L2, query, vec)})
+		}
+		approx, err := h.Search(query, 10, 64, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		found := map[string]struct{}{}
+		for _, result := range approx {
+			found[result.ID] = struct{}{}
+		}
+		for _, result := range exact.Results() {
+			if _, ok := found[result.ID]; ok {
+				hits++
+			}
+			total++
+		}
+	}
+	if recall := float64(hits) / float64(total); recall < 0.9 {
+		t.Fatalf("expected recall >= 0.9, got %v", recall)
+	}
+}
+
+func TestDistance(t *testing.T) {
+	a, b := []float32{1, 0}, []float32{0, 1}
+	if d := vector.Distance(vector.Cosine, a, a); d > 1e-9 {
+		t.Fatalf("expected cosine distance 0, got %v", d)
+	}
+	if d := vector.Distance(vector.Cosine, a, b); d != 1 {
+		t.Fatalf("expected cosine distance 1, got %v", d)
+	}
+	if d := vector.Distance(vector.L2, []float32{0, 0}, []float32{3, 4}); d != 5 {
+		t.Fatalf("expected l2 distance 5, got %v", d)
+	}
+	if d := vector.Distance(vector.Dot, []float32{1, 2}, []float32{3, 4}); d != -11 {
+		t.Fatalf("expected dot distance -11, got %v", d)
+	}
+}
@@ -0,0 +1,110 @@
+// Package vector contains the distance metrics & HNSW(hierarchical navigable small world) graph used by vector indexes
+package vector
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+)
+
+// Metric is a vector distance metric
+type Metric int
+
+const (
+	// Cosine distance is 1 - cosine similarity
+	Cosine Metric = iota
+	// Dot distance is the negative dot product(higher dot products are closer)
+	Dot
+	// L2 distance is the euclidean distance
+	L2
+)
+
+// Distance returns the distance between two vectors of the same length. Lower distances are more similar.
+func Distance(metric Metric, a, b []float32) float64 {
+	switch metric {
+	case Dot:
+		return -dot(a, b)
+	case L2:
+		var sum float64
+		for i := range a {
+			d := float64(a[i]) - float64(b[i])
+			sum += d * d
+		}
+		return math.Sqrt(sum)
+	default:
+		normA, normB := math.Sqrt(dot(a, a)), math.Sqrt(dot(b, b))
+		if normA == 0 || normB == 0 {
+			return 1
+		}
+		return 1 - dot(a, b)/(normA*normB)
+	}
+}
+
+func dot(a, b []float32) float64 {
+	var sum float64
+	for i := range a {
+		sum += float64(a[i]) * float64(b[i])
+	}
+	return sum
+}
+
+// Encode encodes a vector as little endian float32s
+func Encode(vec []float32) []byte {
+	bits := make([]byte, 4*len(vec))
+	for i, v := range vec {
+		binary.LittleEndian.PutUint32(bits[i*4:], math.Float32bits(v))
+	}
+	return bits
+}
+
+// Decode decodes a vector encoded with Encode
+func Decode(bits []byte) []float32 {
+	vec := make([]float32, len(bits)/4)
+	for i := range vec {
+		vec[i] = math.Float32frombits(binary.LittleEndian.Uint32(bits[i*4:]))
+	}
+	return vec
+}
+
+// Result is a vector id & it's distance from the query
+type Result struct {
+	ID       string
+	Distance float64
+}
+
+// TopK keeps the k closest results added to it
+type TopK struct {
+	k       int
+	results []Result
+}
+
+// NewTopK returns an empty TopK
+func NewTopK(k int) *TopK {
+	return &TopK{k: k}
+}
+
+// Accepts returns true if a result at the distance would be kept by Add
+func (t *TopK) Accepts(distance float64) bool {
+	return len(t.results) < t.k || distance < t.results[len(t.results)-1].Distance
+}
+
+// Add adds a result - it's dropped if k closer results have already been added
+func (t *TopK) Add(result Result) {
+	if !t.Accepts(result.Distance) {
+		return
+	}
+	i := sort.Search(len(t.results), func(i int) bool {
+		return t.results[i].Distance > result.Distance
+	})
+	t.results = append(t.results, Result{})
+	copy(t.results[i+1:], t.results[i:])
+	t.results[i] = result
+	if len(t.results) > t.k {
+		t.results = t.results[:t.k]
+	}
+}
+
+// Results returns the results sorted by distance(closest first)
+func (t *TopK) Results() []Result {
+	return t.results
+}
@@ -0,0 +1,123 @@
+package vm
+
+import (
+	"github.com/google/cel-go/cel"
+	"github.com/pkg/errors"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	"strings"
+)
+
+// Conjunct is a single clause of an expression's top level &&s
+type Conjunct struct {
+	// Expression is the normalized source of the clause - clauses with the same meaning & formatting differences have the same Expression
+	Expression string
+	// Path is set if the clause compares an attribute to a constant ex: this.attributes.age >= 21 -> attributes.age
+	Path string
+	// Operator is the comparison operator(==, <, <=, >, >=) with the path on the left hand side
+	Operator string
+	// Value is the constant the path is compared to(string, float64 or bool)
+	Value interface{}
+}
+
+var (
+	comparisons = map[string]string{
+		"_==_": "==",
+		"_<_":  "<",
+		"_<=_": "<=",
+		"_>_":  ">",
+		"_>=_": ">=",
+	}
+	// flipped converts an operator with the constant on the left hand side to the equivalent operator with the path on the left hand side
+	flipped = map[string]string{
+		"==": "==",
+		"<":  ">",
+		"<=": ">=",
+		">":  "<",
+		">=": "<=",
+	}
+)
+
+// Conjuncts splits an expression's top level &&s into clauses
+func (n *DocVM) Conjuncts(expression string) ([]Conjunct, error) {
+	return conjuncts(n.e, expression)
+}
+
+// Conjuncts splits an expression's top level &&s into clauses
+func (n *ConnectionVM) Conjuncts(expression string) ([]Conjunct, error) {
+	return conjuncts(n.e, expression)
+}
+
+func conjuncts(e *cel.Env, expression string) ([]Conjunct, error) {
+	if expression == "" {
+		return nil, errors.New("empty expression")
+	}
+	ast, iss := e.Parse(expression)
+	if iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	var clauses []Conjunct
+	for _, expr := range splitAnd(ast.Expr(), nil) {
+		source, err := cel.AstToString(cel.ParsedExprToAst(&exprpb.ParsedExpr{
+			Expr:       expr,
+			SourceInfo: ast.SourceInfo(),
+		}))
+		if err != nil {
+			return nil, err
+		}
+		clause := Conjunct{Expression: source}
+		if call := expr.GetCallExpr(); call != nil && len(call.GetArgs()) == 2 {
+			if op, ok := comparisons[call.GetFunction()]; ok {
+				left, right := call.GetArgs()[0], call.GetArgs()[1]
+				if path, value, ok := comparison(left, right); ok {
+					clause.Path, clause.Operator, clause.Value = path, op, value
+				} else if path, value, ok := comparison(right, left); ok {
+					clause.Path, clause.Operator, clause.Value = path, flipped[op], value
+				}
+			}
+		}
+		clauses = append(clauses, clause)
+	}
+	return clauses, nil
+}
+
+func splitAnd(expr *exprpb.Expr, clauses []*exprpb.Expr) []*exprpb.Expr {
+	if call := expr.GetCallExpr(); call != nil && call.GetFunction() == "_&&_" {
+		for _, arg := range call.GetArgs() {
+			clauses = splitAnd(arg, clauses)
+		}
+		return clauses
+	}
+	return append(clauses, expr)
+}
+
+// comparison returns the attribute path & constant value of a path/constant pair ex: this.attributes.age, 21
+func comparison(pathExpr, valueExpr *exprpb.Expr) (string, interface{}, bool) {
+	var fields []string
+	for pathExpr.GetSelectExpr() != nil {
+		fields = append([]string{pathExpr.GetSelectExpr().GetField()}, fields...)
+		pathExpr = pathExpr.GetSelectExpr().GetOperand()
+	}
+	if pathExpr.GetIdentExpr().GetName() != "this" || len(fields) < 2 || fields[0] != "attributes" {
+		return "", nil, false
+	}
+	constant := valueExpr.GetConstExpr()
+	if constant == nil {
+		return "", nil, false
+	}
+	var value interface{}
+	switch kind := constant.GetConstantKind().(type) {
+	case *exprpb.Constant_StringValue:
+		value = kind.StringValue
+	case *exprpb.Constant_DoubleValue:
+		value = kind.DoubleValue
+	case *exprpb.Constant_Int64Value:
+		value = float64(kind.Int64Value)
+	case *exprpb.Constant_Uint64Value:
+		value = float64(kind.Uint64Value)
+	case *exprpb.Constant_BoolValue:
+		value = kind.BoolValue
+	default:
+		return "", nil, false
+	}
+	return strings.Join(fields, "."), value, true
+}
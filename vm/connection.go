@@ -5,6 +5,8 @@ import (
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/checker/decls"
 	"github.com/graphikDB/graphik/gen/grpc/go"
+	"google.golang.org/protobuf/types/known/structpb"
+	"reflect"
 	"strings"
 )
 
@@ -69,3 +71,50 @@ func (n *ConnectionVM) Eval(connection *apipb.Connection, programs ...cel.Progra
 	}
 	return passes, nil
 }
+
+// Trace evaluates a program like Eval but returns the errors Eval swallows(ex: no such key)
+func (n *ConnectionVM) Trace(connection *apipb.Connection, program cel.Program) (bool, error) {
+	out, _, err := program.Eval(map[string]interface{}{
+		"this": connection,
+	})
+	if err != nil {
+		return false, err
+	}
+	val, ok := out.Value().(bool)
+	return ok && val, nil
+}
+
+// EvalMap evaluates a program that returns a map ex: {'name': this.attributes.first + ' ' + this.attributes.last}
+func (n *ConnectionVM) EvalMap(connection *apipb.Connection, program cel.Program) (*structpb.Struct, error) {
+	out, _, err := program.Eval(map[string]interface{}{
+		"this": connection,
+	})
+	if err != nil {
+		return nil, err
+	}
+	val, err := out.ConvertToNative(reflect.TypeOf(&structpb.Struct{}))
+	if err != nil {
+		return nil, errors.New("expression must return a map")
+	}
+	return val.(*structpb.Struct), nil
+}
+
+// EvalNumber evaluates a program that returns a number ex: this.attributes.distance * 2.0
+func (n *ConnectionVM) EvalNumber(connection *apipb.Connection, program cel.Program) (float64, error) {
+	out, _, err := program.Eval(map[string]interface{}{
+		"this": connection,
+	})
+	if err != nil {
+		return 0, err
+	}
+	switch val := out.Value().(type) {
+	case float64:
+		return val, nil
+	case int64:
+		return float64(val), nil
+	case uint64:
+		return float64(val), nil
+	default:
+		return 0, errors.New("expression must return a number")
+	}
+}
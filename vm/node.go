@@ -5,6 +5,8 @@ import (
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/checker/decls"
 	"github.com/graphikDB/graphik/gen/grpc/go"
+	"google.golang.org/protobuf/types/known/structpb"
+	"reflect"
 	"strings"
 )
 
@@ -72,3 +74,30 @@ func (n *DocVM) Eval(doc *apipb.Doc, programs ...cel.Program) (bool, error) {
 	}
 	return passes, nil
 }
+
+// Trace evaluates a program like Eval but returns the errors Eval swallows(ex: no such key)
+func (n *DocVM) Trace(doc *apipb.Doc, program cel.Program) (bool, error) {
+	out, _, err := program.Eval(map[string]interface{}{
+		"this": doc,
+	})
+	if err != nil {
+		return false, err
+	}
+	val, ok := out.Value().(bool)
+	return ok && val, nil
+}
+
+// EvalMap evaluates a program that returns a map ex: {'name': this.attributes.first + ' ' + this.attributes.last}
+func (n *DocVM) EvalMap(doc *apipb.Doc, program cel.Program) (*structpb.Struct, error) {
+	out, _, err := program.Eval(map[string]interface{}{
+		"this": doc,
+	})
+	if err != nil {
+		return nil, err
+	}
+	val, err := out.ConvertToNative(reflect.TypeOf(&structpb.Struct{}))
+	if err != nil {
+		return nil, errors.New("expression must return a map")
+	}
+	return val.(*structpb.Struct), nil
+}